@@ -27,24 +27,32 @@ type Account struct {
 	Name string
 }
 
-// List fetches all accounts for an organization.
-func (s *AccountService) List(ctx context.Context, organizationID string) ([]Account, error) {
-	s.logger.Debug("fetching accounts from API", "organizationID", organizationID)
-	resp, err := s.client.ListAccounts(ctx, organizationID)
+// List fetches the accounts of an organization, walking every page unless opts.Limit is set.
+func (s *AccountService) List(ctx context.Context, organizationID string, opts ListOptions) ([]Account, error) {
+	s.logger.Debug("fetching accounts from API", "organizationID", organizationID, "limit", opts.Limit)
+	fetch := func(ctx context.Context, first int, after *string) ([]Account, client.PageInfoFields, error) {
+		resp, err := s.client.ListAccounts(ctx, organizationID, first, after)
+		if err != nil {
+			return nil, client.PageInfoFields{}, err
+		}
+
+		// Convert GraphQL response to domain model
+		accounts := make([]Account, len(resp.Accounts.Edges))
+		for i, edge := range resp.Accounts.Edges {
+			accounts[i] = Account{
+				ID:   edge.Node.Id,
+				Name: edge.Node.Name,
+			}
+		}
+		return accounts, resp.Accounts.PageInfo.PageInfoFields, nil
+	}
+
+	accounts, err := client.Collect(client.Paginate(ctx, fetch, opts.Limit))
 	if err != nil {
 		s.logger.Error("failed to fetch accounts", "error", err, "organizationID", organizationID)
 		return nil, err
 	}
 
-	// Convert GraphQL response to domain model
-	accounts := make([]Account, len(resp.Accounts.Edges))
-	for i, edge := range resp.Accounts.Edges {
-		accounts[i] = Account{
-			ID:   edge.Node.Id,
-			Name: edge.Node.Name,
-		}
-	}
-
 	s.logger.Debug("fetched accounts from API", "count", len(accounts))
 	return accounts, nil
 }
@@ -71,3 +79,90 @@ func (s *AccountService) Create(ctx context.Context, organizationID, name string
 	s.logger.Debug("created account via API", "id", account.ID, "name", account.Name)
 	return account, nil
 }
+
+// Rename changes an account's name.
+func (s *AccountService) Rename(ctx context.Context, accountID, name string) (*Account, error) {
+	s.logger.Debug("renaming account via API", "accountID", accountID, "name", name)
+	resp, err := s.client.RenameAccount(ctx, accountID, name)
+	if err != nil {
+		s.logger.Error("failed to rename account", "error", err)
+		return nil, err
+	}
+
+	account := &Account{
+		ID:   resp.UpdateAccount.Id,
+		Name: resp.UpdateAccount.Name,
+	}
+
+	s.logger.Debug("renamed account via API", "id", account.ID, "name", account.Name)
+	return account, nil
+}
+
+// GetVolumeStats fetches the log volume breakdown for an account and its services.
+// Returns nil if the account does not exist.
+func (s *AccountService) GetVolumeStats(ctx context.Context, accountID string, window TimeWindow) (*AccountVolumeStats, error) {
+	s.logger.Debug("fetching account volume stats from API", "accountID", accountID, "window", window)
+	resp, err := s.client.GetAccountVolumeStats(ctx, accountID, client.TimeWindow(window))
+	if err != nil {
+		s.logger.Error("failed to fetch account volume stats", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	if len(resp.Accounts.Edges) == 0 {
+		s.logger.Debug("no account found", "accountID", accountID)
+		return nil, nil
+	}
+
+	// Convert GraphQL response to domain model
+	node := resp.Accounts.Edges[0].Node
+	stats := &AccountVolumeStats{
+		AccountID:   node.Id,
+		AccountName: node.Name,
+		Window:      window,
+		Stats:       newVolumeStats(node.VolumeStats.LogVolumeStats),
+		Services:    make([]ServiceVolumeStats, len(node.Services)),
+	}
+	for i, svc := range node.Services {
+		stats.Services[i] = ServiceVolumeStats{
+			ID:      svc.Id,
+			Name:    svc.Name,
+			Enabled: svc.Enabled,
+			Stats:   newVolumeStats(svc.VolumeStats.LogVolumeStats),
+		}
+	}
+
+	s.logger.Debug("fetched account volume stats from API", "count", len(stats.Services))
+	return stats, nil
+}
+
+// GetSummary fetches the at-a-glance numbers for an account: its organization,
+// how many services it has, and its log volume over the last day and week.
+// Returns nil if the account does not exist.
+func (s *AccountService) GetSummary(ctx context.Context, accountID string) (*AccountSummary, error) {
+	s.logger.Debug("fetching account summary from API", "accountID", accountID)
+	resp, err := s.client.GetAccountSummary(ctx, accountID)
+	if err != nil {
+		s.logger.Error("failed to fetch account summary", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	if len(resp.Accounts.Edges) == 0 {
+		s.logger.Debug("no account found", "accountID", accountID)
+		return nil, nil
+	}
+
+	// Convert GraphQL response to domain model
+	node := resp.Accounts.Edges[0].Node
+	summary := &AccountSummary{
+		OrganizationID:   node.Organization.Id,
+		OrganizationName: node.Organization.Name,
+		AccountID:        node.Id,
+		AccountName:      node.Name,
+		ServicesCount:    len(node.Services),
+		Day:              newVolumeStats(node.Day.LogVolumeStats),
+		Week:             newVolumeStats(node.Week.LogVolumeStats),
+	}
+
+	s.logger.Debug("fetched account summary from API", "accountID", accountID)
+	return summary, nil
+}
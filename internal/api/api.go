@@ -11,6 +11,10 @@ type API struct {
 	Accounts        *AccountService
 	DatadogAccounts *DatadogAccountService
 	Services        *ServiceService
+	LogEvents       *LogEventService
+	LogRules        *LogRuleService
+	Workspaces      *WorkspaceService
+	Chats           *ChatService
 }
 
 // New creates a new API with all services initialized.
@@ -21,5 +25,9 @@ func New(client Client, logger log.Logger) *API {
 		Accounts:        NewAccountService(client, logger),
 		DatadogAccounts: NewDatadogAccountService(client, logger),
 		Services:        NewServiceService(client, logger),
+		LogEvents:       NewLogEventService(client, logger),
+		LogRules:        NewLogRuleService(client, logger),
+		Workspaces:      NewWorkspaceService(client, logger),
+		Chats:           NewChatService(client, logger),
 	}
 }
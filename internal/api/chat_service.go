@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/usetero/cli/internal/log"
+)
+
+// ChatService handles chat-related API operations.
+// Chats are conversations between a user and Tero, scoped to a workspace.
+// The schema doesn't expose createChat/createMessage mutations or message
+// content yet, so chats can only be read.
+type ChatService struct {
+	client Client
+	logger log.Logger
+}
+
+// NewChatService creates a new chat service.
+func NewChatService(client Client, logger log.Logger) *ChatService {
+	return &ChatService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// MessageRole identifies who authored a message.
+type MessageRole string
+
+const (
+	MessageRoleSystem    MessageRole = "system"
+	MessageRoleUser      MessageRole = "user"
+	MessageRoleAssistant MessageRole = "assistant"
+)
+
+// Chat is the domain model for a chat.
+type Chat struct {
+	ID        string
+	Title     string
+	UpdatedAt time.Time
+	Messages  []Message
+}
+
+// Message is the domain model for a chat message.
+type Message struct {
+	ID         string
+	Role       MessageRole
+	StopReason string
+	CreatedAt  time.Time
+}
+
+// Latest fetches the most recently active chat in a workspace.
+// Returns nil if the workspace has no chats yet.
+func (s *ChatService) Latest(ctx context.Context, workspaceID string) (*Chat, error) {
+	s.logger.Debug("fetching latest chat from API", "workspaceID", workspaceID)
+	resp, err := s.client.GetLatestChat(ctx, workspaceID)
+	if err != nil {
+		s.logger.Error("failed to fetch latest chat", "error", err, "workspaceID", workspaceID)
+		return nil, err
+	}
+
+	if len(resp.Chats.Edges) == 0 {
+		s.logger.Debug("no chats found", "workspaceID", workspaceID)
+		return nil, nil
+	}
+
+	// Convert GraphQL response to domain model
+	node := resp.Chats.Edges[0].Node
+	chat := &Chat{
+		ID:        node.Id,
+		Title:     node.Title,
+		UpdatedAt: node.UpdatedAt,
+		Messages:  make([]Message, len(node.Messages)),
+	}
+	for i, m := range node.Messages {
+		chat.Messages[i] = Message{
+			ID:         m.Id,
+			Role:       MessageRole(m.Role),
+			StopReason: m.StopReason,
+			CreatedAt:  m.CreatedAt,
+		}
+	}
+
+	s.logger.Debug("fetched latest chat from API", "chatID", chat.ID, "messages", len(chat.Messages))
+	return chat, nil
+}
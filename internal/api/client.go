@@ -2,26 +2,53 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/usetero/cli/pkg/client"
 )
 
 // Client defines the interface for communicating with the Tero control plane.
 // This allows services to be tested without real API calls.
+// List operations fetch a single page; services walk pages with client.Paginate.
 // Concrete implementation: *client.Client (generated GraphQL client)
 type Client interface {
 	// Organization operations
-	ListOrganizations(ctx context.Context) (*client.ListOrganizationsResponse, error)
+	ListOrganizations(ctx context.Context, first int, after *string) (*client.ListOrganizationsResponse, error)
 	CreateOrganizationAndBootstrap(ctx context.Context, input client.CreateOrganizationInput) (*client.CreateOrganizationAndBootstrapResponse, error)
 
 	// Account operations
-	ListAccounts(ctx context.Context, organizationID string) (*client.ListAccountsResponse, error)
+	ListAccounts(ctx context.Context, organizationID string, first int, after *string) (*client.ListAccountsResponse, error)
 	CreateAccount(ctx context.Context, input client.CreateAccountInput) (*client.CreateAccountResponse, error)
+	RenameAccount(ctx context.Context, accountID, name string) (*client.RenameAccountResponse, error)
 	GetAccount(ctx context.Context, accountID string) (*client.GetAccountResponse, error)
+	GetAccountVolumeStats(ctx context.Context, accountID string, lookback client.TimeWindow) (*client.GetAccountVolumeStatsResponse, error)
+	GetAccountSummary(ctx context.Context, accountID string) (*client.GetAccountSummaryResponse, error)
 
 	// Datadog operations
 	ValidateDatadogApiKey(ctx context.Context, input client.ValidateDatadogApiKeyInput) (*client.ValidateDatadogApiKeyResponse, error)
 	CreateDatadogAccountWithCredentials(ctx context.Context, input client.CreateDatadogAccountWithCredentialsInput) (*client.CreateDatadogAccountWithCredentialsResponse, error)
 	GetDatadogAccountServiceDiscoveryProgress(ctx context.Context, id string) (*client.GetDatadogAccountServiceDiscoveryProgressResponse, error)
 	GetDatadogAccountLogDiscoveryProgress(ctx context.Context, id string) (*client.GetDatadogAccountLogDiscoveryProgressResponse, error)
+
+	// Workspace operations
+	ListWorkspaces(ctx context.Context, accountID string, first int, after *string) (*client.ListWorkspacesResponse, error)
+
+	// Chat operations
+	GetLatestChat(ctx context.Context, workspaceID string) (*client.GetLatestChatResponse, error)
+
+	// Service operations
+	ListServices(ctx context.Context, first int, after *string) (*client.ListServicesResponse, error)
+	GetServiceVolumeStats(ctx context.Context, serviceID string, lookback client.TimeWindow) (*client.GetServiceVolumeStatsResponse, error)
+	ListServiceVolumeStats(ctx context.Context, accountID string, lookback client.TimeWindow, first int, after *string) (*client.ListServiceVolumeStatsResponse, error)
+	EnableService(ctx context.Context, serviceID string) (*client.EnableServiceResponse, error)
+	DisableService(ctx context.Context, serviceID string) (*client.DisableServiceResponse, error)
+
+	// Log event operations
+	ListLogEventsForService(ctx context.Context, serviceID string, lookback client.TimeWindow, first int, after *string) (*client.ListLogEventsForServiceResponse, error)
+	GetLogEventDetail(ctx context.Context, id string) (*client.GetLogEventDetailResponse, error)
+	ListLogEventVolumes(ctx context.Context, logEventID string, since time.Time, first int, after *string) (*client.ListLogEventVolumesResponse, error)
+
+	// Log rule operations
+	ListLogRulesForService(ctx context.Context, serviceID string, first int, after *string) (*client.ListLogRulesForServiceResponse, error)
+	ListLogRulesForWorkspace(ctx context.Context, workspaceID string, first int, after *string) (*client.ListLogRulesForWorkspaceResponse, error)
 }
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ContentBlockType identifies the shape of a content block's data.
+type ContentBlockType string
+
+const (
+	ContentBlockText      ContentBlockType = "text"
+	ContentBlockChart     ContentBlockType = "chart"
+	ContentBlockTable     ContentBlockType = "table"
+	ContentBlockLogSample ContentBlockType = "log_sample"
+	ContentBlockAction    ContentBlockType = "action"
+)
+
+// ContentBlock is one structured piece of an assistant response.
+// Data is kept raw so clients can skip block types they don't understand
+// instead of failing to decode the whole message.
+type ContentBlock struct {
+	Type ContentBlockType `json:"type"`
+	Data json.RawMessage  `json:"data"`
+}
+
+// Decode unmarshals the block's data into v.
+func (b ContentBlock) Decode(v any) error {
+	if err := json.Unmarshal(b.Data, v); err != nil {
+		return fmt.Errorf("decode %s block: %w", b.Type, err)
+	}
+	return nil
+}
+
+// NewTextBlock creates a text content block.
+func NewTextBlock(text string) ContentBlock {
+	data, _ := json.Marshal(TextBlock{Text: text})
+	return ContentBlock{Type: ContentBlockText, Data: data}
+}
+
+// TextBlock is a natural language response.
+type TextBlock struct {
+	Text string `json:"text"`
+}
+
+// ChartBlock is a series of labeled values, such as daily log volume.
+type ChartBlock struct {
+	Title  string       `json:"title"`
+	Unit   string       `json:"unit"`
+	Points []ChartPoint `json:"points"`
+}
+
+// ChartPoint is a single value in a chart.
+type ChartPoint struct {
+	Label string  `json:"label"`
+	Value float64 `json:"value"`
+}
+
+// TableBlock is tabular data, such as services and their metrics.
+type TableBlock struct {
+	Title   string     `json:"title"`
+	Columns []string   `json:"columns"`
+	Rows    [][]string `json:"rows"`
+}
+
+// LogSampleBlock is a set of example log lines for an event.
+type LogSampleBlock struct {
+	Title   string      `json:"title"`
+	Samples []LogSample `json:"samples"`
+}
+
+// LogSample is a single example log line.
+type LogSample struct {
+	Timestamp time.Time `json:"timestamp"`
+	Severity  string    `json:"severity"`
+	Service   string    `json:"service"`
+	Body      string    `json:"body"`
+}
+
+// ActionBlock offers things the user can do next.
+type ActionBlock struct {
+	Actions []Action `json:"actions"`
+}
+
+// Action is a single suggested action.
+type Action struct {
+	ID          string `json:"id"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/pkg/client"
+)
+
+// LogEventService handles log event operations.
+// Log events are the distinct kinds of log lines a service emits,
+// discovered by analyzing samples from the observability platform.
+type LogEventService struct {
+	client Client
+	logger log.Logger
+}
+
+// NewLogEventService creates a new log event service.
+func NewLogEventService(client Client, logger log.Logger) *LogEventService {
+	return &LogEventService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// LogEvent is the domain model for a log event.
+type LogEvent struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Stats       VolumeStats `json:"volumeStats"`
+}
+
+// ListForService fetches the log events of a service with volume stats for the window,
+// walking every page unless opts.Limit is set.
+func (s *LogEventService) ListForService(ctx context.Context, serviceID string, window TimeWindow, opts ListOptions) ([]LogEvent, error) {
+	s.logger.Debug("fetching log events from API", "serviceID", serviceID, "window", window, "limit", opts.Limit)
+	fetch := func(ctx context.Context, first int, after *string) ([]LogEvent, client.PageInfoFields, error) {
+		resp, err := s.client.ListLogEventsForService(ctx, serviceID, client.TimeWindow(window), first, after)
+		if err != nil {
+			return nil, client.PageInfoFields{}, err
+		}
+
+		// Convert GraphQL response to domain model
+		events := make([]LogEvent, len(resp.LogEvents.Edges))
+		for i, edge := range resp.LogEvents.Edges {
+			events[i] = LogEvent{
+				ID:          edge.Node.Id,
+				Name:        edge.Node.Name,
+				Description: edge.Node.Description,
+				Stats:       newVolumeStats(edge.Node.VolumeStats.LogVolumeStats),
+			}
+		}
+		return events, resp.LogEvents.PageInfo.PageInfoFields, nil
+	}
+
+	events, err := client.Collect(client.Paginate(ctx, fetch, opts.Limit))
+	if err != nil {
+		s.logger.Error("failed to fetch log events", "error", err, "serviceID", serviceID)
+		return nil, err
+	}
+
+	s.logger.Debug("fetched log events from API", "count", len(events))
+	return events, nil
+}
+
+// LogEventDetail is a log event with its service and every workspace's rule for it.
+type LogEventDetail struct {
+	LogEvent
+	ServiceID   string    `json:"serviceId"`
+	ServiceName string    `json:"serviceName"`
+	Rules       []LogRule `json:"rules"`
+}
+
+// Get fetches a log event with its week's volume stats and its rules,
+// including where each rule is deployed. Returns nil if the log event does not exist.
+func (s *LogEventService) Get(ctx context.Context, id string) (*LogEventDetail, error) {
+	s.logger.Debug("fetching log event from API", "logEventID", id)
+	resp, err := s.client.GetLogEventDetail(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to fetch log event", "error", err, "logEventID", id)
+		return nil, err
+	}
+
+	if len(resp.LogEvents.Edges) == 0 {
+		s.logger.Debug("no log event found", "logEventID", id)
+		return nil, nil
+	}
+
+	// Convert GraphQL response to domain model
+	node := resp.LogEvents.Edges[0].Node
+	detail := &LogEventDetail{
+		LogEvent: LogEvent{
+			ID:          node.Id,
+			Name:        node.Name,
+			Description: node.Description,
+			Stats:       newVolumeStats(node.VolumeStats.LogVolumeStats),
+		},
+		ServiceID:   node.Service.Id,
+		ServiceName: node.Service.Name,
+		Rules:       make([]LogRule, len(node.LogRules)),
+	}
+	for i, r := range node.LogRules {
+		rule := newLogRule(r.LogRuleDetails)
+		rule.LogEventName = node.Name
+		rule.WorkspaceName = r.Workspace.Name
+		rule.Deployments = make([]LogRuleDeployment, len(r.Deployments))
+		for j, d := range r.Deployments {
+			rule.Deployments[j] = newLogRuleDeployment(d.LogRuleDeploymentDetails)
+		}
+		detail.Rules[i] = rule
+	}
+
+	s.logger.Debug("fetched log event from API", "logEventID", id, "rules", len(detail.Rules))
+	return detail, nil
+}
+
+// WeekOverWeek compares a log event's volume over the last seven days with the seven days before.
+type WeekOverWeek struct {
+	ThisWeek float64 `json:"thisWeek"`
+	LastWeek float64 `json:"lastWeek"`
+}
+
+// ChangePercent returns the change from last week to this week as a percentage.
+// Returns false if there was no volume last week to compare against.
+func (w WeekOverWeek) ChangePercent() (float64, bool) {
+	if w.LastWeek == 0 {
+		return 0, false
+	}
+	return (w.ThisWeek - w.LastWeek) / w.LastWeek * 100, true
+}
+
+// logEventVolume is a single volume sample as returned by the API.
+type logEventVolume = client.ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdgeNodeLogEventVolume
+
+// GetWeekOverWeek sums a log event's volume samples for the two weeks before now.
+func (s *LogEventService) GetWeekOverWeek(ctx context.Context, id string, now time.Time) (*WeekOverWeek, error) {
+	const week = 7 * 24 * time.Hour
+	thisWeekStart := now.Add(-week)
+	since := now.Add(-2 * week)
+
+	s.logger.Debug("fetching log event volumes from API", "logEventID", id, "since", since)
+	fetch := func(ctx context.Context, first int, after *string) ([]logEventVolume, client.PageInfoFields, error) {
+		resp, err := s.client.ListLogEventVolumes(ctx, id, since, first, after)
+		if err != nil {
+			return nil, client.PageInfoFields{}, err
+		}
+
+		volumes := make([]logEventVolume, len(resp.LogEventVolumes.Edges))
+		for i, edge := range resp.LogEventVolumes.Edges {
+			volumes[i] = edge.Node
+		}
+		return volumes, resp.LogEventVolumes.PageInfo.PageInfoFields, nil
+	}
+
+	var wow WeekOverWeek
+	for v, err := range client.Paginate(ctx, fetch, 0) {
+		if err != nil {
+			s.logger.Error("failed to fetch log event volumes", "error", err, "logEventID", id)
+			return nil, err
+		}
+		switch {
+		case !v.Timestamp.Before(now):
+			// Samples from the future (clock skew) don't belong to either week
+		case !v.Timestamp.Before(thisWeekStart):
+			wow.ThisWeek += v.Count
+		default:
+			wow.LastWeek += v.Count
+		}
+	}
+
+	s.logger.Debug("fetched log event volumes from API", "logEventID", id, "thisWeek", wow.ThisWeek, "lastWeek", wow.LastWeek)
+	return &wow, nil
+}
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/pkg/client"
+)
+
+// LogRuleService handles log rule operations.
+// A log rule is a workspace's keep/drop decision for a log event,
+// with the rationale and confidence behind it.
+type LogRuleService struct {
+	client Client
+	logger log.Logger
+}
+
+// NewLogRuleService creates a new log rule service.
+func NewLogRuleService(client Client, logger log.Logger) *LogRuleService {
+	return &LogRuleService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// LogRuleRetention is whether matching logs are kept or dropped.
+type LogRuleRetention string
+
+const (
+	LogRuleRetentionKeep LogRuleRetention = "keep"
+	LogRuleRetentionDrop LogRuleRetention = "drop"
+)
+
+// LogRuleConfidence is how confident the rule author is in the retention decision.
+type LogRuleConfidence string
+
+const (
+	LogRuleConfidenceVeryHigh LogRuleConfidence = "very_high"
+	LogRuleConfidenceHigh     LogRuleConfidence = "high"
+	LogRuleConfidenceMedium   LogRuleConfidence = "medium"
+	LogRuleConfidenceLow      LogRuleConfidence = "low"
+	LogRuleConfidenceVeryLow  LogRuleConfidence = "very_low"
+)
+
+// LogRule is the domain model for a log rule.
+type LogRule struct {
+	ID            string            `json:"id"`
+	LogEventID    string            `json:"logEventId"`
+	LogEventName  string            `json:"logEventName"`
+	WorkspaceID   string            `json:"workspaceId"`
+	Retention     LogRuleRetention  `json:"retention"`
+	Confidence    LogRuleConfidence `json:"confidence"`
+	Rationale     string            `json:"rationale"`
+	VRLScript     string            `json:"vrlScript,omitempty"`
+	CreatedByType string            `json:"createdByType"`
+	CreatedByID   string            `json:"createdById"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	IgnoredAt     *time.Time        `json:"ignoredAt,omitempty"`
+
+	// Set only by queries that load them (e.g. log event detail, export)
+	ServiceID     string              `json:"serviceId,omitempty"`
+	ServiceName   string              `json:"serviceName,omitempty"`
+	WorkspaceName string              `json:"workspaceName,omitempty"`
+	Deployments   []LogRuleDeployment `json:"deployments,omitempty"`
+}
+
+// LogRuleDeployment is where a rule has been pushed to the observability platform.
+type LogRuleDeployment struct {
+	ID         string    `json:"id"`
+	IndexID    string    `json:"indexId,omitempty"`
+	IndexName  string    `json:"indexName,omitempty"`
+	ExternalID string    `json:"externalId,omitempty"` // Empty if the deployment drifted or failed
+	LastError  string    `json:"lastError,omitempty"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// IsHealthy returns true if the rule is live in the platform without errors.
+func (d LogRuleDeployment) IsHealthy() bool {
+	return d.ExternalID != "" && d.LastError == ""
+}
+
+// IsActive returns true if the rule has not been dismissed by a user.
+func (r LogRule) IsActive() bool {
+	return r.IgnoredAt == nil
+}
+
+// ListForService fetches the log rules for every log event of a service,
+// walking every page unless opts.Limit is set.
+func (s *LogRuleService) ListForService(ctx context.Context, serviceID string, opts ListOptions) ([]LogRule, error) {
+	s.logger.Debug("fetching log rules from API", "serviceID", serviceID, "limit", opts.Limit)
+	fetch := func(ctx context.Context, first int, after *string) ([]LogRule, client.PageInfoFields, error) {
+		resp, err := s.client.ListLogRulesForService(ctx, serviceID, first, after)
+		if err != nil {
+			return nil, client.PageInfoFields{}, err
+		}
+
+		// Convert GraphQL response to domain model
+		rules := make([]LogRule, len(resp.LogRules.Edges))
+		for i, edge := range resp.LogRules.Edges {
+			rules[i] = newLogRule(edge.Node.LogRuleDetails)
+			rules[i].LogEventName = edge.Node.LogEvent.Name
+		}
+		return rules, resp.LogRules.PageInfo.PageInfoFields, nil
+	}
+
+	rules, err := client.Collect(client.Paginate(ctx, fetch, opts.Limit))
+	if err != nil {
+		s.logger.Error("failed to fetch log rules", "error", err, "serviceID", serviceID)
+		return nil, err
+	}
+
+	s.logger.Debug("fetched log rules from API", "count", len(rules))
+	return rules, nil
+}
+
+// ListForWorkspace fetches a workspace's log rules with the log event and
+// service each one matches and where it is deployed, walking every page
+// unless opts.Limit is set.
+func (s *LogRuleService) ListForWorkspace(ctx context.Context, workspaceID string, opts ListOptions) ([]LogRule, error) {
+	s.logger.Debug("fetching log rules from API", "workspaceID", workspaceID, "limit", opts.Limit)
+	fetch := func(ctx context.Context, first int, after *string) ([]LogRule, client.PageInfoFields, error) {
+		resp, err := s.client.ListLogRulesForWorkspace(ctx, workspaceID, first, after)
+		if err != nil {
+			return nil, client.PageInfoFields{}, err
+		}
+
+		// Convert GraphQL response to domain model
+		rules := make([]LogRule, len(resp.LogRules.Edges))
+		for i, edge := range resp.LogRules.Edges {
+			node := edge.Node
+			rule := newLogRule(node.LogRuleDetails)
+			rule.LogEventName = node.LogEvent.Name
+			rule.ServiceID = node.LogEvent.Service.Id
+			rule.ServiceName = node.LogEvent.Service.Name
+			rule.Deployments = make([]LogRuleDeployment, len(node.Deployments))
+			for j, d := range node.Deployments {
+				rule.Deployments[j] = newLogRuleDeployment(d.LogRuleDeploymentDetails)
+			}
+			rules[i] = rule
+		}
+		return rules, resp.LogRules.PageInfo.PageInfoFields, nil
+	}
+
+	rules, err := client.Collect(client.Paginate(ctx, fetch, opts.Limit))
+	if err != nil {
+		s.logger.Error("failed to fetch log rules", "error", err, "workspaceID", workspaceID)
+		return nil, err
+	}
+
+	s.logger.Debug("fetched log rules from API", "count", len(rules))
+	return rules, nil
+}
+
+// newLogRule converts the shared GraphQL fragment to the domain model.
+func newLogRule(f client.LogRuleDetails) LogRule {
+	var ignoredAt *time.Time
+	if !f.IgnoredAt.IsZero() {
+		ignoredAt = &f.IgnoredAt
+	}
+
+	return LogRule{
+		ID:            f.Id,
+		LogEventID:    f.LogEventID,
+		WorkspaceID:   f.WorkspaceID,
+		Retention:     LogRuleRetention(f.Retention),
+		Confidence:    LogRuleConfidence(f.Confidence),
+		Rationale:     f.Rationale,
+		VRLScript:     f.VrlScript,
+		CreatedByType: string(f.CreatedByType),
+		CreatedByID:   f.CreatedByID,
+		CreatedAt:     f.CreatedAt,
+		IgnoredAt:     ignoredAt,
+	}
+}
+
+// newLogRuleDeployment converts the shared GraphQL fragment to the domain model.
+func newLogRuleDeployment(f client.LogRuleDeploymentDetails) LogRuleDeployment {
+	return LogRuleDeployment{
+		ID:         f.Id,
+		IndexID:    f.DatadogLogIndex.Id,
+		IndexName:  f.DatadogLogIndex.Name,
+		ExternalID: f.ExternalID,
+		LastError:  f.LastError,
+		UpdatedAt:  f.UpdatedAt,
+	}
+}
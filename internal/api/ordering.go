@@ -8,3 +8,10 @@ const (
 	OrderDirectionAsc  OrderDirection = "ASC"
 	OrderDirectionDesc OrderDirection = "DESC"
 )
+
+// ListOptions controls how many results a list operation returns.
+// The zero value fetches the complete result set, walking every page.
+type ListOptions struct {
+	// Limit caps the number of results. Zero means no limit.
+	Limit int
+}
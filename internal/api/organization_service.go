@@ -34,15 +34,26 @@ type OrganizationBootstrapResult struct {
 	Workspace    *Workspace
 }
 
-// List fetches all organizations for the user.
-func (s *OrganizationService) List(ctx context.Context) ([]Organization, error) {
-	s.logger.Debug("fetching organizations from API")
-	resp, err := s.client.ListOrganizations(ctx)
+// List fetches the user's organizations, walking every page unless opts.Limit is set.
+func (s *OrganizationService) List(ctx context.Context, opts ListOptions) ([]Organization, error) {
+	s.logger.Debug("fetching organizations from API", "limit", opts.Limit)
+	orgs, err := client.Collect(client.Paginate(ctx, s.listPage, opts.Limit))
 	if err != nil {
 		s.logger.Error("failed to fetch organizations", "error", err)
 		return nil, err
 	}
 
+	s.logger.Debug("fetched organizations from API", "count", len(orgs))
+	return orgs, nil
+}
+
+// listPage fetches one page of organizations.
+func (s *OrganizationService) listPage(ctx context.Context, first int, after *string) ([]Organization, client.PageInfoFields, error) {
+	resp, err := s.client.ListOrganizations(ctx, first, after)
+	if err != nil {
+		return nil, client.PageInfoFields{}, err
+	}
+
 	// Convert GraphQL response to domain model
 	orgs := make([]Organization, len(resp.Organizations.Edges))
 	for i, edge := range resp.Organizations.Edges {
@@ -51,9 +62,7 @@ func (s *OrganizationService) List(ctx context.Context) ([]Organization, error)
 			Name: edge.Node.Name,
 		}
 	}
-
-	s.logger.Debug("fetched organizations from API", "count", len(orgs))
-	return orgs, nil
+	return orgs, resp.Organizations.PageInfo.PageInfoFields, nil
 }
 
 // Create creates a new organization with bootstrapped account and workspace.
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/pkg/client"
 )
 
 // ServiceService handles service-related operations.
@@ -23,6 +24,14 @@ func NewServiceService(client Client, logger log.Logger) *ServiceService {
 	}
 }
 
+// Service is the domain model for a service.
+type Service struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+}
+
 // ServiceDiscoveryStatus tracks the status of service discovery for a Datadog account.
 type ServiceDiscoveryStatus struct {
 	Status              DiscoveryStatus
@@ -88,3 +97,122 @@ func (s *ServiceService) GetServiceDiscoveryStatus(ctx context.Context, datadogA
 	s.logger.Debug("no Datadog account found")
 	return nil, nil
 }
+
+// List fetches the services visible to the user, walking every page unless opts.Limit is set.
+func (s *ServiceService) List(ctx context.Context, opts ListOptions) ([]Service, error) {
+	s.logger.Debug("fetching services from API", "limit", opts.Limit)
+	services, err := client.Collect(client.Paginate(ctx, s.listPage, opts.Limit))
+	if err != nil {
+		s.logger.Error("failed to fetch services", "error", err)
+		return nil, err
+	}
+
+	s.logger.Debug("fetched services from API", "count", len(services))
+	return services, nil
+}
+
+// listPage fetches one page of services.
+func (s *ServiceService) listPage(ctx context.Context, first int, after *string) ([]Service, client.PageInfoFields, error) {
+	resp, err := s.client.ListServices(ctx, first, after)
+	if err != nil {
+		return nil, client.PageInfoFields{}, err
+	}
+
+	// Convert GraphQL response to domain model
+	services := make([]Service, len(resp.Services.Edges))
+	for i, edge := range resp.Services.Edges {
+		services[i] = Service{
+			ID:          edge.Node.Id,
+			Name:        edge.Node.Name,
+			Description: edge.Node.Description,
+			Enabled:     edge.Node.Enabled,
+		}
+	}
+	return services, resp.Services.PageInfo.PageInfoFields, nil
+}
+
+// GetVolumeStats fetches the log volume breakdown for a service.
+// Returns nil if the service does not exist.
+func (s *ServiceService) GetVolumeStats(ctx context.Context, serviceID string, window TimeWindow) (*ServiceVolumeStats, error) {
+	s.logger.Debug("fetching service volume stats from API", "serviceID", serviceID, "window", window)
+	resp, err := s.client.GetServiceVolumeStats(ctx, serviceID, client.TimeWindow(window))
+	if err != nil {
+		s.logger.Error("failed to fetch service volume stats", "error", err, "serviceID", serviceID)
+		return nil, err
+	}
+
+	if len(resp.Services.Edges) == 0 {
+		s.logger.Debug("no service found", "serviceID", serviceID)
+		return nil, nil
+	}
+
+	node := resp.Services.Edges[0].Node
+	stats := &ServiceVolumeStats{
+		ID:      node.Id,
+		Name:    node.Name,
+		Enabled: node.Enabled,
+		Stats:   newVolumeStats(node.VolumeStats.LogVolumeStats),
+	}
+
+	s.logger.Debug("fetched service volume stats from API", "serviceID", serviceID)
+	return stats, nil
+}
+
+// ListVolumeStats fetches an account's services with their log volume breakdowns,
+// walking every page unless opts.Limit is set.
+func (s *ServiceService) ListVolumeStats(ctx context.Context, accountID string, window TimeWindow, opts ListOptions) ([]ServiceVolumeStats, error) {
+	s.logger.Debug("fetching service volume stats from API", "accountID", accountID, "window", window, "limit", opts.Limit)
+	fetch := func(ctx context.Context, first int, after *string) ([]ServiceVolumeStats, client.PageInfoFields, error) {
+		resp, err := s.client.ListServiceVolumeStats(ctx, accountID, client.TimeWindow(window), first, after)
+		if err != nil {
+			return nil, client.PageInfoFields{}, err
+		}
+
+		// Convert GraphQL response to domain model
+		services := make([]ServiceVolumeStats, len(resp.Services.Edges))
+		for i, edge := range resp.Services.Edges {
+			services[i] = ServiceVolumeStats{
+				ID:                    edge.Node.Id,
+				Name:                  edge.Node.Name,
+				Enabled:               edge.Node.Enabled,
+				Stats:                 newVolumeStats(edge.Node.VolumeStats.LogVolumeStats),
+				InitialWeeklyLogCount: edge.Node.InitialWeeklyLogCount,
+			}
+		}
+		return services, resp.Services.PageInfo.PageInfoFields, nil
+	}
+
+	services, err := client.Collect(client.Paginate(ctx, fetch, opts.Limit))
+	if err != nil {
+		s.logger.Error("failed to fetch service volume stats", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	s.logger.Debug("fetched service volume stats from API", "count", len(services))
+	return services, nil
+}
+
+// SetEnabled turns analysis on or off for a service.
+func (s *ServiceService) SetEnabled(ctx context.Context, serviceID string, enabled bool) (*Service, error) {
+	s.logger.Debug("updating service", "serviceID", serviceID, "enabled", enabled)
+
+	var svc Service
+	if enabled {
+		resp, err := s.client.EnableService(ctx, serviceID)
+		if err != nil {
+			s.logger.Error("failed to enable service", "error", err, "serviceID", serviceID)
+			return nil, err
+		}
+		svc = Service{ID: resp.UpdateService.Id, Name: resp.UpdateService.Name, Enabled: resp.UpdateService.Enabled}
+	} else {
+		resp, err := s.client.DisableService(ctx, serviceID)
+		if err != nil {
+			s.logger.Error("failed to disable service", "error", err, "serviceID", serviceID)
+			return nil, err
+		}
+		svc = Service{ID: resp.UpdateService.Id, Name: resp.UpdateService.Name, Enabled: resp.UpdateService.Enabled}
+	}
+
+	s.logger.Info("updated service", "serviceID", serviceID, "enabled", svc.Enabled)
+	return &svc, nil
+}
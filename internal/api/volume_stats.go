@@ -0,0 +1,106 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/usetero/cli/pkg/client"
+)
+
+// TimeWindow is the lookback period used when aggregating log volume.
+type TimeWindow string
+
+const (
+	TimeWindowDay     TimeWindow = "DAY"
+	TimeWindowWeek    TimeWindow = "WEEK"
+	TimeWindowMonth   TimeWindow = "MONTH"
+	TimeWindowQuarter TimeWindow = "QUARTER"
+)
+
+// ParseTimeWindow converts a user-facing window name (day, week, month, quarter)
+// into a TimeWindow. Matching is case-insensitive.
+func ParseTimeWindow(s string) (TimeWindow, error) {
+	w := TimeWindow(strings.ToUpper(s))
+	switch w {
+	case TimeWindowDay, TimeWindowWeek, TimeWindowMonth, TimeWindowQuarter:
+		return w, nil
+	}
+	return "", fmt.Errorf("invalid time window %q (expected day, week, month, or quarter)", s)
+}
+
+// VolumeStats is the domain model for a log volume breakdown over a time window.
+// Volumes are event counts; percentages are 0-100.
+type VolumeStats struct {
+	TotalVolume     float64   `json:"totalVolume" yaml:"totalVolume"`
+	UnknownVolume   float64   `json:"unknownVolume" yaml:"unknownVolume"`
+	ValuableVolume  float64   `json:"valuableVolume" yaml:"valuableVolume"`
+	WasteVolume     float64   `json:"wasteVolume" yaml:"wasteVolume"`
+	SavedVolume     float64   `json:"savedVolume" yaml:"savedVolume"`
+	UnknownPercent  float64   `json:"unknownPercent" yaml:"unknownPercent"`
+	ValuablePercent float64   `json:"valuablePercent" yaml:"valuablePercent"`
+	WastePercent    float64   `json:"wastePercent" yaml:"wastePercent"`
+	SavedPercent    float64   `json:"savedPercent" yaml:"savedPercent"`
+	PeriodStart     time.Time `json:"periodStart" yaml:"periodStart"`
+	PeriodEnd       time.Time `json:"periodEnd" yaml:"periodEnd"`
+}
+
+// ServiceVolumeStats pairs a service with its volume stats.
+type ServiceVolumeStats struct {
+	ID      string      `json:"id" yaml:"id"`
+	Name    string      `json:"name" yaml:"name"`
+	Enabled bool        `json:"enabled" yaml:"enabled"`
+	Stats   VolumeStats `json:"volumeStats" yaml:"volumeStats"`
+
+	// InitialWeeklyLogCount is the approximate weekly log count seen when the
+	// service was discovered. Nil if discovery didn't report one.
+	InitialWeeklyLogCount *int `json:"initialWeeklyLogCount,omitempty" yaml:"initialWeeklyLogCount,omitempty"`
+}
+
+// AccountVolumeStats contains volume stats for an account and each of its services.
+type AccountVolumeStats struct {
+	AccountID   string               `json:"accountId" yaml:"accountId"`
+	AccountName string               `json:"accountName" yaml:"accountName"`
+	Window      TimeWindow           `json:"window" yaml:"window"`
+	Stats       VolumeStats          `json:"volumeStats" yaml:"volumeStats"`
+	Services    []ServiceVolumeStats `json:"services" yaml:"services"`
+}
+
+// newVolumeStats converts the shared GraphQL fragment to the domain model.
+func newVolumeStats(f client.LogVolumeStats) VolumeStats {
+	return VolumeStats{
+		TotalVolume:     f.TotalVolume,
+		UnknownVolume:   f.UnknownVolume,
+		ValuableVolume:  f.ValuableVolume,
+		WasteVolume:     f.WasteVolume,
+		SavedVolume:     f.SavedVolume,
+		UnknownPercent:  f.UnknownPercent,
+		ValuablePercent: f.ValuablePercent,
+		WastePercent:    f.WastePercent,
+		SavedPercent:    f.SavedPercent,
+		PeriodStart:     f.PeriodStart,
+		PeriodEnd:       f.PeriodEnd,
+	}
+}
+
+// AccountSummary contains the at-a-glance numbers for an account.
+type AccountSummary struct {
+	OrganizationID   string      `json:"organizationId" yaml:"organizationId"`
+	OrganizationName string      `json:"organizationName" yaml:"organizationName"`
+	AccountID        string      `json:"accountId" yaml:"accountId"`
+	AccountName      string      `json:"accountName" yaml:"accountName"`
+	ServicesCount    int         `json:"servicesCount" yaml:"servicesCount"`
+	Day              VolumeStats `json:"day" yaml:"day"`
+	Week             VolumeStats `json:"week" yaml:"week"`
+}
+
+// LogsPerHour returns the average log rate over the last day.
+func (s AccountSummary) LogsPerHour() float64 {
+	return s.Day.TotalVolume / 24
+}
+
+// WasteTrend returns how the last day's waste percentage compares to the
+// week's, in percentage points. Positive means waste is growing.
+func (s AccountSummary) WasteTrend() float64 {
+	return s.Day.WastePercent - s.Week.WastePercent
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/pkg/client"
+)
+
+// WorkspaceService handles workspace-related API operations.
+type WorkspaceService struct {
+	client Client
+	logger log.Logger
+}
+
+// NewWorkspaceService creates a new workspace service.
+func NewWorkspaceService(client Client, logger log.Logger) *WorkspaceService {
+	return &WorkspaceService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// List fetches the workspaces of an account, walking every page unless opts.Limit is set.
+func (s *WorkspaceService) List(ctx context.Context, accountID string, opts ListOptions) ([]Workspace, error) {
+	s.logger.Debug("fetching workspaces from API", "accountID", accountID, "limit", opts.Limit)
+	fetch := func(ctx context.Context, first int, after *string) ([]Workspace, client.PageInfoFields, error) {
+		resp, err := s.client.ListWorkspaces(ctx, accountID, first, after)
+		if err != nil {
+			return nil, client.PageInfoFields{}, err
+		}
+
+		// Convert GraphQL response to domain model
+		workspaces := make([]Workspace, len(resp.Workspaces.Edges))
+		for i, edge := range resp.Workspaces.Edges {
+			workspaces[i] = Workspace{
+				ID:   edge.Node.Id,
+				Name: edge.Node.Name,
+			}
+		}
+		return workspaces, resp.Workspaces.PageInfo.PageInfoFields, nil
+	}
+
+	workspaces, err := client.Collect(client.Paginate(ctx, fetch, opts.Limit))
+	if err != nil {
+		s.logger.Error("failed to fetch workspaces", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	s.logger.Debug("fetched workspaces from API", "count", len(workspaces))
+	return workspaces, nil
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/usetero/cli/internal/log"
@@ -53,6 +54,14 @@ type User struct {
 	LastName      string
 }
 
+// Name returns the user's full name, falling back to their email.
+func (u User) Name() string {
+	if name := strings.TrimSpace(u.FirstName + " " + u.LastName); name != "" {
+		return name
+	}
+	return u.Email
+}
+
 // StartDeviceAuth initiates the device authorization flow.
 func (s *Service) StartDeviceAuth(ctx context.Context) (*DeviceAuth, error) {
 	s.logger.Debug("starting device authorization flow")
@@ -169,6 +178,56 @@ func (s *Service) GetAccessToken(ctx context.Context) (string, error) {
 	return accessToken, nil
 }
 
+// RefreshAccessToken exchanges the stored refresh token for a new access token.
+// Both tokens are replaced in storage since providers rotate refresh tokens.
+// If the provider rejects the refresh token, stored tokens are cleared so the
+// next launch starts a fresh sign-in.
+func (s *Service) RefreshAccessToken(ctx context.Context) (string, error) {
+	result, err := s.RefreshSession(ctx)
+	if err != nil {
+		return "", err
+	}
+	return result.AccessToken, nil
+}
+
+// RefreshSession refreshes the stored tokens like RefreshAccessToken, and also
+// returns the signed-in user.
+func (s *Service) RefreshSession(ctx context.Context) (*Result, error) {
+	refreshToken, err := s.storage.Get("refresh_token")
+	if err != nil {
+		s.logger.Error("failed to get refresh token", "error", err)
+		return nil, err
+	}
+	if refreshToken == "" {
+		return nil, errors.New("no refresh token found")
+	}
+
+	s.logger.Debug("refreshing access token")
+	resp, err := s.provider.RefreshToken(ctx, refreshToken)
+	if err != nil {
+		var invalidGrantErr *InvalidGrantError
+		if errors.As(err, &invalidGrantErr) {
+			s.logger.Warn("refresh token rejected, clearing tokens")
+			_ = s.ClearTokens()
+			return nil, errors.New("session expired - please sign in again")
+		}
+		s.logger.Error("failed to refresh access token", "error", err)
+		return nil, err
+	}
+
+	if err := s.saveTokens(resp.AccessToken, resp.RefreshToken); err != nil {
+		s.logger.Error("failed to save refreshed tokens", "error", err)
+		return nil, err
+	}
+
+	s.logger.Info("refreshed access token")
+	return &Result{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		User:         resp.User,
+	}, nil
+}
+
 // ClearTokens removes all stored authentication tokens.
 func (s *Service) ClearTokens() error {
 	s.logger.Info("clearing authentication tokens")
@@ -2,12 +2,14 @@ package auth
 
 import "context"
 
-// OAuthProvider defines the interface for OAuth device authorization flow.
+// OAuthProvider defines the interface for OAuth device authorization flow
+// and refresh token grants.
 // This allows Service to work with any OAuth provider (WorkOS, Auth0, etc.).
 // Concrete implementation: workos.Client
 type OAuthProvider interface {
 	AuthorizeDevice(ctx context.Context) (*DeviceAuthResponse, error)
 	PollAuthentication(ctx context.Context, deviceCode string) (*AuthenticationResponse, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*AuthenticationResponse, error)
 }
 
 // DeviceAuthResponse represents the response from device authorization.
@@ -56,3 +58,10 @@ type AccessDeniedError struct{}
 func (e *AccessDeniedError) Error() string {
 	return "access denied: user denied authorization"
 }
+
+// InvalidGrantError indicates the refresh token is invalid, expired, or revoked.
+type InvalidGrantError struct{}
+
+func (e *InvalidGrantError) Error() string {
+	return "invalid grant: refresh token is invalid or expired"
+}
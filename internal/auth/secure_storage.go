@@ -3,7 +3,8 @@ package auth
 // SecureStorage defines a generic secure key-value storage interface.
 // This allows services to define domain concepts (like "access_token") while keeping
 // the storage implementation generic (OS keychain, encrypted file, etc.).
-// Concrete implementations: keyring.Keyring (OS keychain)
+// Concrete implementations: keyring.Keyring (OS keychain), securestore.File
+// (encrypted file), securestore.Env (environment variables)
 type SecureStorage interface {
 	// Get retrieves a value by key
 	// Returns empty string if key doesn't exist
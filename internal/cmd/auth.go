@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/pkg/client"
+)
+
+// NewAuthCmd creates the auth command, which groups credential operations.
+func NewAuthCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Inspect how the CLI authenticates",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newAuthStatusCmd(logger, cliConfig))
+
+	return cmd
+}
+
+// newAuthStatusCmd creates the auth status command, which shows which credentials
+// are in use and checks them against the control plane.
+func newAuthStatusCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show which credentials are in use and whether they work",
+		Long: `Show which credentials the CLI is using and check them against the control plane.
+
+Credentials are chosen in this order:
+  1. --token flag
+  2. TERO_API_TOKEN environment variable
+  3. The session stored by signing in with 'tero'
+
+Exits non-zero if there are no credentials or the control plane rejects them,
+so CI jobs can check their token before doing real work.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w := cmd.OutOrStdout()
+			endpoint := apiEndpoint(cmd, cliConfig)
+
+			_, source := apiToken(cmd, cliConfig)
+			if source == credentialsNone {
+				authService, err := newAuthService(cliConfig, logger)
+				if err != nil {
+					return err
+				}
+				if authService.IsAuthenticated() {
+					source = credentialsFromStorage
+				}
+			}
+
+			fmt.Fprintf(w, "Profile:      %s\n", cliConfig.Profile)
+			fmt.Fprintf(w, "Endpoint:     %s\n", endpoint)
+			fmt.Fprintf(w, "Credentials:  %s\n", source)
+			if source == credentialsNone {
+				return errNotAuthenticated
+			}
+
+			tero, err := newAPI(cmd, cliConfig, logger)
+			if err != nil {
+				return err
+			}
+			orgs, err := tero.Organizations.List(cmd.Context(), api.ListOptions{})
+			if errors.Is(err, client.ErrUnauthorized) {
+				fmt.Fprintln(w, "Status:       rejected")
+				return err
+			}
+			if err != nil {
+				return fmt.Errorf("couldn't reach the control plane: %w", err)
+			}
+
+			fmt.Fprintf(w, "Status:       valid (%d organization(s))\n", len(orgs))
+			return nil
+		},
+	}
+}
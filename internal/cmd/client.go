@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/auth"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/demo"
+	"github.com/usetero/cli/internal/diagnostics"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
+	"github.com/usetero/cli/internal/securestore"
+	"github.com/usetero/cli/internal/workos"
+	"github.com/usetero/cli/pkg/client"
+)
+
+// errNotAuthenticated is returned by non-interactive commands when no stored credentials exist.
+var errNotAuthenticated = errors.New("not logged in: run 'tero' to sign in, or set TERO_API_TOKEN")
+
+// openSecrets opens the selected profile's secure storage, chosen by TERO_CREDENTIAL_STORE.
+func openSecrets(cliConfig *config.CLIConfig, logger log.Logger) (auth.SecureStorage, error) {
+	return securestore.Open(cliConfig.CredentialStore, cliConfig.Profile, logger)
+}
+
+// newAuthService creates the auth service backed by WorkOS and the selected profile's secure storage.
+func newAuthService(cliConfig *config.CLIConfig, logger log.Logger) (*auth.Service, error) {
+	secrets, err := openSecrets(cliConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	workosClient := workos.NewClient(workos.DefaultBaseURL, cliConfig.WorkOSClientID)
+	return auth.NewService(workosClient, secrets, logger), nil
+}
+
+// apiEndpoint returns the control plane endpoint: the --endpoint flag if given,
+// otherwise the environment, the selected profile, or the default, in that order.
+// In demo mode it's always the local demo server.
+func apiEndpoint(cmd *cobra.Command, cliConfig *config.CLIConfig) string {
+	if cliConfig.Demo {
+		return cliConfig.APIEndpoint
+	}
+	if flag := cmd.Flags().Lookup("endpoint"); flag != nil && flag.Changed {
+		return flag.Value.String()
+	}
+	return cliConfig.APIEndpoint
+}
+
+// newClientFactory creates the factory for control plane clients, recording
+// each operation for bug reports (see 'tero debug bundle') and tracing them
+// if --trace is set.
+func newClientFactory(cmd *cobra.Command, cliConfig *config.CLIConfig, logger log.Logger) *client.Factory {
+	var opts []client.Option
+
+	operations, err := diagnostics.OpenOperationLog(logger)
+	if err != nil {
+		// Not fatal - bug reports just won't list recent operations
+		logger.Warn("failed to open operation log", "error", err)
+	} else {
+		opts = append(opts, client.WithObserver(operations.Observe))
+	}
+
+	if tracer := newTracer(cmd, cliConfig, logger); tracer != nil {
+		opts = append(opts, client.WithObserver(tracer.Observe))
+	}
+
+	return client.NewFactory(apiEndpoint(cmd, cliConfig), opts...)
+}
+
+// credentialSource is where a command's control plane credentials come from.
+type credentialSource string
+
+const (
+	credentialsFromFlag    credentialSource = "--token flag"
+	credentialsFromEnv     credentialSource = "TERO_API_TOKEN environment variable"
+	credentialsFromStorage credentialSource = "secure storage (signed in with 'tero')"
+	credentialsDemo        credentialSource = "demo mode"
+	credentialsNone        credentialSource = "none"
+)
+
+// apiToken returns the API token from --token or TERO_API_TOKEN, in that order,
+// and where it came from. An empty token means secure storage should be used.
+// Demo mode always uses the demo server's token.
+func apiToken(cmd *cobra.Command, cliConfig *config.CLIConfig) (string, credentialSource) {
+	if cliConfig.Demo {
+		return demo.Token, credentialsDemo
+	}
+	if flag := cmd.Flags().Lookup("token"); flag != nil && flag.Changed {
+		if token := strings.TrimSpace(flag.Value.String()); token != "" {
+			return token, credentialsFromFlag
+		}
+	}
+	if cliConfig.APIToken != "" {
+		return cliConfig.APIToken, credentialsFromEnv
+	}
+	return "", credentialsNone
+}
+
+// newAPIClient creates an authenticated control plane client.
+// An API token (--token or TERO_API_TOKEN) is used as-is, bypassing secure storage;
+// otherwise the stored credentials are used and refreshed as needed.
+// The endpoint comes from apiEndpoint so flag, env, and profile overrides apply.
+func newAPIClient(cmd *cobra.Command, cliConfig *config.CLIConfig, logger log.Logger) (*client.Client, error) {
+	clients := newClientFactory(cmd, cliConfig, logger)
+
+	if token, source := apiToken(cmd, cliConfig); token != "" {
+		logger.Debug("using API token", "source", source)
+		return clients.New(token), nil
+	}
+
+	authService, err := newAuthService(cliConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	if !authService.IsAuthenticated() {
+		return nil, errNotAuthenticated
+	}
+
+	accessToken, err := authService.GetAccessToken(cmd.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.New(accessToken, client.WithTokenRefresher(authService)), nil
+}
+
+// newAPI creates the bundled API services from the stored credentials.
+func newAPI(cmd *cobra.Command, cliConfig *config.CLIConfig, logger log.Logger) (*api.API, error) {
+	apiClient, err := newAPIClient(cmd, cliConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	return api.New(apiClient, logger), nil
+}
+
+// loadPreferences loads the selected profile's preferences from the config file.
+// Demo mode uses in-memory preferences pointing at the demo data instead.
+func loadPreferences(cliConfig *config.CLIConfig, logger log.Logger) (*preferences.Service, error) {
+	if cliConfig.Demo {
+		return demo.Preferences(logger), nil
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := openSecrets(cliConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	prefs := preferences.NewService(cfg.Profile(cliConfig.Profile), secrets, logger)
+	if err := prefs.MigrateSecrets(); err != nil {
+		// Not fatal - the plaintext copy is kept and migration retries next run
+		logger.Warn("failed to migrate secrets to secure storage", "error", err)
+	}
+	return prefs, nil
+}
+
+// selectProfile picks the profile for this run and applies its connection settings.
+// Priority: --profile flag > TERO_PROFILE > the config's current profile.
+func selectProfile(cmd *cobra.Command, cliConfig *config.CLIConfig) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	name := cfg.CurrentProfile()
+	if cliConfig.Profile != "" {
+		name = cliConfig.Profile
+	}
+	if flag := cmd.Flags().Lookup("profile"); flag != nil && flag.Changed {
+		name = flag.Value.String()
+	}
+	if err := config.ValidateProfileName(name); err != nil {
+		return err
+	}
+
+	cliConfig.Profile = name
+	cliConfig.ApplyProfile(cfg.Profile(name))
+	return nil
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/diagnostics"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/version"
+)
+
+// bundleLogs is how many recent logs go into a support bundle
+const bundleLogs = 5
+
+// NewDebugCmd creates the debug command, which groups tools for bug reports.
+func NewDebugCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Collect information for bug reports",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newDebugBundleCmd(logger, cliConfig))
+
+	return cmd
+}
+
+// bundleSettings are the CLI settings in a support bundle. Credentials are
+// only reported as set or not.
+type bundleSettings struct {
+	BuildVersion    string `json:"build_version"`
+	OS              string `json:"os"`
+	Arch            string `json:"arch"`
+	Profile         string `json:"profile"`
+	APIEndpoint     string `json:"api_endpoint"`
+	WorkOSClientID  string `json:"workos_client_id"`
+	APIToken        string `json:"api_token"`
+	CredentialStore string `json:"credential_store"`
+	LogLevel        string `json:"log_level"`
+	LogFormat       string `json:"log_format"`
+	LogFile         string `json:"log_file,omitempty"`
+}
+
+// newDebugBundleCmd creates the debug bundle command, which packages logs,
+// config, and recent activity into one file to attach to a bug report.
+func newDebugBundleCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	var (
+		output     string
+		operations int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Package logs and diagnostics into a tar.gz for a bug report",
+		Long: `Package everything support needs into a single tar.gz:
+
+  - recent logs
+  - ~/.tero/config.yaml, with secrets, IDs, and email addresses masked
+  - the CLI version and settings (credentials are only reported as set or not)
+  - the terminal the interactive UI last ran in
+  - recent control plane operations with timings and errors
+
+Look it over before sending: logs are redacted, but may still mention
+service names and other details of your setup.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			now := time.Now()
+			if output == "" {
+				output = fmt.Sprintf("tero-debug-%s.tar.gz", now.Format("20060102-150405"))
+			}
+
+			bundle := diagnostics.Bundle{
+				Version:   version.Version,
+				Settings:  currentSettings(cmd, cliConfig),
+				CreatedAt: now,
+			}
+
+			var err error
+			if bundle.ConfigPath, err = config.Path(); err != nil {
+				return err
+			}
+			if cliConfig.LogFile != "" {
+				bundle.LogPaths = []string{cliConfig.LogFile}
+			} else if dir, err := log.Dir(); err == nil {
+				if bundle.LogPaths, err = log.Recent(dir, bundleLogs); err != nil {
+					return err
+				}
+			}
+			if bundle.Terminal, err = diagnostics.LoadTerminal(); err != nil {
+				logger.Warn("failed to load recorded terminal", "error", err)
+			}
+			if bundle.Operations, err = diagnostics.RecentOperations(operations); err != nil {
+				logger.Warn("failed to load recent operations", "error", err)
+			}
+
+			f, err := os.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+			if err != nil {
+				return err
+			}
+			if err := diagnostics.Write(f, bundle); err != nil {
+				_ = f.Close()
+				return fmt.Errorf("couldn't write %s: %w", output, err)
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+
+			path, err := filepath.Abs(output)
+			if err != nil {
+				path = output
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Wrote %s\nLook it over, then attach it to your bug report.\n", path)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "File to write (default tero-debug-<time>.tar.gz)")
+	cmd.Flags().IntVar(&operations, "operations", 50, "How many recent control plane operations to include")
+
+	return cmd
+}
+
+// currentSettings returns the settings this run resolved, safe to share
+func currentSettings(cmd *cobra.Command, cliConfig *config.CLIConfig) bundleSettings {
+	token := "unset"
+	if t, source := apiToken(cmd, cliConfig); t != "" {
+		token = fmt.Sprintf("set (%s)", source)
+	}
+	store := cliConfig.CredentialStore
+	if store == "" {
+		store = "auto"
+	}
+	return bundleSettings{
+		BuildVersion:    cmd.Root().Version,
+		OS:              runtime.GOOS,
+		Arch:            runtime.GOARCH,
+		Profile:         cliConfig.Profile,
+		APIEndpoint:     apiEndpoint(cmd, cliConfig),
+		WorkOSClientID:  cliConfig.WorkOSClientID,
+		APIToken:        token,
+		CredentialStore: store,
+		LogLevel:        cliConfig.LogLevel,
+		LogFormat:       cliConfig.LogFormat,
+		LogFile:         cliConfig.LogFile,
+	}
+}
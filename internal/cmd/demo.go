@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/demo"
+	"github.com/usetero/cli/internal/log"
+)
+
+// applyDemo starts the demo server when --demo or TERO_DEMO is set and points
+// this run at it. The server lives until the process exits.
+func applyDemo(cmd *cobra.Command, cliConfig *config.CLIConfig, logger log.Logger) error {
+	if flag := cmd.Flags().Lookup("demo"); flag != nil && flag.Changed {
+		cliConfig.Demo = flag.Value.String() == "true"
+	}
+	if !cliConfig.Demo {
+		return nil
+	}
+
+	srv, err := demo.Start(logger)
+	if err != nil {
+		return fmt.Errorf("couldn't start the demo server: %w", err)
+	}
+	cliConfig.APIEndpoint = srv.URL
+
+	// The UI shows its own banner; commands print it where it won't mix with output
+	if cmd != cmd.Root() {
+		fmt.Fprintln(cmd.ErrOrStderr(), demo.Banner)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/doctor"
+	"github.com/usetero/cli/internal/keyring"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
+	"github.com/usetero/cli/internal/securestore"
+	"github.com/usetero/cli/internal/tui"
+	"github.com/usetero/cli/internal/workos"
+	"github.com/usetero/cli/pkg/client"
+)
+
+// doctorTimeout bounds each network check so an unreachable host doesn't hang the report
+const doctorTimeout = 10 * time.Second
+
+// NewDoctorCmd creates the doctor command, which checks the environment and
+// reports problems with hints for fixing them.
+func NewDoctorCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check your environment for problems",
+		Long: `Check everything the CLI depends on and print pass, warn, or fail for each,
+with a hint for fixing anything that's wrong:
+
+  - secure storage for your sign-in (the OS keyring or encrypted file)
+  - the control plane endpoint and WorkOS respond
+  - your credentials are accepted
+  - ~/.tero/config.yaml parses and is private
+  - the terminal is big enough for the interactive UI
+  - your default organization and account still exist
+
+Exits non-zero if any check fails. Attach the output of 'tero doctor --json'
+to support tickets.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			results := runDoctor(cmd, cliConfig, logger)
+
+			w := cmd.OutOrStdout()
+			var err error
+			if jsonOutput {
+				err = doctor.WriteJSON(w, results)
+			} else {
+				err = doctor.WriteText(w, results)
+			}
+			if err != nil {
+				return err
+			}
+
+			if n := doctor.Failures(results); n > 0 {
+				return fmt.Errorf("%d check(s) failed", n)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Print results as JSON")
+
+	return cmd
+}
+
+// runDoctor runs every check in order. Later checks that need credentials are
+// skipped when the credentials check fails.
+func runDoctor(cmd *cobra.Command, cliConfig *config.CLIConfig, logger log.Logger) []doctor.Result {
+	ctx := cmd.Context()
+	httpClient := &http.Client{Timeout: doctorTimeout}
+	endpoint := apiEndpoint(cmd, cliConfig)
+
+	results := []doctor.Result{
+		checkSecureStorage(cliConfig, logger),
+		doctor.CheckURL(ctx, httpClient, "API endpoint", endpoint,
+			"Check --endpoint, TERO_API_ENDPOINT, or the profile's endpoint, and your network or proxy settings"),
+	}
+	if cliConfig.Demo {
+		results = append(results, doctor.Pass("WorkOS", "skipped: demo mode doesn't sign in"))
+	} else {
+		results = append(results, doctor.CheckURL(ctx, httpClient, "WorkOS", workos.DefaultBaseURL,
+			"Sign-in needs api.workos.com; check your network or proxy settings"))
+	}
+
+	tero, credentials := checkCredentials(cmd, cliConfig, logger)
+	results = append(results, credentials)
+
+	configPath, err := config.Path()
+	if err != nil {
+		results = append(results, doctor.Fail("Config file", err.Error(), "Set HOME to your home directory"))
+	} else {
+		results = append(results, doctor.CheckConfigFile("Config file", configPath, func() error {
+			_, err := config.Load()
+			return err
+		}))
+	}
+
+	results = append(results, doctor.CheckTerminal("Terminal", []uintptr{os.Stdin.Fd(), os.Stderr.Fd(), os.Stdout.Fd()}, tui.MinWidth, tui.MinHeight))
+
+	if tero == nil {
+		return append(results, doctor.Warn("Defaults", "skipped: needs working credentials", ""))
+	}
+	prefs, err := loadPreferences(cliConfig, logger)
+	if err != nil {
+		return append(results, doctor.Fail("Defaults", fmt.Sprintf("can't load preferences: %v", err), "See the config file check"))
+	}
+	return append(results, checkDefaults(ctx, tero, prefs))
+}
+
+// checkSecureStorage checks that sign-ins can be stored, and whether that's
+// in the OS keyring or the encrypted file fallback
+func checkSecureStorage(cliConfig *config.CLIConfig, logger log.Logger) doctor.Result {
+	const name = "Secure storage"
+
+	if cliConfig.Demo {
+		return doctor.Pass(name, "in memory (demo mode)")
+	}
+	switch cliConfig.CredentialStore {
+	case securestore.ModeEnv:
+		return doctor.Pass(name, "reading secrets from TERO_* environment variables (TERO_CREDENTIAL_STORE=env)")
+	case securestore.ModeKeyring:
+		if err := keyring.New(cliConfig.Profile).Available(); err != nil {
+			return doctor.Fail(name, fmt.Sprintf("OS keyring unavailable: %v", err),
+				"Start a Secret Service such as gnome-keyring, or unset TERO_CREDENTIAL_STORE to use an encrypted file")
+		}
+		return doctor.Pass(name, "OS keyring reachable")
+	case securestore.ModeFile:
+		return checkCredentialFile(name, cliConfig, logger, "TERO_CREDENTIAL_STORE=file")
+	default:
+		err := keyring.New(cliConfig.Profile).Available()
+		if err == nil {
+			return doctor.Pass(name, "OS keyring reachable")
+		}
+		why := fmt.Sprintf("OS keyring unavailable: %v", err)
+		if store, err := securestore.Open(securestore.ModeAuto, cliConfig.Profile, logger); err == nil {
+			if _, ok := store.(*securestore.Env); ok {
+				// No machine ID to key the file with, so auto mode reads the environment
+				return doctor.Warn(name, why+"; no machine ID to encrypt credentials with, so sign-ins aren't saved",
+					"Set TERO_CREDENTIAL_PASSPHRASE to save sign-ins in an encrypted file")
+			}
+		}
+		result := checkCredentialFile(name, cliConfig, logger, why)
+		if result.Status == doctor.StatusPass {
+			result = doctor.Warn(name, result.Message, "Set TERO_CREDENTIAL_PASSPHRASE so the file isn't keyed only to this machine")
+		}
+		return result
+	}
+}
+
+// checkCredentialFile checks that the encrypted credentials file can be keyed
+func checkCredentialFile(name string, cliConfig *config.CLIConfig, logger log.Logger, why string) doctor.Result {
+	if _, err := securestore.Open(securestore.ModeFile, cliConfig.Profile, logger); err != nil {
+		return doctor.Fail(name, fmt.Sprintf("%s; encrypted file unusable: %v", why, err),
+			"Set TERO_CREDENTIAL_PASSPHRASE, or TERO_CREDENTIAL_STORE=env with TERO_API_TOKEN")
+	}
+	path, _ := securestore.FilePath(cliConfig.Profile)
+	return doctor.Pass(name, fmt.Sprintf("using encrypted file %s (%s)", path, why))
+}
+
+// checkCredentials checks the credentials with a cheap query, returning the
+// API for later checks if they work
+func checkCredentials(cmd *cobra.Command, cliConfig *config.CLIConfig, logger log.Logger) (*api.API, doctor.Result) {
+	const name = "Credentials"
+
+	tero, err := newAPI(cmd, cliConfig, logger)
+	if errors.Is(err, errNotAuthenticated) {
+		return nil, doctor.Warn(name, "not signed in", "Run 'tero' to sign in, or set TERO_API_TOKEN")
+	}
+	if err != nil {
+		return nil, doctor.Fail(name, fmt.Sprintf("can't load credentials: %v", err), "Run 'tero' to sign in again")
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), doctorTimeout)
+	defer cancel()
+	_, err = tero.Organizations.List(ctx, api.ListOptions{Limit: 1})
+	if errors.Is(err, client.ErrUnauthorized) {
+		return nil, doctor.Fail(name, "rejected by the control plane", "Check --token or TERO_API_TOKEN, or run 'tero' to sign in again")
+	}
+	if err != nil {
+		return nil, doctor.Fail(name, fmt.Sprintf("couldn't check credentials: %v", err), "See the API endpoint check")
+	}
+
+	_, source := apiToken(cmd, cliConfig)
+	if source == credentialsNone {
+		source = credentialsFromStorage
+	}
+	return tero, doctor.Pass(name, fmt.Sprintf("accepted (%s)", source))
+}
+
+// checkDefaults checks that the organization and account chosen during setup
+// still exist and are visible to the signed-in user
+func checkDefaults(ctx context.Context, tero *api.API, prefs *preferences.Service) doctor.Result {
+	const name = "Defaults"
+	const rechoose = "Run 'tero' to choose again"
+
+	orgID := prefs.GetDefaultOrgID()
+	accountID := prefs.GetDefaultAccountID()
+	if orgID == "" {
+		return doctor.Warn(name, "no default organization yet", "Run 'tero' to finish setup")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, doctorTimeout)
+	defer cancel()
+
+	orgs, err := tero.Organizations.List(ctx, api.ListOptions{})
+	if err != nil {
+		return doctor.Fail(name, fmt.Sprintf("couldn't list organizations: %v", err), "")
+	}
+	if !slices.ContainsFunc(orgs, func(o api.Organization) bool { return o.ID == orgID }) {
+		return doctor.Fail(name, fmt.Sprintf("default organization %s no longer exists or you lost access", orgID), rechoose)
+	}
+
+	if accountID == "" {
+		return doctor.Warn(name, fmt.Sprintf("organization %s resolves, but there's no default account yet", orgID), "Run 'tero' to finish setup")
+	}
+	accounts, err := tero.Accounts.List(ctx, orgID, api.ListOptions{})
+	if err != nil {
+		return doctor.Fail(name, fmt.Sprintf("couldn't list accounts: %v", err), "")
+	}
+	if !slices.ContainsFunc(accounts, func(a api.Account) bool { return a.ID == accountID }) {
+		return doctor.Fail(name, fmt.Sprintf("default account %s no longer exists in organization %s", accountID, orgID), rechoose)
+	}
+	return doctor.Pass(name, fmt.Sprintf("organization %s and account %s resolve", orgID, accountID))
+}
@@ -1,29 +1,56 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 	"runtime/debug"
 
-	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/pkg/client"
 )
 
+// exitError makes the process exit with a specific code, for failures
+// scripts may want to tell apart
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+func (e *exitError) Unwrap() error { return e.err }
+
 // Execute runs the root command
 func Execute(version string) {
-	// Create logger once at the top level
-	logger := log.New()
+	// Load CLI configuration (env vars + smart defaults)
+	cliConfig := config.LoadCLIConfig(version)
+
+	// Create logger once at the top level; flags adjust its level once parsed
+	logLevel := new(slog.LevelVar)
+	logger, closeLog := newLogger(cliConfig, logLevel)
 
 	defer func() {
 		if r := recover(); r != nil {
 			logger.Error("panic recovered", "panic", r, "stack", string(debug.Stack()))
+			closeLog()
 			fmt.Fprintf(os.Stderr, "Fatal error: %v\n", r)
 			os.Exit(1)
 		}
 	}()
 
-	rootCmd := NewRootCmd(logger, version)
-	if err := rootCmd.Execute(); err != nil {
+	rootCmd := NewRootCmd(logger, logLevel, cliConfig, version)
+	err := rootCmd.Execute()
+	closeLog()
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if errors.Is(err, client.ErrUnauthorized) {
+			fmt.Fprintln(os.Stderr, "Check the token passed with --token or TERO_API_TOKEN, or run 'tero' to sign in again.")
+		}
+		var exitErr *exitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.code)
+		}
 		os.Exit(1)
 	}
 }
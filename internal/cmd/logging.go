@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/diagnostics"
+	"github.com/usetero/cli/internal/log"
+)
+
+// newLogger opens the log file configured by the environment. Logging is
+// disabled rather than failing the command if the file can't be opened.
+// The returned func closes the file.
+func newLogger(cliConfig *config.CLIConfig, level *slog.LevelVar) (log.Logger, func()) {
+	// An invalid TERO_LOG_LEVEL is reported by applyLogLevel once flags are parsed
+	if l, err := log.ParseLevel(cliConfig.LogLevel); err == nil {
+		level.Set(l)
+	}
+
+	logger, file, err := log.New(log.Options{
+		Path:   cliConfig.LogFile,
+		Level:  level,
+		Format: log.Format(cliConfig.LogFormat),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: logging disabled: %v\n", err)
+		return log.Discard(), func() {}
+	}
+	return logger, func() { _ = file.Close() }
+}
+
+// applyLogLevel sets the log level once flags are parsed.
+// Priority: --log-level > --debug > TERO_LOG_LEVEL > TERO_DEBUG > info.
+func applyLogLevel(cmd *cobra.Command, cliConfig *config.CLIConfig, level *slog.LevelVar) error {
+	name := cliConfig.LogLevel
+	if flag := cmd.Flags().Lookup("debug"); flag != nil && flag.Changed && flag.Value.String() == "true" {
+		name = "debug"
+	}
+	if flag := cmd.Flags().Lookup("log-level"); flag != nil && flag.Changed {
+		name = flag.Value.String()
+	}
+
+	l, err := log.ParseLevel(name)
+	if err != nil {
+		return err
+	}
+	level.Set(l)
+	return nil
+}
+
+// applyTrace sets where to trace control plane operations once flags are parsed.
+// Priority: --trace > TERO_TRACE.
+func applyTrace(cmd *cobra.Command, cliConfig *config.CLIConfig) error {
+	flag := cmd.Flags().Lookup("trace")
+	if flag == nil || !flag.Changed {
+		return nil
+	}
+	switch dest := flag.Value.String(); dest {
+	case "log", "stderr":
+		cliConfig.Trace = dest
+	case "off":
+		cliConfig.Trace = ""
+	default:
+		return fmt.Errorf("invalid --trace %q: use log, stderr, or off", dest)
+	}
+	return nil
+}
+
+// newTracer returns the tracer for cliConfig.Trace, or nil when tracing is off.
+func newTracer(cmd *cobra.Command, cliConfig *config.CLIConfig, logger log.Logger) *diagnostics.Tracer {
+	switch cliConfig.Trace {
+	case "log":
+		return diagnostics.NewTracer(logger)
+	case "stderr":
+		return diagnostics.NewTracer(slog.New(log.NewRedactHandler(slog.NewTextHandler(cmd.ErrOrStderr(), nil))))
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/mcp"
+)
+
+// NewMCPCmd creates the mcp command, which serves Tero's tools to coding agents over stdio.
+func NewMCPCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "mcp",
+		Short: "Run an MCP server for coding agents",
+		Long: `Run a Model Context Protocol server over stdio so coding agents
+(Claude Desktop, Cursor, and others) can ask Tero about your services,
+log events, waste, and rules.
+
+Sign in with 'tero' first; the server uses the same stored credentials.
+
+Example agent configuration:
+
+  {
+    "mcpServers": {
+      "tero": { "command": "tero", "args": ["mcp"] }
+    }
+  }`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tero, err := newAPI(cmd, cliConfig, logger)
+			if err != nil {
+				return err
+			}
+
+			server := mcp.NewServer("tero", cmd.Root().Version, logger)
+			mcp.RegisterTools(server, tero)
+
+			return server.Serve(cmd.Context(), os.Stdin, os.Stdout)
+		},
+	}
+}
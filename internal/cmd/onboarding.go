@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/log"
+)
+
+// NewOnboardingCmd creates the onboarding command, which manages onboarding progress.
+func NewOnboardingCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "onboarding",
+		Short: "Manage onboarding progress",
+		Long: `Onboarding saves its progress as each step completes, so quitting partway
+through resumes where you left off the next time you run 'tero'.`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newOnboardingResetCmd(logger, cliConfig))
+
+	return cmd
+}
+
+// newOnboardingResetCmd creates the onboarding reset command, which forgets
+// saved progress so the next run starts over.
+func newOnboardingResetCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reset",
+		Short: "Start onboarding over",
+		Long: `Forget onboarding progress for the current profile: your role, default
+organization and account, and any Datadog API key saved partway through
+setup. The next run of 'tero' starts onboarding from the beginning.
+
+You stay signed in, and nothing is changed in Tero or Datadog.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefs, err := loadPreferences(cliConfig, logger)
+			if err != nil {
+				return err
+			}
+			if err := prefs.ClearOnboardingProgress(); err != nil {
+				return fmt.Errorf("couldn't reset onboarding: %w", err)
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), "Onboarding reset. Run 'tero' to start over.")
+			return err
+		},
+	}
+}
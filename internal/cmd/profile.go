@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
+)
+
+// NewProfileCmd creates the profile command, which manages named profiles.
+// Each profile has its own endpoint, WorkOS client ID, sign-in, and preferences.
+func NewProfileCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage profiles for different endpoints, orgs, and identities",
+		Long: `Profiles keep separate sign-ins and preferences, so signing in to a local
+control plane doesn't replace your production session.
+
+Use a profile for one command with --profile or TERO_PROFILE:
+
+  tero --profile staging status`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newProfileListCmd())
+	cmd.AddCommand(newProfileUseCmd())
+	cmd.AddCommand(newProfileDeleteCmd(logger, cliConfig))
+
+	return cmd
+}
+
+// newProfileListCmd creates the profile list command.
+func newProfileListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List profiles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			names := cfg.ProfileNames()
+			if len(names) == 0 {
+				_, err := fmt.Fprintf(cmd.OutOrStdout(), "No profiles yet. Run 'tero' to sign in to the %q profile.\n", config.DefaultProfile)
+				return err
+			}
+
+			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+			fmt.Fprintln(tw, "CURRENT\tNAME\tENDPOINT\tEMAIL")
+			for _, name := range names {
+				profile := cfg.Profile(name)
+				current := ""
+				if name == cfg.CurrentProfile() {
+					current = "*"
+				}
+				endpoint := profile.Endpoint()
+				if endpoint == "" {
+					endpoint = "(default)"
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", current, name, endpoint, profile.Get("email"))
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newProfileUseCmd creates the profile use command, which switches the current
+// profile, creating it if needed.
+func newProfileUseCmd() *cobra.Command {
+	var workosClientID string
+
+	cmd := &cobra.Command{
+		Use:   "use <name>",
+		Short: "Switch to a profile, creating it if needed",
+		Long: `Switch to a profile, creating it if needed. Pass --endpoint or
+--workos-client-id to save connection settings with the profile.
+
+  tero profile use local --endpoint http://localhost:8081/graphql`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if err := config.ValidateProfileName(name); err != nil {
+				return err
+			}
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			created := !cfg.HasProfile(name)
+			profile := cfg.Profile(name)
+			if flag := cmd.Flags().Lookup("endpoint"); flag != nil && flag.Changed {
+				profile.SetEndpoint(flag.Value.String())
+			}
+			if workosClientID != "" {
+				profile.SetWorkOSClientID(workosClientID)
+			}
+			cfg.SetCurrentProfile(name)
+			if err := cfg.Save(); err != nil {
+				return err
+			}
+
+			if created {
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "Created and switched to profile %q. Run 'tero' to sign in.\n", name)
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Switched to profile %q.\n", name)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&workosClientID, "workos-client-id", "", "WorkOS client ID to save with the profile")
+
+	return cmd
+}
+
+// newProfileDeleteCmd creates the profile delete command, which removes a
+// profile's preferences and its stored credentials.
+func newProfileDeleteCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a profile and sign out of it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+			if !cfg.HasProfile(name) {
+				return fmt.Errorf("profile %q not found", name)
+			}
+			if name == cfg.CurrentProfile() {
+				return fmt.Errorf("profile %q is the current profile: switch to another with 'tero profile use' first", name)
+			}
+
+			// Remove secrets before the profile so a failure leaves nothing orphaned
+			profileConfig := *cliConfig
+			profileConfig.Profile = name
+			secrets, err := openSecrets(&profileConfig, logger)
+			if err != nil {
+				return err
+			}
+			authService, err := newAuthService(&profileConfig, logger)
+			if err != nil {
+				return err
+			}
+			if err := authService.ClearTokens(); err != nil {
+				return fmt.Errorf("couldn't remove the profile's stored sign-in: %w", err)
+			}
+			prefs := preferences.NewService(cfg.Profile(name), secrets, logger)
+			if err := prefs.ClearDatadogAPIKey(); err != nil {
+				return fmt.Errorf("couldn't remove the profile's Datadog API key: %w", err)
+			}
+
+			cfg.DeleteProfile(name)
+			if err := cfg.Save(); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Deleted profile %q.\n", name)
+			return err
+		},
+	}
+}
@@ -1,51 +1,107 @@
 package cmd
 
 import (
+	"fmt"
+	"log/slog"
+
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/spf13/cobra"
 	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/demo"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/securestore"
 	"github.com/usetero/cli/internal/tui"
 )
 
-func NewRootCmd(logger log.Logger, version string) *cobra.Command {
-	// Load CLI configuration (env vars + smart defaults)
-	cliConfig := config.LoadCLIConfig(version)
-
+// NewRootCmd creates the root command. logLevel is the logger's level, set from
+// --log-level and --debug once flags are parsed.
+func NewRootCmd(logger log.Logger, logLevel *slog.LevelVar, cliConfig *config.CLIConfig, version string) *cobra.Command {
 	rootCmd := &cobra.Command{
 		Use:     "tero",
 		Short:   "Tero - Your telemetry quality platform",
 		Version: version,
+		// Execute reports errors itself; usage is noise once a command has started
+		SilenceUsage:  true,
+		SilenceErrors: true,
 		Long: `Tero is a telemetry quality platform that helps you understand and improve
 your observability data across all your tools.
 
 Just run 'tero' to start an interactive chat session.`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := applyLogLevel(cmd, cliConfig, logLevel); err != nil {
+				return err
+			}
+			if err := applyTrace(cmd, cliConfig); err != nil {
+				return err
+			}
+			if err := selectProfile(cmd, cliConfig); err != nil {
+				return err
+			}
+			return applyDemo(cmd, cliConfig, logger)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// Anything on stderr would garble the UI
+			if cliConfig.Trace == "stderr" {
+				fmt.Fprintln(cmd.ErrOrStderr(), "Tracing to the log file instead of stderr while the UI runs.")
+				cliConfig.Trace = "log"
+			}
+			clients := newClientFactory(cmd, cliConfig, logger)
+
+			// Demo data needs no sign-in and leaves saved preferences alone
+			if cliConfig.Demo {
+				return runTUI(tui.NewDemo(demo.Profile(), securestore.NewMemory(), clients, demo.Token, logger), logger)
+			}
+
 			// Load user preferences
 			cfg, err := config.Load()
 			if err != nil {
 				return err
 			}
 
-			// Get endpoint from flag (allows override of env var/default)
-			endpoint, _ := cmd.Flags().GetString("endpoint")
-
-			// Create and run the TUI
-			p := tea.NewProgram(tui.New(cfg, endpoint, cliConfig.WorkOSClientID, logger))
-			if _, err := p.Run(); err != nil {
-				logger.Error("bubbletea program error", "error", err)
+			// Secure storage for tokens and secrets, isolated per profile
+			secrets, err := openSecrets(cliConfig, logger)
+			if err != nil {
 				return err
 			}
-			return nil
+
+			// An API token skips the interactive sign-in
+			token, _ := apiToken(cmd, cliConfig)
+
+			return runTUI(tui.New(cfg.Profile(cliConfig.Profile), secrets, clients, cliConfig.WorkOSClientID, token, logger), logger)
 		},
 	}
 
 	// Global flags with defaults from CLI config
-	rootCmd.PersistentFlags().String("endpoint", cliConfig.APIEndpoint, "Tero control plane endpoint")
-	rootCmd.PersistentFlags().BoolP("debug", "d", cliConfig.Debug, "Enable debug logging")
+	rootCmd.PersistentFlags().String("endpoint", cliConfig.APIEndpoint, "Tero control plane endpoint (overrides the profile's)")
+	rootCmd.PersistentFlags().String("profile", "", "Profile to use (or set TERO_PROFILE; defaults to the current profile)")
+	rootCmd.PersistentFlags().BoolP("debug", "d", cliConfig.Debug, "Enable debug logging (same as --log-level debug)")
+	rootCmd.PersistentFlags().String("log-level", "", "Log level: debug, info, warn, or error (or set TERO_LOG_LEVEL)")
+	rootCmd.PersistentFlags().Bool("demo", cliConfig.Demo, "Explore bundled sample data offline, without signing in (or set TERO_DEMO)")
+	rootCmd.PersistentFlags().String("trace", "", "Trace control plane operations to the log or stderr (or set TERO_TRACE)")
+	rootCmd.PersistentFlags().Lookup("trace").NoOptDefVal = "log"
+	// No default shown: it would print TERO_API_TOKEN in --help
+	rootCmd.PersistentFlags().String("token", "", "API token for CI and service accounts (or set TERO_API_TOKEN)")
 
-	// Subcommands (add later)
-	// rootCmd.AddCommand(NewMCPCmd())
+	// Subcommands
+	rootCmd.AddCommand(NewAuthCmd(logger, cliConfig))
+	rootCmd.AddCommand(NewDebugCmd(logger, cliConfig))
+	rootCmd.AddCommand(NewDoctorCmd(logger, cliConfig))
+	rootCmd.AddCommand(NewStatusCmd(logger, cliConfig))
+	rootCmd.AddCommand(NewMCPCmd(logger, cliConfig))
+	rootCmd.AddCommand(NewOnboardingCmd(logger, cliConfig))
+	rootCmd.AddCommand(NewProfileCmd(logger, cliConfig))
+	rootCmd.AddCommand(NewRulesCmd(logger, cliConfig))
+	rootCmd.AddCommand(NewSetupCmd(logger, cliConfig))
 
 	return rootCmd
 }
+
+// runTUI runs the interactive UI until the user quits
+func runTUI(model tea.Model, logger log.Logger) error {
+	p := tea.NewProgram(model)
+	if _, err := p.Run(); err != nil {
+		logger.Error("bubbletea program error", "error", err)
+		return err
+	}
+	return nil
+}
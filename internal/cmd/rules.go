@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/export"
+	"github.com/usetero/cli/internal/log"
+)
+
+// NewRulesCmd creates the rules command, which groups operations on a workspace's log rules.
+func NewRulesCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rules",
+		Short: "Work with your workspace's log rules",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newRulesExportCmd(logger, cliConfig))
+
+	return cmd
+}
+
+// newRulesExportCmd creates the rules export command, which writes a workspace's
+// rules as configuration for the tools that enforce them.
+func newRulesExportCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	var (
+		format         string
+		syntax         string
+		input          string
+		eventAttribute string
+		workspaceID    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export log rules as pipeline configuration",
+		Long: `Export your workspace's active log rules as configuration for the tools
+that enforce them, so Tero's keep/drop decisions live alongside the rest of
+your pipeline and infrastructure config.
+
+Formats:
+  vector     Vector transforms: a filter per drop rule and a remap per VRL script,
+             chained from --input and ending in "` + export.VectorOutput + `"
+  terraform  datadog_logs_index resources with an exclusion_filter per drop rule
+             deployed (or pending) on each index
+  otelcol    An OpenTelemetry Collector filter processor ("` + export.OTelColProcessor + `") with an
+             OTTL condition per drop rule, keyed by service.name and the log
+             attribute named by --event-attribute (rules are skipped without it)
+
+Output goes to stdout:
+
+  tero rules export --format vector > /etc/vector/tero.yaml
+  tero rules export --format terraform > datadog_tero.tf
+  tero rules export --format otelcol --event-attribute event.name > otelcol-tero.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "vector" && format != "terraform" && format != "otelcol" {
+				return fmt.Errorf("invalid format %q (expected vector, terraform, or otelcol)", format)
+			}
+			fileSyntax, err := export.ParseSyntax(syntax)
+			if err != nil {
+				return err
+			}
+
+			if workspaceID == "" {
+				prefs, err := loadPreferences(cliConfig, logger)
+				if err != nil {
+					return err
+				}
+				workspaceID = prefs.GetDefaultWorkspaceID()
+			}
+			if workspaceID == "" {
+				return errors.New("no default workspace: run 'tero' to finish setup or pass --workspace")
+			}
+
+			tero, err := newAPI(cmd, cliConfig, logger)
+			if err != nil {
+				return err
+			}
+
+			rules, err := tero.LogRules.ListForWorkspace(cmd.Context(), workspaceID, api.ListOptions{})
+			if err != nil {
+				return err
+			}
+
+			switch format {
+			case "terraform":
+				return export.Terraform(cmd.OutOrStdout(), rules, export.TerraformOptions{Workspace: workspaceID})
+			case "otelcol":
+				return export.OTelCol(cmd.OutOrStdout(), rules, export.OTelColOptions{
+					Workspace:      workspaceID,
+					EventAttribute: eventAttribute,
+				})
+			default:
+				return export.Vector(cmd.OutOrStdout(), rules, export.VectorOptions{
+					Syntax:    fileSyntax,
+					Input:     input,
+					Workspace: workspaceID,
+				})
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "", "Output format: vector, terraform, or otelcol")
+	cmd.Flags().StringVar(&syntax, "syntax", "yaml", "Config file syntax for vector: yaml or toml")
+	cmd.Flags().StringVar(&input, "input", "datadog_agent", "Vector component the rules read from")
+	cmd.Flags().StringVar(&eventAttribute, "event-attribute", "", "Log attribute holding the log event name, for otelcol")
+	cmd.Flags().StringVar(&workspaceID, "workspace", "", "Workspace ID (defaults to the workspace chosen in the app)")
+	_ = cmd.MarkFlagRequired("format")
+
+	return cmd
+}
@@ -0,0 +1,398 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/datadog"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
+	"github.com/usetero/cli/internal/tui/onboarding/role"
+	"github.com/usetero/cli/internal/tui/onboarding/step"
+	"github.com/usetero/cli/pkg/client"
+)
+
+// Exit codes for setup failures scripts may want to handle differently
+const (
+	exitInvalidKeys     = 3
+	exitDiscoveryFailed = 4
+)
+
+// setupPollInterval is how often setup checks discovery progress
+var setupPollInterval = 2 * time.Second
+
+// setupOptions are the choices onboarding would otherwise ask for
+type setupOptions struct {
+	org              string
+	account          string
+	role             string
+	datadogSite      string
+	datadogAPIKey    string
+	datadogAppKey    string
+	waitForDiscovery bool
+	discoveryTimeout time.Duration
+}
+
+// NewSetupCmd creates the setup command, which runs onboarding from flags.
+func NewSetupCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	var (
+		opts      setupOptions
+		apiKeyEnv string
+		appKeyEnv string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "setup",
+		Short: "Set up an organization, account, and Datadog connection without prompts",
+		Long: `Do what onboarding in 'tero' does, from flags, for provisioning teams in
+scripts and CI. An organization or account with the given name is used if it
+exists and created otherwise, so setup is safe to run again.
+
+Datadog keys are read from the environment variables you name, so they stay
+out of shell history and process listings:
+
+  tero setup --org Acme --account Production --role platform \
+    --datadog-site US5 --datadog-api-key-env DD_API_KEY --datadog-app-key-env DD_APP_KEY
+
+Exit codes:
+  0  Setup finished
+  1  Any other error (bad flags, not signed in, control plane unreachable)
+  3  Datadog rejected the API or application key
+  4  Discovery failed or didn't finish within --discovery-timeout`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			var err error
+			if opts.datadogAPIKey, err = readKeyEnv("--datadog-api-key-env", apiKeyEnv); err != nil {
+				return err
+			}
+			if opts.datadogAppKey, err = readKeyEnv("--datadog-app-key-env", appKeyEnv); err != nil {
+				return err
+			}
+
+			tero, err := newAPI(cmd, cliConfig, logger)
+			if err != nil {
+				return err
+			}
+			prefs, err := loadPreferences(cliConfig, logger)
+			if err != nil {
+				return err
+			}
+
+			progress, setupErr := runSetup(cmd.Context(), cmd.OutOrStdout(), tero, opts)
+
+			// Save whatever finished, so 'tero' resumes onboarding from there
+			if progress.Step != "" {
+				if err := prefs.SetOnboardingProgress(progress); err != nil {
+					logger.Warn("failed to save onboarding progress", "error", err)
+				}
+			}
+			if setupErr != nil {
+				return setupErr
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), "Setup complete. Run 'tero' to explore your account.")
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.org, "org", "", "Organization name, created if it doesn't exist")
+	cmd.Flags().StringVar(&opts.account, "account", "", "Account name, created if it doesn't exist")
+	cmd.Flags().StringVar(&opts.role, "role", "", "Your role: platform or engineer")
+	cmd.Flags().StringVar(&opts.datadogSite, "datadog-site", "", "Datadog site: "+strings.Join(datadogSites(), ", "))
+	cmd.Flags().StringVar(&apiKeyEnv, "datadog-api-key-env", "", "Environment variable holding the Datadog API key")
+	cmd.Flags().StringVar(&appKeyEnv, "datadog-app-key-env", "", "Environment variable holding the Datadog application key")
+	cmd.Flags().BoolVar(&opts.waitForDiscovery, "wait-for-discovery", false, "Wait for service and log event discovery to finish")
+	cmd.Flags().DurationVar(&opts.discoveryTimeout, "discovery-timeout", 30*time.Minute, "How long --wait-for-discovery waits")
+	for _, name := range []string{"org", "account", "role", "datadog-site", "datadog-api-key-env", "datadog-app-key-env"} {
+		_ = cmd.MarkFlagRequired(name)
+	}
+
+	return cmd
+}
+
+// validate checks the flags that don't need the environment or control plane
+func (o *setupOptions) validate() error {
+	if o.role != role.Platform && o.role != role.Engineer {
+		return fmt.Errorf("invalid role %q (expected %s or %s)", o.role, role.Platform, role.Engineer)
+	}
+
+	o.datadogSite = strings.ToUpper(o.datadogSite)
+	for _, site := range datadogSites() {
+		if o.datadogSite == site {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid Datadog site %q (expected one of %s)", o.datadogSite, strings.Join(datadogSites(), ", "))
+}
+
+// datadogSites returns the Datadog sites setup accepts
+func datadogSites() []string {
+	regions := datadog.GetRegions()
+	sites := make([]string, len(regions))
+	for i, r := range regions {
+		sites[i] = r.Site
+	}
+	return sites
+}
+
+// readKeyEnv reads a Datadog key from the environment variable named by flag
+func readKeyEnv(flag, name string) (string, error) {
+	value := strings.TrimSpace(os.Getenv(name))
+	if value == "" {
+		return "", fmt.Errorf("%s: environment variable %s is empty or unset", flag, name)
+	}
+	return value, nil
+}
+
+// runSetup drives onboarding through the same control plane calls as the
+// interactive steps, printing a line as each finishes. It returns the progress
+// reached, even on error.
+func runSetup(ctx context.Context, w io.Writer, tero *api.API, opts setupOptions) (preferences.OnboardingProgress, error) {
+	progress := preferences.OnboardingProgress{Role: opts.role}
+
+	org, bootstrap, err := findOrCreateOrg(ctx, tero, opts.org)
+	if err != nil {
+		return progress, err
+	}
+	progress.Step, progress.OrgID = step.CheckpointOrganization, org.ID
+	if bootstrap != nil {
+		fmt.Fprintf(w, "Created organization %s (%s)\n", org.Name, org.ID)
+	} else {
+		fmt.Fprintf(w, "Using organization %s (%s)\n", org.Name, org.ID)
+	}
+
+	var account *api.Account
+	created := bootstrap != nil
+	if created {
+		// Creating the organization created its first account too, like the
+		// interactive flow, which skips account selection for a new organization
+		account, err = nameBootstrapAccount(ctx, tero, bootstrap, opts.account)
+	} else {
+		account, created, err = findOrCreateAccount(ctx, tero, org.ID, opts.account)
+	}
+	if err != nil {
+		return progress, err
+	}
+	progress.Step, progress.AccountID = step.CheckpointAccount, account.ID
+	if created {
+		fmt.Fprintf(w, "Created account %s (%s)\n", account.Name, account.ID)
+	} else {
+		fmt.Fprintf(w, "Using account %s (%s)\n", account.Name, account.ID)
+	}
+
+	ddAccount, err := connectDatadog(ctx, w, tero, account.ID, opts)
+	if err != nil {
+		return progress, err
+	}
+	progress.Step, progress.DatadogSite, progress.DatadogAccountID = step.CheckpointDatadogAccount, ddAccount.Site, ddAccount.ID
+
+	if !opts.waitForDiscovery {
+		return progress, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.discoveryTimeout)
+	defer cancel()
+
+	if err := waitForServiceDiscovery(ctx, w, tero, ddAccount.ID); err != nil {
+		return progress, err
+	}
+	progress.Step = step.CheckpointServices
+
+	if err := waitForLogEventDiscovery(ctx, w, tero, ddAccount.ID); err != nil {
+		return progress, err
+	}
+	progress.Step = step.CheckpointLogEvents
+	return progress, nil
+}
+
+// findOrCreateOrg returns the organization with the given name, creating it
+// if there isn't one. The bootstrap result is only returned when it was created.
+func findOrCreateOrg(ctx context.Context, tero *api.API, name string) (*api.Organization, *api.OrganizationBootstrapResult, error) {
+	orgs, err := tero.Organizations.List(ctx, api.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't list organizations: %w", err)
+	}
+	for _, org := range orgs {
+		if strings.EqualFold(org.Name, name) {
+			return &org, nil, nil
+		}
+	}
+
+	result, err := tero.Organizations.Create(ctx, name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't create organization %q: %w", name, err)
+	}
+	return result.Organization, result, nil
+}
+
+// nameBootstrapAccount returns the account created with a new organization,
+// renamed to name so later runs find it
+func nameBootstrapAccount(ctx context.Context, tero *api.API, bootstrap *api.OrganizationBootstrapResult, name string) (*api.Account, error) {
+	account := bootstrap.Account
+	if strings.EqualFold(account.Name, name) {
+		return account, nil
+	}
+	renamed, err := tero.Accounts.Rename(ctx, account.ID, name)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't rename account %s to %q: %w", account.ID, name, err)
+	}
+	return renamed, nil
+}
+
+// findOrCreateAccount returns the organization's account with the given name,
+// creating it if there isn't one
+func findOrCreateAccount(ctx context.Context, tero *api.API, orgID, name string) (*api.Account, bool, error) {
+	accounts, err := tero.Accounts.List(ctx, orgID, api.ListOptions{})
+	if err != nil {
+		return nil, false, fmt.Errorf("couldn't list accounts: %w", err)
+	}
+	for _, account := range accounts {
+		if strings.EqualFold(account.Name, name) {
+			return &account, false, nil
+		}
+	}
+
+	account, err := tero.Accounts.Create(ctx, orgID, name)
+	if err != nil {
+		return nil, false, fmt.Errorf("couldn't create account %q: %w", name, err)
+	}
+	return account, true, nil
+}
+
+// connectDatadog connects the account to Datadog, or returns the Datadog
+// account it's already connected to
+func connectDatadog(ctx context.Context, w io.Writer, tero *api.API, accountID string, opts setupOptions) (*api.DatadogAccount, error) {
+	existing, err := tero.DatadogAccounts.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't check for a Datadog connection: %w", err)
+	}
+	if existing != nil {
+		fmt.Fprintf(w, "Datadog already connected (%s, %s)\n", existing.Site, existing.ID)
+		return existing, nil
+	}
+
+	valid, reason, err := tero.DatadogAccounts.ValidateAPIKey(ctx, opts.datadogAPIKey, opts.datadogSite)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't validate the Datadog API key: %w", err)
+	}
+	if !valid {
+		return nil, &exitError{code: exitInvalidKeys, err: fmt.Errorf("the Datadog API key was rejected for site %s: %s", opts.datadogSite, reason)}
+	}
+	fmt.Fprintf(w, "Validated Datadog API key for %s\n", opts.datadogSite)
+
+	// Same default name as onboarding
+	ddAccount, err := tero.DatadogAccounts.CreateAccount(ctx, accountID, "Datadog", opts.datadogSite, opts.datadogAPIKey, opts.datadogAppKey)
+	if err != nil {
+		// The control plane checks the application key here, and says why it refused
+		var gqlErr *client.GraphQLError
+		if errors.As(err, &gqlErr) && gqlErr.HasCode(client.CodeInvalidDatadogCredentials) {
+			return nil, &exitError{code: exitInvalidKeys, err: fmt.Errorf("couldn't connect Datadog: %w", err)}
+		}
+		return nil, fmt.Errorf("couldn't connect Datadog: %w", err)
+	}
+	fmt.Fprintf(w, "Connected Datadog (%s, %s)\n", ddAccount.Site, ddAccount.ID)
+	return ddAccount, nil
+}
+
+// waitForServiceDiscovery polls until Datadog services have been discovered
+func waitForServiceDiscovery(ctx context.Context, w io.Writer, tero *api.API, datadogAccountID string) error {
+	fmt.Fprintln(w, "Waiting for service discovery...")
+	var lastError string
+	return poll(ctx, "service discovery", func() (bool, error) {
+		status, err := tero.Services.GetServiceDiscoveryStatus(ctx, datadogAccountID)
+		if err != nil {
+			return false, err
+		}
+		if status == nil {
+			return false, fmt.Errorf("datadog account %s not found", datadogAccountID)
+		}
+		if err := discoveryFailed("service discovery", status.Status, status.LastError, &lastError, w); err != nil {
+			return false, err
+		}
+		if status.Status != api.DiscoveryStatusReady {
+			return false, nil
+		}
+		fmt.Fprintf(w, "Discovered %d services\n", status.ServicesDiscovered)
+		return true, nil
+	})
+}
+
+// waitForLogEventDiscovery polls until log events have been discovered,
+// printing progress every 10%
+func waitForLogEventDiscovery(ctx context.Context, w io.Writer, tero *api.API, datadogAccountID string) error {
+	fmt.Fprintln(w, "Waiting for log event discovery...")
+	var lastError string
+	lastDecile := -1
+	return poll(ctx, "log event discovery", func() (bool, error) {
+		progress, err := tero.DatadogAccounts.GetLogDiscoveryProgress(ctx, datadogAccountID)
+		if err != nil {
+			return false, err
+		}
+		if progress == nil {
+			// Not started yet
+			return false, nil
+		}
+		if err := discoveryFailed("log event discovery", progress.Status, progress.LastError, &lastError, w); err != nil {
+			return false, err
+		}
+		if progress.Status == api.DiscoveryStatusReady {
+			fmt.Fprintln(w, "Discovered log events")
+			return true, nil
+		}
+		if progress.PercentComplete != nil {
+			if decile := int(*progress.PercentComplete) / 10; decile > lastDecile {
+				lastDecile = decile
+				fmt.Fprintf(w, "  %.0f%% of weekly log volume analyzed\n", *progress.PercentComplete)
+			}
+		}
+		return false, nil
+	})
+}
+
+// discoveryFailed returns an error if discovery stopped with an error. Errors
+// discovery is still retrying are printed once each.
+func discoveryFailed(name string, status api.DiscoveryStatus, lastError string, printed *string, w io.Writer) error {
+	if status == api.DiscoveryStatusError {
+		return &exitError{code: exitDiscoveryFailed, err: fmt.Errorf("%s failed: %s", name, lastError)}
+	}
+	if lastError != "" && lastError != *printed {
+		*printed = lastError
+		fmt.Fprintf(w, "  retrying after error: %s\n", lastError)
+	}
+	return nil
+}
+
+// poll calls check every setupPollInterval until it's done, fails, or ctx ends
+func poll(ctx context.Context, name string, check func() (bool, error)) error {
+	for {
+		done, err := check()
+		// A request cut off by the timeout is reported as the timeout below
+		var exitErr *exitError
+		if err != nil && (ctx.Err() == nil || errors.As(err, &exitErr)) {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return &exitError{code: exitDiscoveryFailed, err: fmt.Errorf("%s didn't finish in time", name)}
+			}
+			return ctx.Err()
+		case <-time.After(setupPollInterval):
+		}
+	}
+}
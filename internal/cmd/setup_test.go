@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/log/logtest"
+	"github.com/usetero/cli/internal/tui/onboarding/step"
+	"github.com/usetero/cli/pkg/client/clienttest"
+	"github.com/usetero/cli/pkg/client/fakeserver"
+)
+
+func TestRunSetup(t *testing.T) {
+	opts := setupOptions{
+		org:              "Globex",
+		account:          "Staging",
+		role:             "platform",
+		datadogSite:      "US5",
+		datadogAPIKey:    "dd-api",
+		datadogAppKey:    "dd-app",
+		waitForDiscovery: true,
+		discoveryTimeout: time.Minute,
+	}
+
+	t.Run("creates what's missing and is safe to run again", func(t *testing.T) {
+		srv := clienttest.NewServer(t, fakeserver.NewFixtures())
+		tero := api.New(srv.Client(), logtest.New(t))
+
+		var out bytes.Buffer
+		progress, err := runSetup(context.Background(), &out, tero, opts)
+		if err != nil {
+			t.Fatalf("runSetup() error = %v\n%s", err, out.String())
+		}
+		if progress.Step != step.CheckpointLogEvents || progress.OrgID == "" || progress.AccountID == "" || progress.DatadogAccountID == "" {
+			t.Errorf("progress = %+v, want every ID through log events", progress)
+		}
+		accounts, err := tero.Accounts.List(context.Background(), progress.OrgID, api.ListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(accounts) != 1 || accounts[0].ID != progress.AccountID || accounts[0].Name != opts.account {
+			t.Errorf("accounts = %+v, want only %s named %q", accounts, progress.AccountID, opts.account)
+		}
+
+		out.Reset()
+		again, err := runSetup(context.Background(), &out, tero, opts)
+		if err != nil {
+			t.Fatalf("second runSetup() error = %v", err)
+		}
+		if again != progress {
+			t.Errorf("second run progress = %+v, want %+v", again, progress)
+		}
+		if bytes.Contains(out.Bytes(), []byte("Created")) {
+			t.Errorf("second run created something:\n%s", out.String())
+		}
+	})
+
+	t.Run("exits with the invalid keys code when datadog rejects the api key", func(t *testing.T) {
+		srv := clienttest.NewServer(t, fakeserver.NewFixtures())
+		tero := api.New(srv.Client(), logtest.New(t))
+
+		bad := opts
+		bad.datadogAPIKey = "invalid-key"
+		progress, err := runSetup(context.Background(), &bytes.Buffer{}, tero, bad)
+
+		var exitErr *exitError
+		if !errors.As(err, &exitErr) || exitErr.code != exitInvalidKeys {
+			t.Fatalf("runSetup() error = %v, want exit code %d", err, exitInvalidKeys)
+		}
+		if progress.Step != step.CheckpointAccount {
+			t.Errorf("progress.Step = %q, want %q so onboarding resumes at Datadog", progress.Step, step.CheckpointAccount)
+		}
+	})
+
+	t.Run("exits with the invalid keys code when datadog rejects the application key", func(t *testing.T) {
+		srv := clienttest.NewServer(t, fakeserver.NewFixtures())
+		tero := api.New(srv.Client(), logtest.New(t))
+
+		bad := opts
+		bad.datadogAppKey = "invalid-key"
+		_, err := runSetup(context.Background(), &bytes.Buffer{}, tero, bad)
+
+		var exitErr *exitError
+		if !errors.As(err, &exitErr) || exitErr.code != exitInvalidKeys {
+			t.Fatalf("runSetup() error = %v, want exit code %d", err, exitInvalidKeys)
+		}
+	})
+
+	t.Run("exits with the generic code when connecting datadog fails for another reason", func(t *testing.T) {
+		srv := clienttest.NewServer(t, fakeserver.NewFixtures())
+		srv.HandleMutation("createDatadogAccount", func(f *fakeserver.Fixtures, args map[string]any) (any, error) {
+			return nil, errors.New("database is read-only")
+		})
+		tero := api.New(srv.Client(), logtest.New(t))
+
+		_, err := runSetup(context.Background(), &bytes.Buffer{}, tero, opts)
+
+		var exitErr *exitError
+		if err == nil || errors.As(err, &exitErr) {
+			t.Fatalf("runSetup() error = %v, want a plain error so tero exits 1", err)
+		}
+	})
+
+	t.Run("exits with the discovery code when discovery fails", func(t *testing.T) {
+		fixtures := fakeserver.NewFixtures()
+		srv := clienttest.NewServer(t, fixtures)
+		srv.HandleMutation("createDatadogAccount", func(f *fakeserver.Fixtures, args map[string]any) (any, error) {
+			input, _ := args["input"].(map[string]any)
+			attrs, _ := input["attributes"].(map[string]any)
+			return f.Add("DatadogAccount", fakeserver.Object{
+				"name":      attrs["name"],
+				"site":      attrs["site"],
+				"accountID": attrs["accountID"],
+				"serviceDiscoveryProgress": fakeserver.Object{
+					"status":              "ERROR",
+					"lastError":           "datadog returned 403",
+					"servicesDiscovered":  0,
+					"consecutiveFailures": 3,
+				},
+			}), nil
+		})
+		tero := api.New(srv.Client(), logtest.New(t))
+
+		_, err := runSetup(context.Background(), &bytes.Buffer{}, tero, opts)
+
+		var exitErr *exitError
+		if !errors.As(err, &exitErr) || exitErr.code != exitDiscoveryFailed {
+			t.Fatalf("runSetup() error = %v, want exit code %d", err, exitDiscoveryFailed)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/format"
+	"github.com/usetero/cli/internal/log"
+	"gopkg.in/yaml.v2"
+)
+
+// NewStatusCmd creates the status command, which prints the waste summary for an account.
+func NewStatusCmd(logger log.Logger, cliConfig *config.CLIConfig) *cobra.Command {
+	var (
+		window    string
+		output    string
+		accountID string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show log volume and waste for your account",
+		Long: `Show how much of your log volume is unknown, valuable, waste, or already saved,
+for the whole account and for each service.
+
+Useful in scripts and CI where the interactive UI isn't available.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			timeWindow, err := api.ParseTimeWindow(window)
+			if err != nil {
+				return err
+			}
+			if output != "table" && output != "json" && output != "yaml" {
+				return fmt.Errorf("invalid output format %q (expected table, json, or yaml)", output)
+			}
+
+			if accountID == "" {
+				prefs, err := loadPreferences(cliConfig, logger)
+				if err != nil {
+					return err
+				}
+				accountID = prefs.GetDefaultAccountID()
+			}
+			if accountID == "" {
+				return errors.New("no default account: run 'tero' to finish setup or pass --account")
+			}
+
+			tero, err := newAPI(cmd, cliConfig, logger)
+			if err != nil {
+				return err
+			}
+
+			stats, err := tero.Accounts.GetVolumeStats(cmd.Context(), accountID, timeWindow)
+			if err != nil {
+				return err
+			}
+			if stats == nil {
+				return fmt.Errorf("account %s not found", accountID)
+			}
+
+			// Biggest waste first - that's what the user came here to see
+			slices.SortStableFunc(stats.Services, func(a, b api.ServiceVolumeStats) int {
+				return cmp.Compare(b.Stats.WasteVolume, a.Stats.WasteVolume)
+			})
+
+			return writeStatus(cmd.OutOrStdout(), stats, output)
+		},
+	}
+
+	cmd.Flags().StringVar(&window, "window", "week", "Lookback window: day, week, month, or quarter")
+	cmd.Flags().StringVarP(&output, "output", "o", "table", "Output format: table, json, or yaml")
+	cmd.Flags().StringVar(&accountID, "account", "", "Account ID (defaults to the account chosen during setup)")
+
+	return cmd
+}
+
+// writeStatus renders account volume stats in the requested format.
+func writeStatus(w io.Writer, stats *api.AccountVolumeStats, output string) error {
+	switch output {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(stats)
+	case "yaml":
+		data, err := yaml.Marshal(stats)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	default:
+		return writeStatusTable(w, stats)
+	}
+}
+
+// writeStatusTable renders account volume stats as aligned text tables.
+func writeStatusTable(w io.Writer, stats *api.AccountVolumeStats) error {
+	s := stats.Stats
+	fmt.Fprintf(w, "%s — last %s (%s to %s)\n\n",
+		stats.AccountName,
+		strings.ToLower(string(stats.Window)),
+		s.PeriodStart.Format("Jan 2"),
+		s.PeriodEnd.Format("Jan 2"))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, "\tVOLUME\tPERCENT")
+	fmt.Fprintf(tw, "Unknown\t%s\t%s\n", format.Count(s.UnknownVolume), format.Percent(s.UnknownPercent, 1))
+	fmt.Fprintf(tw, "Valuable\t%s\t%s\n", format.Count(s.ValuableVolume), format.Percent(s.ValuablePercent, 1))
+	fmt.Fprintf(tw, "Waste\t%s\t%s\n", format.Count(s.WasteVolume), format.Percent(s.WastePercent, 1))
+	fmt.Fprintf(tw, "Saved\t%s\t%s\n", format.Count(s.SavedVolume), format.Percent(s.SavedPercent, 1))
+	fmt.Fprintf(tw, "Total\t%s\t\n", format.Count(s.TotalVolume))
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(stats.Services) == 0 {
+		_, err := fmt.Fprintln(w, "\nNo services discovered yet.")
+		return err
+	}
+
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, "SERVICE\tTOTAL\tUNKNOWN\tVALUABLE\tWASTE\tSAVED")
+	for _, svc := range stats.Services {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			svc.Name,
+			format.Count(svc.Stats.TotalVolume),
+			format.Percent(svc.Stats.UnknownPercent, 1),
+			format.Percent(svc.Stats.ValuablePercent, 1),
+			format.Percent(svc.Stats.WastePercent, 1),
+			format.Percent(svc.Stats.SavedPercent, 1))
+	}
+	return tw.Flush()
+}
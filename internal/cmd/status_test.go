@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/log/logtest"
+	"github.com/usetero/cli/pkg/client/clienttest"
+	"github.com/usetero/cli/pkg/client/fakeserver"
+	"gopkg.in/yaml.v2"
+)
+
+func TestStatusCmd(t *testing.T) {
+	srv := clienttest.NewServer(t, fakeserver.Seed())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name:    "defaults to a weekly table",
+			args:    []string{"--account", fakeserver.AccountID},
+			wantOut: "Production — last week",
+		},
+		{
+			name:    "accepts a window in any case",
+			args:    []string{"--account", fakeserver.AccountID, "--window", "Month"},
+			wantOut: "Production — last month",
+		},
+		{
+			name:    "writes json",
+			args:    []string{"--account", fakeserver.AccountID, "-o", "json"},
+			wantOut: `"accountName": "Production"`,
+		},
+		{
+			name:    "rejects an unknown window",
+			args:    []string{"--account", fakeserver.AccountID, "--window", "fortnight"},
+			wantErr: `invalid time window "fortnight"`,
+		},
+		{
+			name:    "rejects an unknown output format",
+			args:    []string{"--account", fakeserver.AccountID, "--output", "csv"},
+			wantErr: `invalid output format "csv"`,
+		},
+		{
+			name:    "reports a missing account",
+			args:    []string{"--account", "account-missing"},
+			wantErr: "account account-missing not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewStatusCmd(logtest.New(t), &config.CLIConfig{APIEndpoint: srv.URL, APIToken: clienttest.Token})
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if !strings.Contains(out.String(), tt.wantOut) {
+				t.Errorf("output missing %q:\n%s", tt.wantOut, out.String())
+			}
+		})
+	}
+}
+
+func TestWriteStatus(t *testing.T) {
+	srv := clienttest.NewServer(t, fakeserver.Seed())
+	tero := api.New(srv.Client(), logtest.New(t))
+
+	stats, err := tero.Accounts.GetVolumeStats(context.Background(), fakeserver.AccountID, api.TimeWindowWeek)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats == nil || len(stats.Services) == 0 {
+		t.Fatalf("stats = %+v, want the seeded account with services", stats)
+	}
+
+	tests := []struct {
+		output string
+		check  func(t *testing.T, out []byte)
+	}{
+		{
+			output: "table",
+			check: func(t *testing.T, out []byte) {
+				for _, want := range []string{"Production — last week", "VOLUME", "Waste", "SERVICE", stats.Services[0].Name} {
+					if !bytes.Contains(out, []byte(want)) {
+						t.Errorf("table missing %q:\n%s", want, out)
+					}
+				}
+			},
+		},
+		{
+			output: "json",
+			check: func(t *testing.T, out []byte) {
+				var got api.AccountVolumeStats
+				if err := json.Unmarshal(out, &got); err != nil {
+					t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+				}
+				if got.AccountID != stats.AccountID || len(got.Services) != len(stats.Services) || got.Stats.TotalVolume != stats.Stats.TotalVolume {
+					t.Errorf("decoded = %+v, want %+v", got, stats)
+				}
+			},
+		},
+		{
+			output: "yaml",
+			check: func(t *testing.T, out []byte) {
+				var got api.AccountVolumeStats
+				if err := yaml.Unmarshal(out, &got); err != nil {
+					t.Fatalf("output is not valid YAML: %v\n%s", err, out)
+				}
+				if got.AccountID != stats.AccountID || len(got.Services) != len(stats.Services) || got.Stats.TotalVolume != stats.Stats.TotalVolume {
+					t.Errorf("decoded = %+v, want %+v", got, stats)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.output, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := writeStatus(&out, stats, tt.output); err != nil {
+				t.Fatalf("writeStatus() error = %v", err)
+			}
+			tt.check(t, out.Bytes())
+		})
+	}
+
+	t.Run("table without services", func(t *testing.T) {
+		empty := *stats
+		empty.Services = nil
+
+		var out bytes.Buffer
+		if err := writeStatus(&out, &empty, "table"); err != nil {
+			t.Fatalf("writeStatus() error = %v", err)
+		}
+		if !strings.Contains(out.String(), "No services discovered yet.") {
+			t.Errorf("table missing the no services note:\n%s", out.String())
+		}
+	})
+}
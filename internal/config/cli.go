@@ -13,8 +13,38 @@ type CLIConfig struct {
 	// WorkOSClientID is the WorkOS OAuth client ID for authentication
 	WorkOSClientID string
 
+	// APIToken authenticates without the interactive sign-in (CI, service accounts).
+	// Empty means use the credentials stored by 'tero'.
+	APIToken string
+
+	// Profile is the profile selected by TERO_PROFILE, or "" for the config's current profile
+	Profile string
+
+	// CredentialStore is where tokens and secrets are kept: keyring, file, or env.
+	// Empty means the OS keyring, falling back to an encrypted file when it's unavailable.
+	CredentialStore string
+
 	// Debug enables debug logging
 	Debug bool
+
+	// LogLevel is debug, info, warn, or error. TERO_LOG_LEVEL wins over TERO_DEBUG.
+	LogLevel string
+
+	// LogFile is the log path. Empty means a new file per process in the state directory.
+	LogFile string
+
+	// LogFormat is text or json
+	LogFormat string
+
+	// Trace is where to trace control plane operations: "log", "stderr", or "" for off
+	Trace string
+
+	// Demo serves bundled sample data from a local server instead of the control plane
+	Demo bool
+
+	// Whether the connection settings came from the environment, which wins over the profile
+	endpointFromEnv bool
+	clientIDFromEnv bool
 }
 
 // LoadCLIConfig loads CLI configuration from environment variables and defaults.
@@ -24,24 +54,68 @@ func LoadCLIConfig(version string) *CLIConfig {
 		APIEndpoint:    getDefaultAPIEndpoint(version),
 		WorkOSClientID: getDefaultWorkOSClientID(),
 		Debug:          false,
+		LogLevel:       "info",
+		LogFormat:      "text",
 	}
 
 	// Override from environment variables if set
 	if endpoint := os.Getenv("TERO_API_ENDPOINT"); endpoint != "" {
 		cfg.APIEndpoint = endpoint
+		cfg.endpointFromEnv = true
 	}
 
 	if clientID := os.Getenv("TERO_WORKOS_CLIENT_ID"); clientID != "" {
 		cfg.WorkOSClientID = clientID
+		cfg.clientIDFromEnv = true
 	}
 
+	cfg.Profile = strings.TrimSpace(os.Getenv("TERO_PROFILE"))
+
+	cfg.APIToken = strings.TrimSpace(os.Getenv("TERO_API_TOKEN"))
+
+	cfg.CredentialStore = strings.ToLower(strings.TrimSpace(os.Getenv("TERO_CREDENTIAL_STORE")))
+
 	if debug := os.Getenv("TERO_DEBUG"); debug == "true" || debug == "1" {
 		cfg.Debug = true
+		cfg.LogLevel = "debug"
+	}
+
+	if level := strings.TrimSpace(os.Getenv("TERO_LOG_LEVEL")); level != "" {
+		cfg.LogLevel = level
+	}
+
+	cfg.LogFile = strings.TrimSpace(os.Getenv("TERO_LOG_FILE"))
+
+	if format := strings.TrimSpace(os.Getenv("TERO_LOG_FORMAT")); format != "" {
+		cfg.LogFormat = strings.ToLower(format)
+	}
+
+	switch trace := strings.ToLower(strings.TrimSpace(os.Getenv("TERO_TRACE"))); trace {
+	case "1", "true", "log":
+		cfg.Trace = "log"
+	case "stderr":
+		cfg.Trace = "stderr"
+	}
+
+	if demo := os.Getenv("TERO_DEMO"); demo == "true" || demo == "1" {
+		cfg.Demo = true
 	}
 
 	return cfg
 }
 
+// ApplyProfile uses the profile's saved endpoint and WorkOS client ID where the
+// environment doesn't override them.
+// Priority: environment variables > profile > smart defaults based on version.
+func (c *CLIConfig) ApplyProfile(p *Profile) {
+	if endpoint := p.Endpoint(); endpoint != "" && !c.endpointFromEnv {
+		c.APIEndpoint = endpoint
+	}
+	if clientID := p.WorkOSClientID(); clientID != "" && !c.clientIDFromEnv {
+		c.WorkOSClientID = clientID
+	}
+}
+
 // getDefaultAPIEndpoint returns the default API endpoint based on the build version.
 // Development builds (version contains "dev" or "dirty") use localhost.
 // Release builds use the production API.
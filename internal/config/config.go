@@ -1,66 +1,94 @@
 package config
 
 import (
+	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 
 	"gopkg.in/yaml.v2"
 )
 
+// DefaultProfile is the profile used when none is selected.
+// Configs written before profiles existed are read as this profile.
+const DefaultProfile = "default"
+
+// Profile keys for the connection settings; everything else is preferences
+const (
+	endpointKey       = "endpoint"
+	workosClientIDKey = "workos_client_id"
+)
+
 // Config is the Tero CLI configuration stored as YAML.
-// It implements the app.Store interface using a map-based structure for flexibility.
+// Preferences live in named profiles (like kubectl contexts) so signing in to
+// a second control plane or org doesn't overwrite the first.
 type Config struct {
-	data map[string]interface{}
+	current  string
+	profiles map[string]map[string]interface{}
+	inMemory bool // Save is a no-op
 }
 
-// Get retrieves a string value by key
-func (c *Config) Get(key string) string {
-	if v, ok := c.data[key].(string); ok {
-		return v
+// file is the on-disk layout of the config
+type file struct {
+	CurrentProfile string                            `yaml:"current_profile,omitempty"`
+	Profiles       map[string]map[string]interface{} `yaml:"profiles,omitempty"`
+}
+
+// CurrentProfile returns the name of the profile used when none is selected
+func (c *Config) CurrentProfile() string {
+	if c.current == "" {
+		return DefaultProfile
 	}
-	return ""
+	return c.current
 }
 
-// Set stores a string value by key
-func (c *Config) Set(key string, value string) {
-	c.data[key] = value
+// SetCurrentProfile sets the profile used when none is selected
+func (c *Config) SetCurrentProfile(name string) {
+	c.current = name
 }
 
-// GetBool retrieves a boolean value by key
-func (c *Config) GetBool(key string) bool {
-	if v, ok := c.data[key].(bool); ok {
-		return v
-	}
-	return false
+// HasProfile returns true if the profile exists
+func (c *Config) HasProfile(name string) bool {
+	_, ok := c.profiles[name]
+	return ok
 }
 
-// SetBool stores a boolean value by key
-func (c *Config) SetBool(key string, value bool) {
-	c.data[key] = value
+// ProfileNames returns every profile name, sorted
+func (c *Config) ProfileNames() []string {
+	return slices.Sorted(maps.Keys(c.profiles))
 }
 
-// GetList retrieves a list of strings by key
-func (c *Config) GetList(key string) []string {
-	if v, ok := c.data[key].([]interface{}); ok {
-		result := make([]string, 0, len(v))
-		for _, item := range v {
-			if s, ok := item.(string); ok {
-				result = append(result, s)
-			}
-		}
-		return result
+// Profile returns the named profile, creating it if it doesn't exist.
+// A new profile is written to disk on the next Save.
+func (c *Config) Profile(name string) *Profile {
+	data, ok := c.profiles[name]
+	if !ok {
+		data = make(map[string]interface{})
+		c.profiles[name] = data
 	}
-	if v, ok := c.data[key].([]string); ok {
-		return v
+	return &Profile{name: name, data: data, config: c}
+}
+
+// DeleteProfile removes a profile and its preferences
+func (c *Config) DeleteProfile(name string) {
+	delete(c.profiles, name)
+	if c.current == name {
+		c.current = ""
 	}
-	return nil
 }
 
-// SetList stores a list of strings by key
-func (c *Config) SetList(key string, values []string) {
-	c.data[key] = values
+// ValidateProfileName checks that a profile name is safe to use as a keyring namespace
+func ValidateProfileName(name string) error {
+	if !profileName.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use letters, numbers, '-' and '_'", name)
+	}
+	return nil
 }
 
+var profileName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
 // Path returns the config file path (~/.tero/config.yaml)
 func Path() (string, error) {
 	homeDir, err := os.UserHomeDir()
@@ -70,6 +98,19 @@ func Path() (string, error) {
 	return filepath.Join(homeDir, ".tero", "config.yaml"), nil
 }
 
+// StateDir returns the directory for logs and diagnostics: $XDG_STATE_HOME/tero,
+// defaulting to ~/.local/state/tero.
+func StateDir() (string, error) {
+	if state := os.Getenv("XDG_STATE_HOME"); state != "" {
+		return filepath.Join(state, "tero"), nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".local", "state", "tero"), nil
+}
+
 // Load reads the config from disk
 func Load() (*Config, error) {
 	path, err := Path()
@@ -79,22 +120,60 @@ func Load() (*Config, error) {
 
 	data, err := os.ReadFile(path)
 	if os.IsNotExist(err) {
-		return &Config{data: make(map[string]interface{})}, nil // Empty config if file doesn't exist
+		return &Config{profiles: make(map[string]map[string]interface{})}, nil // Empty config if file doesn't exist
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	var cfgData map[string]interface{}
-	if err := yaml.Unmarshal(data, &cfgData); err != nil {
+	return parse(data)
+}
+
+// InMemory returns an empty config that Save never writes, for runs like demo
+// mode that mustn't touch the user's preferences.
+func InMemory() *Config {
+	return &Config{profiles: make(map[string]map[string]interface{}), inMemory: true}
+}
+
+// parse reads a config file's contents, upgrading the flat layout written
+// before profiles existed into the default profile
+func parse(data []byte) (*Config, error) {
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
 		return nil, err
 	}
 
-	return &Config{data: cfgData}, nil
+	_, hasProfiles := raw["profiles"]
+	_, hasCurrent := raw["current_profile"]
+	if !hasProfiles && !hasCurrent {
+		profiles := make(map[string]map[string]interface{})
+		if len(raw) > 0 {
+			profiles[DefaultProfile] = raw
+		}
+		return &Config{profiles: profiles}, nil
+	}
+
+	var f file
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	if f.Profiles == nil {
+		f.Profiles = make(map[string]map[string]interface{})
+	}
+	for name, profile := range f.Profiles {
+		if profile == nil {
+			f.Profiles[name] = make(map[string]interface{}) // An empty profile reads back as null
+		}
+	}
+	return &Config{current: f.CurrentProfile, profiles: f.Profiles}, nil
 }
 
 // Save writes the config to disk
 func (c *Config) Save() error {
+	if c.inMemory {
+		return nil
+	}
+
 	path, err := Path()
 	if err != nil {
 		return err
@@ -105,10 +184,101 @@ func (c *Config) Save() error {
 		return err
 	}
 
-	data, err := yaml.Marshal(c.data)
+	data, err := yaml.Marshal(file{CurrentProfile: c.current, Profiles: c.profiles})
 	if err != nil {
 		return err
 	}
 
 	return os.WriteFile(path, data, 0o600)
 }
+
+// Profile is one named set of connection settings and preferences.
+// It implements the preferences.Store interface; Save writes the whole config.
+type Profile struct {
+	name   string
+	data   map[string]interface{}
+	config *Config
+}
+
+// Name returns the profile name
+func (p *Profile) Name() string {
+	return p.name
+}
+
+// Endpoint returns the control plane endpoint saved for this profile, or "" for the default
+func (p *Profile) Endpoint() string {
+	return p.Get(endpointKey)
+}
+
+// SetEndpoint saves the control plane endpoint for this profile
+func (p *Profile) SetEndpoint(endpoint string) {
+	p.Set(endpointKey, endpoint)
+}
+
+// WorkOSClientID returns the WorkOS client ID saved for this profile, or "" for the default
+func (p *Profile) WorkOSClientID() string {
+	return p.Get(workosClientIDKey)
+}
+
+// SetWorkOSClientID saves the WorkOS client ID for this profile
+func (p *Profile) SetWorkOSClientID(clientID string) {
+	p.Set(workosClientIDKey, clientID)
+}
+
+// Get retrieves a string value by key
+func (p *Profile) Get(key string) string {
+	if v, ok := p.data[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// Set stores a string value by key
+func (p *Profile) Set(key string, value string) {
+	p.data[key] = value
+}
+
+// GetBool retrieves a boolean value by key
+func (p *Profile) GetBool(key string) bool {
+	if v, ok := p.data[key].(bool); ok {
+		return v
+	}
+	return false
+}
+
+// SetBool stores a boolean value by key
+func (p *Profile) SetBool(key string, value bool) {
+	p.data[key] = value
+}
+
+// GetList retrieves a list of strings by key
+func (p *Profile) GetList(key string) []string {
+	if v, ok := p.data[key].([]interface{}); ok {
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	if v, ok := p.data[key].([]string); ok {
+		return v
+	}
+	return nil
+}
+
+// SetList stores a list of strings by key
+func (p *Profile) SetList(key string, values []string) {
+	p.data[key] = values
+}
+
+// Delete removes a key entirely
+func (p *Profile) Delete(key string) {
+	delete(p.data, key)
+}
+
+// Save writes the whole config, including this profile, to disk
+func (p *Profile) Save() error {
+	return p.config.Save()
+}
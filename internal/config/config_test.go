@@ -0,0 +1,64 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLoadUpgradesFlatConfigToDefaultProfile(t *testing.T) {
+	cfg, err := parse([]byte("email: a@example.com\ndefault_org_id: org-1\nservices:\n  - checkout\n"))
+	if err != nil {
+		t.Fatalf("parse() error = %v", err)
+	}
+
+	if got := cfg.CurrentProfile(); got != DefaultProfile {
+		t.Errorf("CurrentProfile() = %q, want %q", got, DefaultProfile)
+	}
+	profile := cfg.Profile(DefaultProfile)
+	if got := profile.Get("default_org_id"); got != "org-1" {
+		t.Errorf("default_org_id = %q, want org-1", got)
+	}
+	if got := profile.GetList("services"); !slices.Equal(got, []string{"checkout"}) {
+		t.Errorf("services = %v, want [checkout]", got)
+	}
+}
+
+func TestProfilesRoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	cfg.Profile(DefaultProfile).Set("default_org_id", "prod-org")
+	staging := cfg.Profile("staging")
+	staging.SetEndpoint("https://staging.example.com/graphql")
+	staging.Set("default_org_id", "staging-org")
+	cfg.SetCurrentProfile("staging")
+	if err := staging.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := cfg.CurrentProfile(); got != "staging" {
+		t.Errorf("CurrentProfile() = %q, want staging", got)
+	}
+	if got := cfg.ProfileNames(); !slices.Equal(got, []string{"default", "staging"}) {
+		t.Errorf("ProfileNames() = %v", got)
+	}
+	// Profiles don't share preferences
+	if got := cfg.Profile(DefaultProfile).Get("default_org_id"); got != "prod-org" {
+		t.Errorf("default profile org = %q, want prod-org", got)
+	}
+	if got := cfg.Profile("staging").Endpoint(); got != "https://staging.example.com/graphql" {
+		t.Errorf("staging endpoint = %q", got)
+	}
+
+	cfg.DeleteProfile("staging")
+	if cfg.HasProfile("staging") || cfg.CurrentProfile() != DefaultProfile {
+		t.Errorf("after delete: profiles %v, current %q", cfg.ProfileNames(), cfg.CurrentProfile())
+	}
+}
@@ -0,0 +1,79 @@
+// Package demo runs the CLI offline against a bundled dataset, for evaluators
+// and conference booths without a Tero or Datadog account. It serves the
+// control plane's GraphQL API from pkg/client/fakeserver on a local port, so
+// every page and command works unchanged, and keeps preferences and secrets
+// in memory so nothing the user has saved is read or overwritten.
+package demo
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
+	"github.com/usetero/cli/internal/securestore"
+	"github.com/usetero/cli/pkg/client/fakeserver"
+)
+
+// Token is the access token clients send to the demo server. It's accepted
+// like any other.
+const Token = "demo"
+
+// Banner is shown across the top of the UI and printed by commands.
+const Banner = "Demo mode: sample data, not a real account. Run without --demo to connect yours."
+
+// Server serves the demo dataset on a loopback port.
+type Server struct {
+	// URL is the GraphQL endpoint
+	URL string
+
+	srv *http.Server
+}
+
+// Start serves a freshly seeded dataset until Close. Changes, like enabling a
+// service, last until the process exits.
+func Start(logger log.Logger) (*Server, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	srv := &http.Server{
+		Handler:           fakeserver.NewHandler(fakeserver.Seed()),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("demo server stopped", "error", err)
+		}
+	}()
+
+	url := "http://" + ln.Addr().String() + "/graphql"
+	logger.Info("serving demo data", "endpoint", url)
+	return &Server{URL: url, srv: srv}, nil
+}
+
+// Close stops the server.
+func (s *Server) Close() error {
+	return s.srv.Close()
+}
+
+// Profile returns an in-memory profile with no preferences, so the interactive
+// UI walks through onboarding against the demo data.
+func Profile() *config.Profile {
+	return config.InMemory().Profile("demo")
+}
+
+// Preferences returns in-memory preferences with the demo organization,
+// account, and workspace as defaults, so commands have something to act on.
+func Preferences(logger log.Logger) *preferences.Service {
+	prefs := preferences.NewService(Profile(), securestore.NewMemory(), logger)
+	// In-memory stores don't fail
+	_ = prefs.SetDefaultOrgID(fakeserver.OrganizationID)
+	_ = prefs.SetDefaultAccountID(fakeserver.AccountID)
+	_ = prefs.SetDefaultWorkspaceID(fakeserver.WorkspaceID)
+	return prefs
+}
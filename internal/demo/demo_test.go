@@ -0,0 +1,30 @@
+package demo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/log/logtest"
+	"github.com/usetero/cli/pkg/client"
+)
+
+func TestDemoServesCommandsDefaults(t *testing.T) {
+	logger := logtest.New(t)
+	srv, err := Start(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = srv.Close() })
+
+	prefs := Preferences(logger)
+	tero := api.New(client.New(srv.URL, Token), logger)
+
+	stats, err := tero.Accounts.GetVolumeStats(context.Background(), prefs.GetDefaultAccountID(), api.TimeWindowWeek)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Services) == 0 || stats.Stats.TotalVolume == 0 {
+		t.Errorf("stats = %+v, want services with volume", stats)
+	}
+}
@@ -0,0 +1,179 @@
+package diagnostics
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/usetero/cli/internal/log"
+	"gopkg.in/yaml.v2"
+)
+
+// Bundle is what goes into a support bundle. Settings must already be safe to
+// share; config and logs are redacted while writing.
+type Bundle struct {
+	Version    string
+	Settings   any
+	ConfigPath string
+	LogPaths   []string
+	Terminal   *Terminal
+	Operations []Operation
+	CreatedAt  time.Time
+}
+
+// summary is the bundle's summary.json
+type summary struct {
+	Version   string    `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	Settings  any       `json:"settings"`
+	Terminal  *Terminal `json:"terminal"`
+	Logs      []string  `json:"logs"`
+}
+
+// Write writes b to w as a tar.gz holding summary.json, config.yaml,
+// operations.json, and logs/. Missing config and logs are skipped.
+func Write(w io.Writer, b Bundle) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	var logNames []string
+	for _, path := range b.LogPaths {
+		logNames = append(logNames, filepath.Base(path))
+	}
+	sum, err := json.MarshalIndent(summary{
+		Version:   b.Version,
+		CreatedAt: b.CreatedAt.UTC(),
+		Settings:  b.Settings,
+		Terminal:  b.Terminal,
+		Logs:      logNames,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := addFile(tw, "summary.json", sum, b.CreatedAt); err != nil {
+		return err
+	}
+
+	if b.ConfigPath != "" {
+		data, err := os.ReadFile(b.ConfigPath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err == nil {
+			redacted, err := RedactConfig(data)
+			if err != nil {
+				// An unparseable config is itself a clue, but may hold secrets verbatim
+				redacted = []byte(fmt.Sprintf("# config.yaml doesn't parse, so it was left out: %v\n", err))
+			}
+			if err := addFile(tw, "config.yaml", redacted, b.CreatedAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	ops, err := json.MarshalIndent(b.Operations, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := addFile(tw, "operations.json", ops, b.CreatedAt); err != nil {
+		return err
+	}
+
+	for _, path := range b.LogPaths {
+		data, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// Logs are redacted as they're written; this catches anything older
+		if err := addFile(tw, "logs/"+filepath.Base(path), []byte(log.RedactString(string(data))), b.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func addFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+// RedactConfig masks secrets, IDs, and email addresses in a config file.
+// IDs keep their last four characters so support can tell them apart.
+func RedactConfig(data []byte) ([]byte, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(redactValue("", raw))
+}
+
+func redactValue(key string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[interface{}]interface{}, len(v))
+		for k, val := range v {
+			out[k] = redactValue(fmt.Sprint(k), val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = redactValue(key, val)
+		}
+		return out
+	case string:
+		lower := strings.ToLower(key)
+		switch {
+		case v == "":
+			return v
+		case log.IsSensitiveKey(lower):
+			return log.Redacted
+		case lower == "id" || strings.HasSuffix(lower, "_id"):
+			return maskID(v)
+		case strings.Contains(lower, "email"):
+			return maskEmail(v)
+		default:
+			return log.RedactString(v)
+		}
+	default:
+		return v
+	}
+}
+
+// maskID keeps the last four characters of an ID
+func maskID(id string) string {
+	if len(id) <= 4 {
+		return "****"
+	}
+	return "****" + id[len(id)-4:]
+}
+
+// maskEmail keeps the first letter and domain of an email address
+func maskEmail(email string) string {
+	user, domain, ok := strings.Cut(email, "@")
+	if !ok || user == "" {
+		return "****"
+	}
+	return user[:1] + "***@" + domain
+}
@@ -0,0 +1,36 @@
+package diagnostics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactConfig(t *testing.T) {
+	in := `current_profile: default
+profiles:
+  default:
+    email: alice@example.com
+    default_org_id: org-123456789
+    default_account_id: acct-abcdef
+    datadog_api_key: 0123456789abcdef
+    endpoint: https://api.example.com/graphql?token=s3cret
+    services:
+      - checkout
+`
+	out, err := RedactConfig([]byte(in))
+	if err != nil {
+		t.Fatalf("RedactConfig() error = %v", err)
+	}
+	got := string(out)
+
+	for _, secret := range []string{"alice@", "org-123456789", "acct-abcdef", "0123456789abcdef", "s3cret"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("redacted config contains %q:\n%s", secret, got)
+		}
+	}
+	for _, keep := range []string{"a***@example.com", "****6789", "****cdef", "checkout", "current_profile: default"} {
+		if !strings.Contains(got, keep) {
+			t.Errorf("redacted config lost %q:\n%s", keep, got)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package diagnostics
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/pkg/client"
+)
+
+// MaxOperations is how many recent operations are kept
+const MaxOperations = 100
+
+// Operation is a recorded control plane operation
+type Operation struct {
+	Name       string    `json:"name"`
+	Start      time.Time `json:"start"`
+	DurationMS int64     `json:"duration_ms"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// OperationLog appends control plane operations to a file shared by every
+// process, so a bug report can show what ran before something went wrong.
+type OperationLog struct {
+	path   string
+	logger log.Logger
+
+	mu sync.Mutex
+}
+
+// operationsPath is where recent operations are recorded
+func operationsPath() (string, error) {
+	state, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(state, "operations.jsonl"), nil
+}
+
+// OpenOperationLog opens the shared operation log, trimming it to the most
+// recent MaxOperations. Recording failures are logged, never returned, since
+// diagnostics mustn't break commands.
+func OpenOperationLog(logger log.Logger) (*OperationLog, error) {
+	path, err := operationsPath()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+
+	ops, err := readOperations(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(ops) > MaxOperations {
+		if err := writeOperations(path, ops[len(ops)-MaxOperations:]); err != nil {
+			return nil, err
+		}
+	}
+	return &OperationLog{path: path, logger: logger}, nil
+}
+
+// Observe implements client.Observer.
+func (l *OperationLog) Observe(op client.Operation) {
+	rec := Operation{
+		Name:       op.Name,
+		Start:      op.Start.UTC(),
+		DurationMS: op.Duration.Milliseconds(),
+	}
+	if op.Err != nil {
+		rec.Error = log.RedactString(op.Err.Error())
+	}
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		l.logger.Warn("failed to record operation", "error", err)
+		return
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		l.logger.Warn("failed to record operation", "error", err)
+	}
+}
+
+// RecentOperations returns up to n of the most recently recorded operations, oldest first.
+func RecentOperations(n int) ([]Operation, error) {
+	path, err := operationsPath()
+	if err != nil {
+		return nil, err
+	}
+	ops, err := readOperations(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(ops) > n {
+		ops = ops[len(ops)-n:]
+	}
+	return ops, nil
+}
+
+// readOperations reads recorded operations, skipping lines torn by
+// concurrent writers
+func readOperations(path string) ([]Operation, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var ops []Operation
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var op Operation
+		if json.Unmarshal(scanner.Bytes(), &op) == nil {
+			ops = append(ops, op)
+		}
+	}
+	return ops, scanner.Err()
+}
+
+// writeOperations replaces the recorded operations
+func writeOperations(path string, ops []Operation) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, op := range ops {
+		if err := enc.Encode(op); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o600)
+}
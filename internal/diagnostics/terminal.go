@@ -0,0 +1,91 @@
+// Package diagnostics records what support needs to debug a problem after the
+// fact: the terminal the UI ran in and recent control plane operations. It
+// also packages them, with logs and config, into a bundle for bug reports.
+package diagnostics
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/usetero/cli/internal/config"
+)
+
+// terminalEnv are the environment variables that describe a terminal.
+// Everything else is left out since the environment can hold secrets.
+var terminalEnv = []string{
+	"TERM", "TERM_PROGRAM", "TERM_PROGRAM_VERSION", "COLORTERM",
+	"LANG", "LC_ALL", "TMUX", "STY", "SSH_TTY", "WT_SESSION",
+}
+
+// Terminal describes the terminal the UI last ran in
+type Terminal struct {
+	// Version is the terminal's XTVERSION reply, e.g. "ghostty 1.1.0"
+	Version string `json:"version,omitempty"`
+
+	// Env holds the terminal-describing environment variables that were set
+	Env map[string]string `json:"env,omitempty"`
+
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+// TerminalEnv picks the terminal-describing variables out of an environment,
+// as returned by getenv.
+func TerminalEnv(getenv func(string) string) map[string]string {
+	env := make(map[string]string)
+	for _, name := range terminalEnv {
+		if v := getenv(name); v != "" {
+			env[name] = v
+		}
+	}
+	return env
+}
+
+// terminalPath is where the last terminal is saved
+func terminalPath() (string, error) {
+	state, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(state, "terminal.json"), nil
+}
+
+// SaveTerminal records the terminal for later bug reports.
+func SaveTerminal(t Terminal) error {
+	path, err := terminalPath()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// LoadTerminal returns the last recorded terminal, or nil if the UI hasn't run.
+func LoadTerminal() (*Terminal, error) {
+	path, err := terminalPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var t Terminal
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
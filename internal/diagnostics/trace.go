@@ -0,0 +1,83 @@
+package diagnostics
+
+import (
+	"encoding/json"
+
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/pkg/client"
+)
+
+// Tracer logs every control plane operation in detail: name, variables with
+// secrets redacted, latency, response size, and error extensions. It backs
+// the --trace flag.
+type Tracer struct {
+	logger log.Logger
+}
+
+// NewTracer creates a tracer that writes to logger at info level.
+func NewTracer(logger log.Logger) *Tracer {
+	return &Tracer{logger: logger}
+}
+
+// Observe implements client.Observer.
+func (t *Tracer) Observe(op client.Operation) {
+	args := []any{
+		"operation", op.Name,
+		"duration", op.Duration,
+		"response_bytes", op.ResponseBytes,
+	}
+	if len(op.Variables) > 0 {
+		args = append(args, "variables", RedactJSON(op.Variables))
+	}
+	if op.Err != nil {
+		args = append(args, "error", op.Err)
+	}
+	if len(op.ErrorExtensions) > 0 {
+		if ext, err := json.Marshal(op.ErrorExtensions); err == nil {
+			args = append(args, "error_extensions", RedactJSON(ext))
+		}
+	}
+
+	if op.Err != nil {
+		t.logger.Warn("graphql operation failed", args...)
+		return
+	}
+	t.logger.Info("graphql operation", args...)
+}
+
+// RedactJSON returns data with the values of secret-named fields, such as
+// apiKey, replaced. Invalid JSON is returned as a redacted string.
+func RedactJSON(data []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return log.RedactString(string(data))
+	}
+	out, err := json.Marshal(redactJSONValue(v))
+	if err != nil {
+		return log.Redacted
+	}
+	return string(out)
+}
+
+func redactJSONValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if _, isString := val.(string); isString && log.IsSensitiveKey(k) {
+				v[k] = log.Redacted
+			} else {
+				v[k] = redactJSONValue(val)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactJSONValue(val)
+		}
+		return v
+	case string:
+		return log.RedactString(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,21 @@
+package diagnostics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactJSON(t *testing.T) {
+	got := RedactJSON([]byte(`{"input":{"name":"prod","apiKey":"dd-api-secret","appKey":"dd-app-secret","site":"US1"},"first":100}`))
+
+	for _, secret := range []string{"dd-api-secret", "dd-app-secret"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("RedactJSON() = %s, contains %q", got, secret)
+		}
+	}
+	for _, keep := range []string{`"name":"prod"`, `"site":"US1"`, `"first":100`} {
+		if !strings.Contains(got, keep) {
+			t.Errorf("RedactJSON() = %s, lost %s", got, keep)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Package doctor checks the environment the CLI runs in and reports what's
+// wrong with hints for fixing it, so support doesn't start with "send us your log".
+package doctor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// Status is the outcome of a check
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// Result is the outcome of one check. Hint says how to fix a warning or failure.
+type Result struct {
+	Name    string `json:"name"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+	Hint    string `json:"hint,omitempty"`
+}
+
+// Pass reports a check that found nothing wrong.
+func Pass(name, message string) Result {
+	return Result{Name: name, Status: StatusPass, Message: message}
+}
+
+// Warn reports a problem that doesn't stop the CLI from working.
+func Warn(name, message, hint string) Result {
+	return Result{Name: name, Status: StatusWarn, Message: message, Hint: hint}
+}
+
+// Fail reports a problem that stops the CLI from working.
+func Fail(name, message, hint string) Result {
+	return Result{Name: name, Status: StatusFail, Message: message, Hint: hint}
+}
+
+// Failures counts failed checks.
+func Failures(results []Result) int {
+	n := 0
+	for _, r := range results {
+		if r.Status == StatusFail {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteText renders results as an aligned list with hints under problems.
+func WriteText(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", statusLabel(r.Status), r.Name, r.Message)
+		if r.Hint != "" {
+			fmt.Fprintf(tw, "\t\t→ %s\n", r.Hint)
+		}
+	}
+	return tw.Flush()
+}
+
+// WriteJSON renders results as JSON, for attaching to support tickets.
+func WriteJSON(w io.Writer, results []Result) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Checks   []Result `json:"checks"`
+		Failures int      `json:"failures"`
+	}{results, Failures(results)})
+}
+
+func statusLabel(s Status) string {
+	switch s {
+	case StatusPass:
+		return "[pass]"
+	case StatusWarn:
+		return "[warn]"
+	default:
+		return "[FAIL]"
+	}
+}
+
+// CheckURL checks that url answers HTTP requests. Any response counts, since
+// endpoints like GraphQL reject a bare GET; only server errors and network
+// failures are problems.
+func CheckURL(ctx context.Context, httpClient *http.Client, name, url, hint string) Result {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Fail(name, fmt.Sprintf("invalid URL %q: %v", url, err), hint)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return Fail(name, fmt.Sprintf("%s is unreachable: %v", url, err), hint)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= 500 {
+		return Warn(name, fmt.Sprintf("%s responded with %s", url, resp.Status), "The service may be degraded; try again shortly")
+	}
+	return Pass(name, fmt.Sprintf("%s responds", url))
+}
+
+// CheckConfigFile checks that the config file parses and isn't readable by
+// other users. parse is config loading, passed in to keep this package free
+// of the config package.
+func CheckConfigFile(name, path string, parse func() error) Result {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Pass(name, fmt.Sprintf("%s doesn't exist yet (created on first sign-in)", path))
+	}
+	if err != nil {
+		return Fail(name, fmt.Sprintf("can't read %s: %v", path, err), "Check the file's ownership")
+	}
+
+	if err := parse(); err != nil {
+		return Fail(name, fmt.Sprintf("%s doesn't parse: %v", path, err), fmt.Sprintf("Fix the YAML, or move %s aside and run 'tero' to set up again", path))
+	}
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		return Warn(name, fmt.Sprintf("%s is readable by other users (mode %o)", path, perm), fmt.Sprintf("Run 'chmod 600 %s'", path))
+	}
+	return Pass(name, fmt.Sprintf("%s parses and is private", path))
+}
+
+// CheckTerminal checks that the terminal is at least minWidth×minHeight, the
+// smallest the interactive UI renders in. It measures the first of fds that's
+// a terminal, so redirecting one stream (e.g. '--json > report.json') doesn't
+// hide it.
+func CheckTerminal(name string, fds []uintptr, minWidth, minHeight int) Result {
+	i := slices.IndexFunc(fds, term.IsTerminal)
+	if i < 0 {
+		return Warn(name, "not running in a terminal", "Run 'tero' from an interactive terminal to use the UI; other commands work without one")
+	}
+	fd := fds[i]
+	width, height, err := term.GetSize(fd)
+	if err != nil {
+		return Warn(name, fmt.Sprintf("can't read the terminal size: %v", err), "")
+	}
+	if width < minWidth || height < minHeight {
+		return Fail(name, fmt.Sprintf("terminal is %d×%d, smaller than the %d×%d minimum", width, height, minWidth, minHeight), "Enlarge the window or reduce the font size")
+	}
+	return Pass(name, fmt.Sprintf("terminal is %d×%d", width, height))
+}
@@ -0,0 +1,78 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	parseOK := func() error { return nil }
+
+	if got := CheckConfigFile("Config", path, parseOK); got.Status != StatusPass {
+		t.Errorf("missing file: status = %s, want pass", got.Status)
+	}
+
+	if err := os.WriteFile(path, []byte("email: a@example.com\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got := CheckConfigFile("Config", path, parseOK); got.Status != StatusWarn || got.Hint == "" {
+		t.Errorf("world-readable file: got %+v, want warn with hint", got)
+	}
+
+	if err := os.Chmod(path, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if got := CheckConfigFile("Config", path, parseOK); got.Status != StatusPass {
+		t.Errorf("private file: got %+v, want pass", got)
+	}
+
+	parseErr := func() error { return errors.New("yaml: line 1: did not find expected key") }
+	if got := CheckConfigFile("Config", path, parseErr); got.Status != StatusFail {
+		t.Errorf("unparseable file: got %+v, want fail", got)
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	status := http.StatusMethodNotAllowed
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	// A GraphQL endpoint rejecting GET still proves it's reachable
+	if got := CheckURL(context.Background(), srv.Client(), "API", srv.URL, ""); got.Status != StatusPass {
+		t.Errorf("405: got %+v, want pass", got)
+	}
+
+	status = http.StatusBadGateway
+	if got := CheckURL(context.Background(), srv.Client(), "API", srv.URL, ""); got.Status != StatusWarn {
+		t.Errorf("502: got %+v, want warn", got)
+	}
+
+	srv.Close()
+	if got := CheckURL(context.Background(), srv.Client(), "API", srv.URL, "check the network"); got.Status != StatusFail || got.Hint != "check the network" {
+		t.Errorf("closed server: got %+v, want fail with hint", got)
+	}
+}
+
+func TestCheckTerminal(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "report.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if got := CheckTerminal("Terminal", []uintptr{f.Fd()}, 80, 24); got.Status != StatusWarn || got.Hint == "" {
+		t.Errorf("no terminal: got %+v, want warn with hint", got)
+	}
+	if got := CheckTerminal("Terminal", nil, 80, 24); got.Status != StatusWarn {
+		t.Errorf("no streams: got %+v, want warn", got)
+	}
+}
@@ -0,0 +1,127 @@
+// Package export renders a workspace's log rules as configuration for the
+// tools that enforce them outside Tero (log pipelines, Terraform, collectors).
+//
+// A log event shows up in the logs as its service plus its name in Datadog's
+// standard evt.name attribute, so every format matches on those two values.
+package export
+
+import (
+	"cmp"
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/usetero/cli/internal/api"
+)
+
+// Attributes a rule matches on
+const (
+	ServiceAttribute   = "service"
+	EventNameAttribute = "evt.name"
+)
+
+// activeRules returns the rules that haven't been ignored, ordered by service,
+// log event, and rule ID so the same rules always export the same way.
+func activeRules(rules []api.LogRule) []api.LogRule {
+	var active []api.LogRule
+	for _, r := range rules {
+		if r.IsActive() {
+			active = append(active, r)
+		}
+	}
+	slices.SortStableFunc(active, func(a, b api.LogRule) int {
+		return cmp.Or(
+			cmp.Compare(a.ServiceName, b.ServiceName),
+			cmp.Compare(a.LogEventName, b.LogEventName),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+	return active
+}
+
+// ruleLabel returns a short human label for a rule, e.g. "checkout / payment_retry"
+func ruleLabel(r api.LogRule) string {
+	service := cmp.Or(r.ServiceName, "(no service)")
+	return service + " / " + r.LogEventName
+}
+
+// ruleSummary returns the retention and confidence, e.g. "DROP (high confidence)"
+func ruleSummary(r api.LogRule) string {
+	return strings.ToUpper(string(r.Retention)) + " (" + strings.ReplaceAll(string(r.Confidence), "_", " ") + " confidence)"
+}
+
+// ruleComment returns comment lines explaining a rule: what it matches,
+// its decision, and the rationale behind it, wrapped for reading.
+func ruleComment(r api.LogRule) []string {
+	lines := []string{ruleLabel(r) + ": " + ruleSummary(r)}
+	if r.Rationale != "" {
+		lines = append(lines, wrap(r.Rationale, 76)...)
+	}
+	return lines
+}
+
+// wrap splits text into lines of at most width characters, breaking on spaces.
+// Existing line breaks are kept; words longer than width get a line of their own.
+func wrap(text string, width int) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(strings.TrimSpace(text), "\n") {
+		var line string
+		for _, word := range strings.Fields(paragraph) {
+			switch {
+			case line == "":
+				line = word
+			case len(line)+1+len(word) > width:
+				lines = append(lines, line)
+				line = word
+			default:
+				line += " " + word
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+var nonIdentifier = regexp.MustCompile(`[^a-z0-9]+`)
+
+// identifier turns parts into a lowercase snake_case identifier that's safe
+// as a component or resource name in every format
+func identifier(parts ...string) string {
+	var cleaned []string
+	for _, p := range parts {
+		p = strings.Trim(nonIdentifier.ReplaceAllString(strings.ToLower(p), "_"), "_")
+		if p != "" {
+			cleaned = append(cleaned, p)
+		}
+	}
+	return strings.Join(cleaned, "_")
+}
+
+// uniqueIdentifiers hands out identifiers, numbering repeats (name, name_2, ...).
+// It maps each identifier handed out to the last number tried for it, so a
+// number is skipped if another name already took it (a rule named "name_2").
+type uniqueIdentifiers map[string]int
+
+func (u uniqueIdentifiers) next(id string) string {
+	n := max(u[id], 1)
+	candidate := id
+	for u[candidate] > 0 {
+		n++
+		candidate = id + "_" + strconv.Itoa(n)
+	}
+	u[id] = n
+	u[candidate] = max(u[candidate], 1)
+	return candidate
+}
+
+// quote returns s as a double-quoted string with JSON escapes, which VRL,
+// YAML, TOML, and HCL all read the same way (HCL templates aside)
+func quote(s string) string {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s) // Encoding a string can't fail
+	return strings.TrimSuffix(b.String(), "\n")
+}
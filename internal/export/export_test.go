@@ -0,0 +1,43 @@
+package export
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUniqueIdentifiers(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []string
+		want []string
+	}{
+		{
+			name: "numbers repeats",
+			ids:  []string{"drop_health", "drop_health", "drop_health"},
+			want: []string{"drop_health", "drop_health_2", "drop_health_3"},
+		},
+		{
+			name: "skips numbers taken by earlier names",
+			ids:  []string{"drop_health_2", "drop_health", "drop_health"},
+			want: []string{"drop_health_2", "drop_health", "drop_health_3"},
+		},
+		{
+			name: "renames later names that collide with a numbered repeat",
+			ids:  []string{"drop_health", "drop_health", "drop_health_2"},
+			want: []string{"drop_health", "drop_health_2", "drop_health_2_2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := uniqueIdentifiers{}
+			var got []string
+			for _, id := range tt.ids {
+				got = append(got, u.next(id))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
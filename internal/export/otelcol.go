@@ -0,0 +1,111 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/usetero/cli/internal/api"
+)
+
+// OTelColProcessor is the component ID of the exported filter processor
+const OTelColProcessor = "filter/tero"
+
+// OTelColOptions configures an OpenTelemetry Collector export
+type OTelColOptions struct {
+	Workspace string // Shown in the header comment
+
+	// EventAttribute is the log record attribute holding the log event name,
+	// e.g. "event.name". OTel has no standard one, so without it rules can't
+	// be matched and are all skipped.
+	EventAttribute string
+}
+
+// skippedRule is a rule that couldn't be exported, and why
+type skippedRule struct {
+	rule   api.LogRule
+	reason string
+}
+
+// OTelCol writes an OpenTelemetry Collector filter processor that drops logs
+// matching a workspace's active drop rules, with one OTTL condition per rule
+// keyed by the service.name resource attribute and opts.EventAttribute. Rules
+// that can't be expressed as a condition are listed in the header with the reason.
+func OTelCol(w io.Writer, rules []api.LogRule, opts OTelColOptions) error {
+	var (
+		exported []api.LogRule
+		skipped  []skippedRule
+	)
+	for _, r := range activeRules(rules) {
+		if r.Retention != api.LogRuleRetentionDrop {
+			continue
+		}
+		if reason := ottlUnsupported(r, opts.EventAttribute); reason != "" {
+			skipped = append(skipped, skippedRule{rule: r, reason: reason})
+			continue
+		}
+		exported = append(exported, r)
+	}
+
+	var b strings.Builder
+	writeComment(&b, "", []string{
+		"Generated by 'tero rules export --format otelcol'.",
+		fmt.Sprintf("Workspace %s: %d drop rule(s) as filter conditions.", opts.Workspace, len(exported)),
+		fmt.Sprintf("Add %s to your logs pipeline's processors. Re-export after rules change.", OTelColProcessor),
+	})
+	if opts.EventAttribute != "" {
+		writeComment(&b, "", []string{
+			fmt.Sprintf("Logs are matched on the service.name resource attribute and the %s", opts.EventAttribute),
+			"log attribute; logs missing either are kept.",
+		})
+	}
+	if len(skipped) > 0 {
+		b.WriteString("#\n")
+		writeComment(&b, "", []string{"Skipped drop rules:"})
+		for _, s := range skipped {
+			writeComment(&b, "", []string{"  " + ruleLabel(s.rule) + ": " + s.reason})
+		}
+	}
+
+	b.WriteString("\nprocessors:\n")
+	fmt.Fprintf(&b, "  %s:\n", OTelColProcessor)
+	b.WriteString("    # A condition that can't be evaluated (e.g. a missing attribute) keeps the log\n")
+	b.WriteString("    error_mode: ignore\n")
+	b.WriteString("    logs:\n")
+	if len(exported) == 0 {
+		b.WriteString("      log_record: []\n")
+	} else {
+		b.WriteString("      log_record:\n")
+	}
+	for i, r := range exported {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		writeComment(&b, "        ", ruleComment(r))
+		fmt.Fprintf(&b, "        - %s\n", yamlString(ottlMatch(r, opts.EventAttribute)))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ottlUnsupported returns why a rule can't be expressed as an OTTL condition
+// on eventAttribute, or "" if it can
+func ottlUnsupported(r api.LogRule, eventAttribute string) string {
+	switch {
+	case r.ServiceName == "":
+		return "log event has no service to match service.name on"
+	case r.LogEventName == "":
+		return "log event has no name to match on"
+	case eventAttribute == "":
+		return "no log attribute holds the event name (pass --event-attribute)"
+	default:
+		return ""
+	}
+}
+
+// ottlMatch returns an OTTL log condition matching a rule's log event
+func ottlMatch(r api.LogRule, eventAttribute string) string {
+	return fmt.Sprintf(`resource.attributes["service.name"] == %s and attributes[%s] == %s`,
+		quote(r.ServiceName), quote(eventAttribute), quote(r.LogEventName))
+}
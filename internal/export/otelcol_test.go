@@ -0,0 +1,69 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/usetero/cli/internal/api"
+	"gopkg.in/yaml.v2"
+)
+
+func TestOTelCol(t *testing.T) {
+	rules := []api.LogRule{
+		rule("r1", "checkout", "payment_retry", api.LogRuleRetentionDrop, ""),
+		rule("r2", "auth", "login", api.LogRuleRetentionKeep, ""),
+		rule("r3", "", "orphaned_event", api.LogRuleRetentionDrop, ""),
+	}
+
+	t.Run("matches on the event attribute", func(t *testing.T) {
+		out, conditions := otelcol(t, rules, OTelColOptions{Workspace: "ws-1", EventAttribute: "event.name"})
+
+		want := `resource.attributes["service.name"] == "checkout" and attributes["event.name"] == "payment_retry"`
+		if len(conditions) != 1 || conditions[0] != want {
+			t.Errorf("conditions = %q, want [%q]", conditions, want)
+		}
+		if !strings.Contains(out, "# Logs are matched on the service.name resource attribute and the event.name") {
+			t.Errorf("header doesn't say which attributes are matched\n%s", out)
+		}
+		if !strings.Contains(out, "#   (no service) / orphaned_event: log event has no service") {
+			t.Errorf("rule without a service not listed as skipped\n%s", out)
+		}
+	})
+
+	t.Run("skips rules without an event attribute", func(t *testing.T) {
+		out, conditions := otelcol(t, rules, OTelColOptions{Workspace: "ws-1"})
+
+		if len(conditions) != 0 {
+			t.Errorf("conditions = %q, want none", conditions)
+		}
+		if !strings.Contains(out, "#   checkout / payment_retry: no log attribute holds the event name (pass --event-attribute)") {
+			t.Errorf("rule not listed as skipped\n%s", out)
+		}
+	})
+}
+
+// otelcol exports rules, returning the output and the processor's conditions
+func otelcol(t *testing.T, rules []api.LogRule, opts OTelColOptions) (string, []string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := OTelCol(&buf, rules, opts); err != nil {
+		t.Fatalf("OTelCol() error = %v", err)
+	}
+	out := buf.String()
+
+	var config struct {
+		Processors map[string]struct {
+			ErrorMode string `yaml:"error_mode"`
+			Logs      struct {
+				LogRecord []string `yaml:"log_record"`
+			} `yaml:"logs"`
+		} `yaml:"processors"`
+	}
+	if err := yaml.Unmarshal(buf.Bytes(), &config); err != nil {
+		t.Fatalf("output is not valid YAML: %v\n%s", err, out)
+	}
+
+	return out, config.Processors[OTelColProcessor].Logs.LogRecord
+}
@@ -0,0 +1,146 @@
+package export
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/usetero/cli/internal/api"
+)
+
+// TerraformFilterVariable is the variable the exported indexes read their own
+// filter query from. Tero only knows an index's exclusion filters, not which
+// logs the index itself accepts.
+const TerraformFilterVariable = "datadog_logs_index_filters"
+
+// TerraformOptions configures a Terraform export
+type TerraformOptions struct {
+	Workspace string // Shown in the header comment
+}
+
+// terraformIndex is one datadog_logs_index resource and the drop rules it excludes
+type terraformIndex struct {
+	name  string
+	rules []api.LogRule
+}
+
+// Terraform writes a datadog_logs_index resource per Datadog log index with an
+// exclusion_filter for each active drop rule deployed, or pending deployment,
+// to that index. Indexes are ordered by name and filters by service and log
+// event, so the output only changes when the rules do.
+func Terraform(w io.Writer, rules []api.LogRule, opts TerraformOptions) error {
+	byIndex := map[string]*terraformIndex{}
+	var undeployed []api.LogRule
+	for _, r := range activeRules(rules) {
+		if r.Retention != api.LogRuleRetentionDrop {
+			continue
+		}
+		deployed := false
+		for _, d := range r.Deployments {
+			if d.IndexName == "" {
+				continue
+			}
+			idx, ok := byIndex[d.IndexName]
+			if !ok {
+				idx = &terraformIndex{name: d.IndexName}
+				byIndex[d.IndexName] = idx
+			}
+			// A rule is deployed to an index at most once, but don't trust that
+			if !slices.ContainsFunc(idx.rules, func(existing api.LogRule) bool { return existing.ID == r.ID }) {
+				idx.rules = append(idx.rules, r)
+			}
+			deployed = true
+		}
+		if !deployed {
+			undeployed = append(undeployed, r)
+		}
+	}
+
+	indexes := make([]*terraformIndex, 0, len(byIndex))
+	for _, idx := range byIndex {
+		indexes = append(indexes, idx)
+	}
+	slices.SortFunc(indexes, func(a, b *terraformIndex) int { return cmp.Compare(a.name, b.name) })
+
+	var b strings.Builder
+	writeComment(&b, "", []string{
+		"Generated by 'tero rules export --format terraform'.",
+		fmt.Sprintf("Workspace %s: drop rules as exclusion filters on %d index(es).", opts.Workspace, len(indexes)),
+		"Exclusion filters on these indexes that aren't listed here are removed on",
+		"apply; copy any you want to keep into the resource. Re-export after rules change.",
+	})
+	if len(undeployed) > 0 {
+		b.WriteString("#\n")
+		writeComment(&b, "", []string{"Drop rules not deployed to any index yet, so not exported:"})
+		for _, r := range undeployed {
+			writeComment(&b, "", []string{"  " + ruleLabel(r)})
+		}
+	}
+
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "variable %s {\n", hclString(TerraformFilterVariable))
+	b.WriteString("  description = \"Each index's own filter query, keyed by index name\"\n")
+	b.WriteString("  type        = map(string)\n")
+	b.WriteString("}\n")
+
+	labels := uniqueIdentifiers{}
+	for _, idx := range indexes {
+		label := identifier(idx.name)
+		if label == "" || !startsWithLetter.MatchString(label) {
+			label = identifier("index", label)
+		}
+
+		b.WriteString("\n")
+		fmt.Fprintf(&b, "resource \"datadog_logs_index\" %s {\n", hclString(labels.next(label)))
+		fmt.Fprintf(&b, "  name = %s\n", hclString(idx.name))
+		b.WriteString("\n")
+		b.WriteString("  filter {\n")
+		fmt.Fprintf(&b, "    query = var.%s[%s]\n", TerraformFilterVariable, hclString(idx.name))
+		b.WriteString("  }\n")
+
+		for _, r := range idx.rules {
+			b.WriteString("\n")
+			writeComment(&b, "  ", ruleComment(r))
+			b.WriteString("  exclusion_filter {\n")
+			fmt.Fprintf(&b, "    name       = %s\n", hclString("tero: "+ruleLabel(r)))
+			b.WriteString("    is_enabled = true\n")
+			b.WriteString("\n")
+			b.WriteString("    filter {\n")
+			fmt.Fprintf(&b, "      query       = %s\n", hclString(datadogQuery(r)))
+			b.WriteString("      sample_rate = 1.0\n")
+			b.WriteString("    }\n")
+			b.WriteString("  }\n")
+		}
+		b.WriteString("}\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var startsWithLetter = regexp.MustCompile(`^[a-z]`)
+
+// datadogQuery returns a Datadog log search query matching a rule's log event
+func datadogQuery(r api.LogRule) string {
+	return ServiceAttribute + ":" + datadogValue(r.ServiceName) + " @" + EventNameAttribute + ":" + datadogValue(r.LogEventName)
+}
+
+var plainDatadogValue = regexp.MustCompile(`^[A-Za-z0-9_.\-/]+$`)
+
+// datadogValue returns a value for a Datadog search term, quoting it when it
+// has characters the query syntax would otherwise interpret
+func datadogValue(s string) string {
+	if plainDatadogValue.MatchString(s) {
+		return s
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// hclString returns s as an HCL string, escaping template sequences so it's
+// taken literally
+func hclString(s string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(quote(s))
+}
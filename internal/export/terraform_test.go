@@ -0,0 +1,79 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/usetero/cli/internal/api"
+)
+
+func TestTerraform(t *testing.T) {
+	deployedTo := func(r api.LogRule, indexes ...string) api.LogRule {
+		for _, name := range indexes {
+			r.Deployments = append(r.Deployments, api.LogRuleDeployment{IndexName: name})
+		}
+		return r
+	}
+	rules := []api.LogRule{
+		deployedTo(rule("r2", "web", "asset_served", api.LogRuleRetentionDrop, ""), "main"),
+		deployedTo(rule("r1", "checkout", "payment_retry", api.LogRuleRetentionDrop, ""), "main", "archive"),
+		deployedTo(rule("r3", "auth", "login", api.LogRuleRetentionKeep, ""), "main"),
+		rule("r4", "search", "query_cached", api.LogRuleRetentionDrop, ""),
+	}
+
+	var first, second bytes.Buffer
+	if err := Terraform(&first, rules, TerraformOptions{Workspace: "ws-1"}); err != nil {
+		t.Fatalf("Terraform() error = %v", err)
+	}
+	// Input order must not change the output
+	rules[0], rules[1] = rules[1], rules[0]
+	if err := Terraform(&second, rules, TerraformOptions{Workspace: "ws-1"}); err != nil {
+		t.Fatalf("Terraform() error = %v", err)
+	}
+	out := first.String()
+	if out != second.String() {
+		t.Errorf("output depends on rule order:\n%s\n---\n%s", out, second.String())
+	}
+
+	// Indexes in name order, filters in service/event order within each
+	wantOrder := []string{
+		`resource "datadog_logs_index" "archive"`,
+		`query       = "service:checkout @evt.name:payment_retry"`,
+		`resource "datadog_logs_index" "main"`,
+		`name       = "tero: checkout / payment_retry"`,
+		`query       = "service:web @evt.name:asset_served"`,
+	}
+	pos := 0
+	for _, want := range wantOrder {
+		i := strings.Index(out[pos:], want)
+		if i < 0 {
+			t.Fatalf("output missing %q after offset %d\n%s", want, pos, out)
+		}
+		pos += i + len(want)
+	}
+
+	if strings.Contains(out, "auth @evt.name:login") {
+		t.Error("keep rule exported as an exclusion filter")
+	}
+	if !strings.Contains(out, "#   search / query_cached") {
+		t.Errorf("undeployed drop rule not listed\n%s", out)
+	}
+	if !strings.Contains(out, `query = var.datadog_logs_index_filters["main"]`) {
+		t.Errorf("index filter not read from the variable\n%s", out)
+	}
+}
+
+func TestDatadogValue(t *testing.T) {
+	tests := map[string]string{
+		"payment_retry": "payment_retry",
+		"api/v1.users":  "api/v1.users",
+		"my service":    `"my service"`,
+		`say "hi"`:      `"say \"hi\""`,
+	}
+	for in, want := range tests {
+		if got := datadogValue(in); got != want {
+			t.Errorf("datadogValue(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/usetero/cli/internal/api"
+)
+
+// Syntax is the file syntax for formats that support more than one
+type Syntax string
+
+const (
+	SyntaxYAML Syntax = "yaml"
+	SyntaxTOML Syntax = "toml"
+)
+
+// ParseSyntax parses a user-provided syntax name
+func ParseSyntax(s string) (Syntax, error) {
+	switch Syntax(strings.ToLower(s)) {
+	case SyntaxYAML, "yml":
+		return SyntaxYAML, nil
+	case SyntaxTOML:
+		return SyntaxTOML, nil
+	default:
+		return "", fmt.Errorf("invalid syntax %q (expected yaml or toml)", s)
+	}
+}
+
+// VectorOutput is the ID of the last transform in the exported chain.
+// It doesn't change as rules come and go, so sinks can read from it.
+const VectorOutput = "tero_rules"
+
+// VectorOptions configures a Vector export
+type VectorOptions struct {
+	Syntax    Syntax
+	Input     string // Component the first transform reads from, e.g. a datadog_agent source
+	Workspace string // Shown in the header comment
+}
+
+// vectorTransform is one transform in the exported chain
+type vectorTransform struct {
+	comment   []string
+	id        string
+	kind      string // filter or remap
+	input     string
+	condition string // filter only
+	source    string // remap only
+}
+
+// Vector writes Vector transforms that enforce a workspace's active rules.
+// Drop rules become filter transforms. Keep rules with a VRL script become
+// remap transforms that run the script on matching logs; keep rules without
+// one need nothing. The transforms are chained from opts.Input and end in
+// VectorOutput, so the file loads next to an existing source and sink config.
+func Vector(w io.Writer, rules []api.LogRule, opts VectorOptions) error {
+	var (
+		transforms []vectorTransform
+		ids        = uniqueIdentifiers{}
+		input      = opts.Input
+		passed     int
+	)
+	for _, r := range activeRules(rules) {
+		t := vectorTransform{
+			comment: ruleComment(r),
+			input:   input,
+		}
+		match := vrlMatch(r)
+		switch {
+		case r.Retention == api.LogRuleRetentionDrop:
+			t.id = ids.next(identifier("tero_drop", r.ServiceName, r.LogEventName))
+			t.kind = "filter"
+			t.condition = "!(" + match + ")"
+		case r.VRLScript != "":
+			t.id = ids.next(identifier("tero_remap", r.ServiceName, r.LogEventName))
+			t.kind = "remap"
+			t.source = "if " + match + " {\n" + indent(strings.TrimSpace(r.VRLScript), "  ") + "\n}"
+		default:
+			passed++
+			continue
+		}
+		transforms = append(transforms, t)
+		input = t.id
+	}
+
+	// A pass-through filter gives sinks a name that survives rule changes
+	transforms = append(transforms, vectorTransform{
+		comment:   []string{"Everything Tero keeps. Point your sinks' inputs here."},
+		id:        VectorOutput,
+		kind:      "filter",
+		input:     input,
+		condition: "true",
+	})
+
+	header := []string{
+		"Generated by 'tero rules export --format vector'.",
+		fmt.Sprintf("Workspace %s: %d rule transform(s); %d keep rule(s) need no transform.", opts.Workspace, len(transforms)-1, passed),
+		fmt.Sprintf("Logs are matched on .%s and .%s. Re-export after rules change.", ServiceAttribute, EventNameAttribute),
+	}
+
+	if opts.Syntax == SyntaxTOML {
+		return writeVectorTOML(w, header, transforms)
+	}
+	return writeVectorYAML(w, header, transforms)
+}
+
+// vrlMatch returns a VRL condition matching a rule's log event
+func vrlMatch(r api.LogRule) string {
+	return fmt.Sprintf(".%s == %s && .%s == %s",
+		ServiceAttribute, quote(r.ServiceName),
+		EventNameAttribute, quote(r.LogEventName))
+}
+
+// writeVectorYAML writes the transforms as a Vector YAML config
+func writeVectorYAML(w io.Writer, header []string, transforms []vectorTransform) error {
+	var b strings.Builder
+	writeComment(&b, "", header)
+	b.WriteString("\ntransforms:\n")
+	for i, t := range transforms {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		writeComment(&b, "  ", t.comment)
+		fmt.Fprintf(&b, "  %s:\n", t.id)
+		fmt.Fprintf(&b, "    type: %s\n", t.kind)
+		fmt.Fprintf(&b, "    inputs:\n      - %s\n", yamlString(t.input))
+		if t.kind == "filter" {
+			fmt.Fprintf(&b, "    condition: %s\n", yamlString(t.condition))
+		} else {
+			fmt.Fprintf(&b, "    source: |-\n%s\n", indent(t.source, "      "))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeVectorTOML writes the transforms as a Vector TOML config
+func writeVectorTOML(w io.Writer, header []string, transforms []vectorTransform) error {
+	var b strings.Builder
+	writeComment(&b, "", header)
+	for _, t := range transforms {
+		b.WriteString("\n")
+		writeComment(&b, "", t.comment)
+		fmt.Fprintf(&b, "[transforms.%s]\n", t.id)
+		fmt.Fprintf(&b, "type = %s\n", tomlString(t.kind))
+		fmt.Fprintf(&b, "inputs = [%s]\n", tomlString(t.input))
+		if t.kind == "filter" {
+			fmt.Fprintf(&b, "condition = %s\n", tomlString(t.condition))
+		} else if strings.Contains(t.source, "'''") {
+			fmt.Fprintf(&b, "source = %s\n", quote(t.source))
+		} else {
+			fmt.Fprintf(&b, "source = '''\n%s\n'''\n", t.source)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeComment writes lines as # comments at the given indent
+func writeComment(b *strings.Builder, prefix string, lines []string) {
+	for _, line := range lines {
+		b.WriteString(strings.TrimRight(prefix+"# "+line, " ") + "\n")
+	}
+}
+
+// indent prefixes every non-empty line of s
+func indent(s, prefix string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// yamlString returns s as a YAML scalar, single-quoted so VRL's double quotes
+// don't need escaping
+func yamlString(s string) string {
+	if strings.ContainsAny(s, "\n\r\t") {
+		return quote(s)
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// tomlString returns s as a TOML string, using a literal string when it can
+// so VRL's double quotes don't need escaping
+func tomlString(s string) string {
+	if strings.ContainsAny(s, "'\n\r\t") {
+		return quote(s)
+	}
+	return "'" + s + "'"
+}
@@ -0,0 +1,107 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/usetero/cli/internal/api"
+	"gopkg.in/yaml.v2"
+)
+
+func TestVectorYAML(t *testing.T) {
+	ignored := time.Now()
+	rules := []api.LogRule{
+		rule("r3", "checkout", "cart_viewed", api.LogRuleRetentionKeep, ""),
+		rule("r1", "checkout", "payment_retry", api.LogRuleRetentionDrop, ""),
+		rule("r2", "auth", "token_refreshed", api.LogRuleRetentionKeep, "del(.token)"),
+		{ID: "r4", ServiceName: "auth", LogEventName: "login", Retention: api.LogRuleRetentionDrop, IgnoredAt: &ignored},
+	}
+	rules[1].Rationale = "Retries are logged again on success,\nso this is noise."
+
+	var buf bytes.Buffer
+	if err := Vector(&buf, rules, VectorOptions{Syntax: SyntaxYAML, Input: "datadog_agent", Workspace: "ws-1"}); err != nil {
+		t.Fatalf("Vector() error = %v", err)
+	}
+	out := buf.String()
+
+	var config struct {
+		Transforms map[string]struct {
+			Type      string   `yaml:"type"`
+			Inputs    []string `yaml:"inputs"`
+			Condition string   `yaml:"condition"`
+			Source    string   `yaml:"source"`
+		} `yaml:"transforms"`
+	}
+	if err := yaml.Unmarshal(buf.Bytes(), &config); err != nil {
+		t.Fatalf("output is not valid YAML: %v\n%s", err, out)
+	}
+
+	// Ordered by service then event: the auth remap first, then the checkout filter
+	remap := config.Transforms["tero_remap_auth_token_refreshed"]
+	if remap.Type != "remap" || remap.Inputs[0] != "datadog_agent" {
+		t.Errorf("remap = %+v, want a remap reading from datadog_agent", remap)
+	}
+	if !strings.Contains(remap.Source, `if .service == "auth" && .evt.name == "token_refreshed" {`) || !strings.Contains(remap.Source, "del(.token)") {
+		t.Errorf("remap source = %q, want the script guarded by the match", remap.Source)
+	}
+
+	filter := config.Transforms["tero_drop_checkout_payment_retry"]
+	if filter.Type != "filter" || filter.Inputs[0] != "tero_remap_auth_token_refreshed" {
+		t.Errorf("filter = %+v, want a filter chained after the remap", filter)
+	}
+	if filter.Condition != `!(.service == "checkout" && .evt.name == "payment_retry")` {
+		t.Errorf("filter condition = %q", filter.Condition)
+	}
+
+	output := config.Transforms[VectorOutput]
+	if output.Inputs[0] != "tero_drop_checkout_payment_retry" {
+		t.Errorf("output inputs = %v, want the last rule transform", output.Inputs)
+	}
+
+	// Ignored rules and keep rules without a script produce nothing
+	if len(config.Transforms) != 3 {
+		t.Errorf("got %d transforms, want 3", len(config.Transforms))
+	}
+
+	// Rationale and confidence are carried as comments
+	for _, want := range []string{"# checkout / payment_retry: DROP (high confidence)", "# Retries are logged again on success,", "# so this is noise."} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing comment %q\n%s", want, out)
+		}
+	}
+}
+
+func TestVectorTOML(t *testing.T) {
+	rules := []api.LogRule{rule("r1", "checkout", "payment_retry", api.LogRuleRetentionDrop, "")}
+
+	var buf bytes.Buffer
+	if err := Vector(&buf, rules, VectorOptions{Syntax: SyntaxTOML, Input: "in", Workspace: "ws-1"}); err != nil {
+		t.Fatalf("Vector() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"[transforms.tero_drop_checkout_payment_retry]",
+		"type = 'filter'",
+		"inputs = ['in']",
+		`condition = '!(.service == "checkout" && .evt.name == "payment_retry")'`,
+		"[transforms." + VectorOutput + "]",
+		"inputs = ['tero_drop_checkout_payment_retry']",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output missing %q\n%s", want, buf.String())
+		}
+	}
+}
+
+func rule(id, service, event string, retention api.LogRuleRetention, script string) api.LogRule {
+	return api.LogRule{
+		ID:           id,
+		ServiceName:  service,
+		LogEventName: event,
+		Retention:    retention,
+		Confidence:   api.LogRuleConfidenceHigh,
+		VRLScript:    script,
+	}
+}
@@ -0,0 +1,30 @@
+// Package format renders numbers the same way across the TUI and commands.
+package format
+
+import (
+	"math"
+	"strconv"
+)
+
+// Count formats a count compactly with one decimal, e.g. "1.5M".
+// Counts under a thousand are shown as is, e.g. "42".
+func Count(n float64) string {
+	switch {
+	case n >= 1e12:
+		return strconv.FormatFloat(n/1e12, 'f', 1, 64) + "T"
+	case n >= 1e9:
+		return strconv.FormatFloat(n/1e9, 'f', 1, 64) + "B"
+	case n >= 1e6:
+		return strconv.FormatFloat(n/1e6, 'f', 1, 64) + "M"
+	case n >= 1e3:
+		return strconv.FormatFloat(n/1e3, 'f', 1, 64) + "K"
+	default:
+		return strconv.FormatFloat(math.Round(n*10)/10, 'f', -1, 64)
+	}
+}
+
+// Percent formats a 0-100 percentage with the given number of decimals,
+// e.g. Percent(23.44, 1) is "23.4%".
+func Percent(percent float64, decimals int) string {
+	return strconv.FormatFloat(percent, 'f', decimals, 64) + "%"
+}
@@ -0,0 +1,43 @@
+package format
+
+import "testing"
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		n    float64
+		want string
+	}{
+		{0, "0"},
+		{42, "42"},
+		{0.25, "0.3"},
+		{999, "999"},
+		{1000, "1.0K"},
+		{1540, "1.5K"},
+		{1_540_000, "1.5M"},
+		{2_000_000_000, "2.0B"},
+		{3_100_000_000_000, "3.1T"},
+	}
+	for _, tt := range tests {
+		if got := Count(tt.n); got != tt.want {
+			t.Errorf("Count(%v) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		percent  float64
+		decimals int
+		want     string
+	}{
+		{0, 1, "0.0%"},
+		{23.44, 1, "23.4%"},
+		{23.44, 0, "23%"},
+		{99.6, 0, "100%"},
+	}
+	for _, tt := range tests {
+		if got := Percent(tt.percent, tt.decimals); got != tt.want {
+			t.Errorf("Percent(%v, %d) = %q, want %q", tt.percent, tt.decimals, got, tt.want)
+		}
+	}
+}
@@ -8,7 +8,10 @@ import (
 
 const (
 	serviceName = "tero-cli"
-	defaultUser = "default"
+
+	// defaultNamespace keeps the service name used before namespaces existed,
+	// so existing sign-ins carry over to the default profile
+	defaultNamespace = "default"
 )
 
 // Keyring provides secure storage for sensitive data using the system keyring.
@@ -16,15 +19,26 @@ const (
 // On macOS it uses Keychain, on Windows it uses Credential Manager, on Linux it uses Secret Service.
 type Keyring struct {
 	service string
-	user    string
 }
 
-// New creates a new keyring.
-func New() *Keyring {
-	return &Keyring{
-		service: serviceName,
-		user:    defaultUser,
+// New creates a keyring whose entries are isolated to a namespace (a profile name),
+// so the same key can hold different values for each profile.
+func New(namespace string) *Keyring {
+	service := serviceName
+	if namespace != "" && namespace != defaultNamespace {
+		service = serviceName + "/" + namespace
 	}
+	return &Keyring{service: service}
+}
+
+// Available returns an error if the OS keyring can't be used, e.g. on Linux
+// hosts without a Secret Service daemon.
+func (k *Keyring) Available() error {
+	_, err := keyring.Get(k.service, "availability-probe")
+	if err == nil || errors.Is(err, keyring.ErrNotFound) {
+		return nil
+	}
+	return err
 }
 
 // Get retrieves a value by key.
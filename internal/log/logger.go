@@ -1,8 +1,16 @@
 package log
 
 import (
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/usetero/cli/internal/config"
 )
 
 // Re-export slog attribute constructors so callers don't need to import log/slog
@@ -27,15 +35,137 @@ type Logger interface {
 	Error(msg string, args ...any)
 }
 
-// New creates a new logger that writes to /tmp/tero.log
-// Returns *slogger.Logger which implements Logger interface
-func New() Logger {
-	logFile, err := os.OpenFile("/tmp/tero.log", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+// Format is the encoding of log records
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+)
+
+const (
+	// DefaultMaxSize is the size a log file may reach before it's rotated
+	DefaultMaxSize = 10 << 20 // 10 MiB
+
+	// DefaultMaxBackups is how many rotated copies of a log file are kept
+	DefaultMaxBackups = 2
+
+	// keepProcessLogs is how many per-process logs are kept in the log directory
+	keepProcessLogs = 20
+
+	processLogPrefix = "tero-"
+	processLogExt    = ".log"
+)
+
+// Options configures New. The zero value writes info-level text to a
+// per-process file in Dir.
+type Options struct {
+	// Path is the log file. Empty means a new file per process in Dir, so
+	// concurrent runs don't clobber each other.
+	Path string
+
+	// Level filters records. Pass a *slog.LevelVar to change it after creation,
+	// e.g. once flags are parsed. Nil means info.
+	Level slog.Leveler
+
+	// Format is text or json. Empty means text.
+	Format Format
+
+	// MaxSize is the size in bytes at which the file rotates. Zero means DefaultMaxSize.
+	MaxSize int64
+
+	// MaxBackups is how many rotated files to keep. Zero means DefaultMaxBackups.
+	MaxBackups int
+}
+
+// New creates a logger writing to a rotating file, with secrets redacted.
+// The returned closer closes the file.
+func New(opts Options) (*slog.Logger, io.Closer, error) {
+	path := opts.Path
+	if path == "" {
+		dir, err := Dir()
+		if err != nil {
+			return nil, nil, err
+		}
+		path = processLogPath(dir, time.Now(), os.Getpid())
+		pruneProcessLogs(dir, keepProcessLogs-1) // Leave room for this process
+	}
+
+	maxSize := opts.MaxSize
+	if maxSize == 0 {
+		maxSize = DefaultMaxSize
+	}
+	maxBackups := opts.MaxBackups
+	if maxBackups == 0 {
+		maxBackups = DefaultMaxBackups
+	}
+	file, err := openRotatingFile(path, maxSize, maxBackups)
 	if err != nil {
-		panic(err)
+		return nil, nil, err
 	}
 
-	return slog.New(slog.NewTextHandler(logFile, &slog.HandlerOptions{
-		Level: slog.LevelDebug,
-	}))
+	handlerOpts := &slog.HandlerOptions{Level: opts.Level}
+	var handler slog.Handler
+	switch opts.Format {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(file, handlerOpts)
+	case FormatText, "":
+		handler = slog.NewTextHandler(file, handlerOpts)
+	default:
+		_ = file.Close()
+		return nil, nil, fmt.Errorf("unknown log format %q: use text or json", opts.Format)
+	}
+
+	return slog.New(NewRedactHandler(handler)), file, nil
+}
+
+// Discard returns a logger that drops every record, for when the log file
+// can't be opened.
+func Discard() Logger {
+	return slog.New(slog.DiscardHandler)
+}
+
+// Dir returns the directory for per-process logs: the logs directory under
+// config.StateDir.
+func Dir() (string, error) {
+	state, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(state, "logs"), nil
+}
+
+// Recent returns up to n per-process logs in dir, newest first.
+func Recent(dir string, n int) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, processLogPrefix+"*"+processLogExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(matches)))
+	if len(matches) > n {
+		matches = matches[:n]
+	}
+	return matches, nil
+}
+
+// processLogPath names a process's log by start time then PID, so names sort
+// oldest first
+func processLogPath(dir string, start time.Time, pid int) string {
+	return filepath.Join(dir, fmt.Sprintf("%s%s-%d%s", processLogPrefix, start.UTC().Format("20060102T150405Z"), pid, processLogExt))
+}
+
+// ParseLevel parses debug, info, warn, or error.
+func ParseLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info", "":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q: use debug, info, warn, or error", s)
+	}
 }
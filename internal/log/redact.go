@@ -0,0 +1,131 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces secret values in log output
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are substrings of attribute keys whose values are always secret
+var sensitiveKeys = []string{
+	"token",
+	"secret",
+	"password",
+	"passphrase",
+	"api_key", "apikey",
+	"app_key", "appkey", "application_key",
+	"device_code",
+	"user_code",
+	"authorization",
+	"cookie",
+}
+
+// sensitiveValues match secrets embedded in otherwise harmless strings, like
+// error messages and URLs. The first group, if any, is kept.
+var sensitiveValues = []*regexp.Regexp{
+	// JWTs, such as WorkOS access tokens
+	regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`),
+	// Authorization headers
+	regexp.MustCompile(`(?i)(bearer\s+)[^\s"',]+`),
+	// Query parameters, e.g. the user code in a device verification URL
+	regexp.MustCompile(`(?i)([?&](?:user_code|device_code|code|token|access_token|refresh_token|api_key|app_key)=)[^&\s"']+`),
+	// Datadog key headers
+	regexp.MustCompile(`(?i)(dd-(?:api|application)-key:\s*)[^\s"',]+`),
+}
+
+// redactHandler removes API keys, app keys, tokens, and device codes from
+// records before they reach the wrapped handler, so they never hit disk.
+type redactHandler struct {
+	next slog.Handler
+}
+
+// NewRedactHandler wraps next so that secrets are redacted from every record.
+func NewRedactHandler(next slog.Handler) slog.Handler {
+	return &redactHandler{next: next}
+}
+
+func (h *redactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *redactHandler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, RedactString(r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+func (h *redactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clean := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		clean[i] = redactAttr(a)
+	}
+	return &redactHandler{next: h.next.WithAttrs(clean)}
+}
+
+func (h *redactHandler) WithGroup(name string) slog.Handler {
+	return &redactHandler{next: h.next.WithGroup(name)}
+}
+
+// redactAttr redacts an attribute's value if its key names a secret, otherwise
+// scrubs secrets from inside it. Numbers and bools are kept: they can't hold a
+// secret and are useful for flags like has_token.
+func redactAttr(a slog.Attr) slog.Attr {
+	v := a.Value.Resolve()
+
+	switch v.Kind() {
+	case slog.KindGroup:
+		attrs := v.Group()
+		clean := make([]slog.Attr, len(attrs))
+		for i, ga := range attrs {
+			clean[i] = redactAttr(ga)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(clean...)}
+	case slog.KindString:
+		if IsSensitiveKey(a.Key) && v.String() != "" {
+			return slog.String(a.Key, Redacted)
+		}
+		return slog.String(a.Key, RedactString(v.String()))
+	case slog.KindAny:
+		if IsSensitiveKey(a.Key) && v.Any() != nil {
+			return slog.String(a.Key, Redacted)
+		}
+		if err, ok := v.Any().(error); ok {
+			if msg := err.Error(); RedactString(msg) != msg {
+				return slog.String(a.Key, RedactString(msg))
+			}
+		}
+		return slog.Attr{Key: a.Key, Value: v}
+	default:
+		return slog.Attr{Key: a.Key, Value: v}
+	}
+}
+
+// IsSensitiveKey reports whether an attribute key names a secret
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(strings.ReplaceAll(key, "-", "_"))
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactString replaces secrets embedded in s
+func RedactString(s string) string {
+	for _, re := range sensitiveValues {
+		if re.NumSubexp() > 0 {
+			s = re.ReplaceAllString(s, "${1}"+Redacted)
+		} else {
+			s = re.ReplaceAllString(s, Redacted)
+		}
+	}
+	return s
+}
@@ -0,0 +1,302 @@
+// Package mcp implements a Model Context Protocol server over stdio.
+//
+// The server speaks newline-delimited JSON-RPC 2.0 and supports the subset
+// of MCP that tool-only servers need: initialize, ping, tools/list, and
+// tools/call. Stdout carries the protocol, so nothing else may write to it.
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"github.com/usetero/cli/internal/log"
+)
+
+// ProtocolVersion is the latest MCP protocol revision this server implements.
+const ProtocolVersion = "2025-06-18"
+
+// supportedVersions lists protocol revisions we can negotiate down to.
+var supportedVersions = []string{ProtocolVersion, "2025-03-26", "2024-11-05"}
+
+// JSON-RPC error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// Handler runs a tool with its raw JSON arguments.
+// The returned value is encoded as JSON and sent to the agent as text content.
+// Returned errors are reported to the agent as tool errors, not protocol errors,
+// so the agent can see and react to them.
+type Handler func(ctx context.Context, args json.RawMessage) (any, error)
+
+// Tool describes a tool the server exposes.
+type Tool struct {
+	Name        string
+	Description string
+	InputSchema map[string]any
+	Handler     Handler
+}
+
+// Server is an MCP server that exposes tools to a single client over stdio.
+type Server struct {
+	name    string
+	version string
+	tools   []Tool
+	logger  log.Logger
+
+	// writeMu serializes responses so concurrent tool calls don't interleave
+	writeMu sync.Mutex
+}
+
+// NewServer creates a new MCP server.
+func NewServer(name, version string, logger log.Logger) *Server {
+	if logger == nil {
+		panic("logger cannot be nil")
+	}
+	return &Server{
+		name:    name,
+		version: version,
+		logger:  logger,
+	}
+}
+
+// AddTool registers a tool. Tools are listed in registration order.
+func (s *Server) AddTool(tool Tool) {
+	s.tools = append(s.tools, tool)
+}
+
+// request is a JSON-RPC request or notification (notifications have no ID).
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// response is a JSON-RPC response.
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError is a JSON-RPC error object.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Serve reads requests from r and writes responses to w until r is closed
+// or ctx is cancelled. Tool calls run concurrently.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.logger.Info("mcp server started", "tools", len(s.tools))
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var req request
+		if err := json.Unmarshal(line, &req); err != nil {
+			s.logger.Warn("failed to parse mcp message", "error", err)
+			s.write(w, response{ID: json.RawMessage("null"), Error: &rpcError{Code: codeParseError, Message: "parse error"}})
+			continue
+		}
+
+		// Tool calls may hit the network, so don't block the read loop on them
+		if req.Method == "tools/call" {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				s.handle(ctx, w, req)
+			}()
+			continue
+		}
+
+		s.handle(ctx, w, req)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	s.logger.Info("mcp server stopped")
+	return nil
+}
+
+// handle dispatches a single request and writes its response.
+func (s *Server) handle(ctx context.Context, w io.Writer, req request) {
+	isNotification := len(req.ID) == 0
+
+	result, rpcErr := s.dispatch(ctx, req)
+
+	// Notifications never get a response, even on error
+	if isNotification {
+		if rpcErr != nil {
+			s.logger.Debug("ignored failed mcp notification", "method", req.Method, "error", rpcErr.Message)
+		}
+		return
+	}
+
+	s.write(w, response{ID: req.ID, Result: result, Error: rpcErr})
+}
+
+// dispatch routes a request to its method implementation.
+func (s *Server) dispatch(ctx context.Context, req request) (any, *rpcError) {
+	if req.JSONRPC != "2.0" {
+		return nil, &rpcError{Code: codeInvalidRequest, Message: "jsonrpc must be \"2.0\""}
+	}
+
+	switch req.Method {
+	case "initialize":
+		return s.initialize(req.Params)
+	case "notifications/initialized", "notifications/cancelled":
+		return nil, nil
+	case "ping":
+		return struct{}{}, nil
+	case "tools/list":
+		return s.listTools(), nil
+	case "tools/call":
+		return s.callTool(ctx, req.Params)
+	default:
+		return nil, &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", req.Method)}
+	}
+}
+
+// initialize negotiates the protocol version and advertises capabilities.
+func (s *Server) initialize(params json.RawMessage) (any, *rpcError) {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"`
+		ClientInfo      struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"clientInfo"`
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+	}
+
+	// Use the client's version if we support it, otherwise offer our latest
+	version := ProtocolVersion
+	if slices.Contains(supportedVersions, p.ProtocolVersion) {
+		version = p.ProtocolVersion
+	}
+
+	s.logger.Info("mcp client connected",
+		"client", p.ClientInfo.Name,
+		"clientVersion", p.ClientInfo.Version,
+		"protocolVersion", version)
+
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities": map[string]any{
+			"tools": map[string]any{},
+		},
+		"serverInfo": map[string]any{
+			"name":    s.name,
+			"version": s.version,
+		},
+	}, nil
+}
+
+// listTools returns the registered tool definitions.
+func (s *Server) listTools() any {
+	tools := make([]map[string]any, len(s.tools))
+	for i, tool := range s.tools {
+		schema := tool.InputSchema
+		if schema == nil {
+			schema = map[string]any{"type": "object", "properties": map[string]any{}}
+		}
+		tools[i] = map[string]any{
+			"name":        tool.Name,
+			"description": tool.Description,
+			"inputSchema": schema,
+		}
+	}
+	return map[string]any{"tools": tools}
+}
+
+// callTool runs a tool and wraps its output as MCP content.
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (any, *rpcError) {
+	var p struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+
+	idx := slices.IndexFunc(s.tools, func(t Tool) bool { return t.Name == p.Name })
+	if idx < 0 {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown tool: %s", p.Name)}
+	}
+
+	if len(p.Arguments) == 0 {
+		p.Arguments = json.RawMessage("{}")
+	}
+
+	s.logger.Debug("calling mcp tool", "tool", p.Name)
+	out, err := s.tools[idx].Handler(ctx, p.Arguments)
+	if err != nil {
+		s.logger.Warn("mcp tool failed", "tool", p.Name, "error", err)
+		return toolResult(err.Error(), true), nil
+	}
+
+	text, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		s.logger.Error("failed to encode mcp tool result", "tool", p.Name, "error", err)
+		return toolResult("failed to encode result: "+err.Error(), true), nil
+	}
+
+	return toolResult(string(text), false), nil
+}
+
+// toolResult builds a tools/call result with a single text content block.
+func toolResult(text string, isError bool) map[string]any {
+	return map[string]any{
+		"content": []map[string]any{
+			{"type": "text", "text": text},
+		},
+		"isError": isError,
+	}
+}
+
+// write encodes a response as a single line.
+func (s *Server) write(w io.Writer, resp response) {
+	resp.JSONRPC = "2.0"
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		s.logger.Error("failed to encode mcp response", "error", err)
+		return
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		s.logger.Error("failed to write mcp response", "error", err)
+	}
+}
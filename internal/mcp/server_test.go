@@ -0,0 +1,135 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/usetero/cli/internal/log/logtest"
+)
+
+func TestServer_Serve(t *testing.T) {
+	t.Run("answers initialize, tools/list, and tools/call in one session", func(t *testing.T) {
+		server := NewServer("tero", "1.2.3", logtest.New(t))
+		server.AddTool(Tool{
+			Name:        "echo",
+			Description: "Echo the input",
+			Handler: func(ctx context.Context, args json.RawMessage) (any, error) {
+				var in struct {
+					Text string `json:"text"`
+				}
+				_ = json.Unmarshal(args, &in)
+				return map[string]string{"text": in.Text}, nil
+			},
+		})
+
+		input := strings.Join([]string{
+			`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","clientInfo":{"name":"test"}}}`,
+			`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+			`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+			`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"echo","arguments":{"text":"hi"}}}`,
+		}, "\n")
+
+		responses := serve(t, server, input)
+
+		if len(responses) != 3 {
+			t.Fatalf("got %d responses, want 3 (notifications get none)", len(responses))
+		}
+
+		init := responses["1"]
+		if got := init.Result["protocolVersion"]; got != "2024-11-05" {
+			t.Errorf("protocolVersion = %v, want client's 2024-11-05", got)
+		}
+
+		tools, _ := responses["2"].Result["tools"].([]any)
+		if len(tools) != 1 {
+			t.Fatalf("got %d tools, want 1", len(tools))
+		}
+
+		call := responses["3"]
+		if call.Result["isError"] != false {
+			t.Errorf("isError = %v, want false", call.Result["isError"])
+		}
+		if text := contentText(t, call.Result); !strings.Contains(text, `"text": "hi"`) {
+			t.Errorf("content = %q, want echoed text", text)
+		}
+	})
+
+	t.Run("reports tool failures as tool errors, not protocol errors", func(t *testing.T) {
+		server := NewServer("tero", "1.2.3", logtest.New(t))
+		server.AddTool(Tool{
+			Name: "broken",
+			Handler: func(ctx context.Context, args json.RawMessage) (any, error) {
+				return nil, errors.New("service not found")
+			},
+		})
+
+		responses := serve(t, server, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"broken"}}`)
+
+		resp := responses["1"]
+		if resp.Error != nil {
+			t.Fatalf("unexpected protocol error: %v", resp.Error)
+		}
+		if resp.Result["isError"] != true {
+			t.Errorf("isError = %v, want true", resp.Result["isError"])
+		}
+		if text := contentText(t, resp.Result); text != "service not found" {
+			t.Errorf("content = %q, want %q", text, "service not found")
+		}
+	})
+
+	t.Run("returns method not found for unknown methods", func(t *testing.T) {
+		server := NewServer("tero", "1.2.3", logtest.New(t))
+
+		responses := serve(t, server, `{"jsonrpc":"2.0","id":"a","method":"resources/list"}`)
+
+		resp := responses[`"a"`]
+		if resp.Error == nil || resp.Error.Code != codeMethodNotFound {
+			t.Errorf("error = %v, want code %d", resp.Error, codeMethodNotFound)
+		}
+	})
+}
+
+// testResponse is a decoded JSON-RPC response.
+type testResponse struct {
+	Result map[string]any `json:"result"`
+	Error  *rpcError      `json:"error"`
+}
+
+// serve runs the server over input and returns responses keyed by raw ID.
+func serve(t *testing.T, server *Server, input string) map[string]testResponse {
+	t.Helper()
+
+	var out bytes.Buffer
+	if err := server.Serve(context.Background(), strings.NewReader(input), &out); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	responses := make(map[string]testResponse)
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var raw struct {
+			ID json.RawMessage `json:"id"`
+			testResponse
+		}
+		if err := json.Unmarshal([]byte(line), &raw); err != nil {
+			t.Fatalf("invalid response line %q: %v", line, err)
+		}
+		responses[string(raw.ID)] = raw.testResponse
+	}
+	return responses
+}
+
+// contentText extracts the text of the first content block.
+func contentText(t *testing.T, result map[string]any) string {
+	t.Helper()
+	content, _ := result["content"].([]any)
+	if len(content) == 0 {
+		t.Fatal("result has no content")
+	}
+	block, _ := content[0].(map[string]any)
+	text, _ := block["text"].(string)
+	return text
+}
@@ -0,0 +1,146 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/usetero/cli/internal/api"
+)
+
+// errMissingArgument is returned when a required tool argument is empty.
+var errMissingArgument = errors.New("missing required argument")
+
+// windowProperty is the JSON schema for the optional lookback window argument.
+var windowProperty = map[string]any{
+	"type":        "string",
+	"enum":        []string{"day", "week", "month", "quarter"},
+	"description": "Lookback window for volume stats. Defaults to week.",
+}
+
+// serviceIDProperty is the JSON schema for the required service ID argument.
+var serviceIDProperty = map[string]any{
+	"type":        "string",
+	"description": "Service ID, as returned by list_services.",
+}
+
+// RegisterTools adds Tero's tools to the server, backed by the API services.
+func RegisterTools(s *Server, tero *api.API) {
+	s.AddTool(Tool{
+		Name:        "list_services",
+		Description: "List the services Tero has discovered from the connected observability platform, with whether analysis is enabled for each.",
+		Handler: func(ctx context.Context, args json.RawMessage) (any, error) {
+			return tero.Services.List(ctx, api.ListOptions{})
+		},
+	})
+
+	s.AddTool(Tool{
+		Name: "get_service_waste",
+		Description: "Get a service's log volume broken down into unknown (not yet classified), valuable, waste " +
+			"(drop rules not yet enforced), and saved (already filtered), with percentages of the total.",
+		InputSchema: objectSchema(map[string]any{
+			"service_id": serviceIDProperty,
+			"window":     windowProperty,
+		}, "service_id"),
+		Handler: func(ctx context.Context, args json.RawMessage) (any, error) {
+			var in struct {
+				ServiceID string `json:"service_id"`
+				Window    string `json:"window"`
+			}
+			if err := decodeArgs(args, &in); err != nil {
+				return nil, err
+			}
+			if in.ServiceID == "" {
+				return nil, fmt.Errorf("%w: service_id", errMissingArgument)
+			}
+			window, err := parseWindow(in.Window)
+			if err != nil {
+				return nil, err
+			}
+
+			stats, err := tero.Services.GetVolumeStats(ctx, in.ServiceID, window)
+			if err != nil {
+				return nil, err
+			}
+			if stats == nil {
+				return nil, fmt.Errorf("service %s not found", in.ServiceID)
+			}
+			return stats, nil
+		},
+	})
+
+	s.AddTool(Tool{
+		Name:        "list_log_events_for_service",
+		Description: "List the distinct log events a service emits, with a description of each and its log volume breakdown.",
+		InputSchema: objectSchema(map[string]any{
+			"service_id": serviceIDProperty,
+			"window":     windowProperty,
+		}, "service_id"),
+		Handler: func(ctx context.Context, args json.RawMessage) (any, error) {
+			var in struct {
+				ServiceID string `json:"service_id"`
+				Window    string `json:"window"`
+			}
+			if err := decodeArgs(args, &in); err != nil {
+				return nil, err
+			}
+			if in.ServiceID == "" {
+				return nil, fmt.Errorf("%w: service_id", errMissingArgument)
+			}
+			window, err := parseWindow(in.Window)
+			if err != nil {
+				return nil, err
+			}
+
+			return tero.LogEvents.ListForService(ctx, in.ServiceID, window, api.ListOptions{})
+		},
+	})
+
+	s.AddTool(Tool{
+		Name: "get_log_rules",
+		Description: "Get Tero's keep/drop rules for a service's log events. Each rule includes its retention decision, " +
+			"confidence, the rationale behind it, and an optional VRL script. Rules with ignoredAt set were dismissed by a user.",
+		InputSchema: objectSchema(map[string]any{
+			"service_id": serviceIDProperty,
+		}, "service_id"),
+		Handler: func(ctx context.Context, args json.RawMessage) (any, error) {
+			var in struct {
+				ServiceID string `json:"service_id"`
+			}
+			if err := decodeArgs(args, &in); err != nil {
+				return nil, err
+			}
+			if in.ServiceID == "" {
+				return nil, fmt.Errorf("%w: service_id", errMissingArgument)
+			}
+
+			return tero.LogRules.ListForService(ctx, in.ServiceID, api.ListOptions{})
+		},
+	})
+}
+
+// objectSchema builds a JSON schema for an object with the given properties.
+func objectSchema(properties map[string]any, required ...string) map[string]any {
+	return map[string]any{
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+}
+
+// decodeArgs unmarshals tool arguments, reporting bad input as a tool error.
+func decodeArgs(args json.RawMessage, v any) error {
+	if err := json.Unmarshal(args, v); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+// parseWindow converts the optional window argument, defaulting to a week.
+func parseWindow(s string) (api.TimeWindow, error) {
+	if s == "" {
+		return api.TimeWindowWeek, nil
+	}
+	return api.ParseTimeWindow(s)
+}
@@ -1,25 +1,59 @@
 package preferences
 
 import (
+	"github.com/usetero/cli/internal/auth"
 	"github.com/usetero/cli/internal/log"
 )
 
+// datadogAPIKey is the key for the Datadog API key in both the secure store
+// and (for configs written by older versions) the plaintext store.
+const datadogAPIKey = "datadog_api_key"
+
 // Service handles user preferences business logic.
 // It defines domain concepts (email, orgID, etc.) and translates them
 // to/from generic key-value storage operations.
+// Secrets go to the secure store; everything else goes to the plaintext store.
 type Service struct {
-	store  Store
-	logger log.Logger
+	store   Store
+	secrets auth.SecureStorage
+	logger  log.Logger
 }
 
 // NewService creates a new preferences service.
-func NewService(store Store, logger log.Logger) *Service {
+func NewService(store Store, secrets auth.SecureStorage, logger log.Logger) *Service {
 	return &Service{
-		store:  store,
-		logger: logger,
+		store:   store,
+		secrets: secrets,
+		logger:  logger,
 	}
 }
 
+// MigrateSecrets moves secrets written to the plaintext store by older
+// versions into the secure store, then scrubs them from the plaintext store.
+// It is safe to call on every startup; once migrated there is nothing to do.
+func (s *Service) MigrateSecrets() error {
+	apiKey := s.store.Get(datadogAPIKey)
+	if apiKey == "" {
+		return nil
+	}
+
+	s.logger.Info("migrating datadog api key to secure storage")
+	if err := s.secrets.Set(datadogAPIKey, apiKey); err != nil {
+		// Leave the plaintext copy in place so the key isn't lost
+		s.logger.Error("failed to migrate datadog api key", "error", err)
+		return err
+	}
+
+	s.store.Delete(datadogAPIKey)
+	if err := s.store.Save(); err != nil {
+		s.logger.Error("failed to scrub datadog api key from config", "error", err)
+		return err
+	}
+
+	s.logger.Info("migrated datadog api key to secure storage")
+	return nil
+}
+
 // GetEmail returns the user's email
 func (s *Service) GetEmail() string {
 	return s.store.Get("email")
@@ -31,17 +65,27 @@ func (s *Service) SetEmail(email string) error {
 	return s.store.Save()
 }
 
-// GetDatadogAPIKey returns the Datadog API key
-func (s *Service) GetDatadogAPIKey() string {
-	return s.store.Get("datadog_api_key")
+// GetUserName returns the signed-in user's display name
+func (s *Service) GetUserName() string {
+	return s.store.Get("user_name")
 }
 
-// SetDatadogAPIKey saves the Datadog API key
-func (s *Service) SetDatadogAPIKey(key string) error {
-	s.store.Set("datadog_api_key", key)
+// SetUserName saves the signed-in user's display name
+func (s *Service) SetUserName(name string) error {
+	s.store.Set("user_name", name)
 	return s.store.Save()
 }
 
+// GetDatadogAPIKey returns the Datadog API key from secure storage
+func (s *Service) GetDatadogAPIKey() (string, error) {
+	return s.secrets.Get(datadogAPIKey)
+}
+
+// SetDatadogAPIKey saves the Datadog API key to secure storage
+func (s *Service) SetDatadogAPIKey(key string) error {
+	return s.secrets.Set(datadogAPIKey, key)
+}
+
 // GetDefaultOrgID returns the default organization ID
 func (s *Service) GetDefaultOrgID() string {
 	return s.store.Get("default_org_id")
@@ -83,8 +127,7 @@ func (s *Service) ClearEmail() error {
 
 // ClearDatadogAPIKey clears the Datadog API key (for going back in onboarding)
 func (s *Service) ClearDatadogAPIKey() error {
-	s.store.Set("datadog_api_key", "")
-	return s.store.Save()
+	return s.secrets.Delete(datadogAPIKey)
 }
 
 // ClearDefaultOrgID clears the default organization ID (for going back in onboarding)
@@ -137,3 +180,82 @@ func (s *Service) ClearServices() error {
 	s.store.SetList("services", nil)
 	return s.store.Save()
 }
+
+// OnboardingProgress is how far onboarding got. It's saved as each step
+// completes so a restart resumes at the first step that isn't done.
+type OnboardingProgress struct {
+	// Step is the last completed step, e.g. "account" (see step.Checkpoint*)
+	Step string
+
+	Role             string
+	OrgID            string
+	AccountID        string
+	DatadogSite      string
+	DatadogAccountID string
+
+	// DatadogAPIKey is the validated key while the app key is still to come.
+	// It's kept in secure storage, and cleared once the Datadog account exists.
+	DatadogAPIKey string
+}
+
+// GetOnboardingProgress returns the saved onboarding progress. A zero Step
+// means onboarding hasn't saved any.
+func (s *Service) GetOnboardingProgress() OnboardingProgress {
+	p := OnboardingProgress{
+		Step:             s.store.Get("onboarding_step"),
+		Role:             s.GetRole(),
+		OrgID:            s.GetDefaultOrgID(),
+		AccountID:        s.GetDefaultAccountID(),
+		DatadogSite:      s.store.Get("datadog_site"),
+		DatadogAccountID: s.store.Get("datadog_account_id"),
+	}
+	key, err := s.GetDatadogAPIKey()
+	if err != nil {
+		// Not fatal - onboarding resumes at the API key step instead
+		s.logger.Warn("failed to read datadog api key", "error", err)
+	}
+	p.DatadogAPIKey = key
+	return p
+}
+
+// SetOnboardingProgress saves onboarding progress. Role, organization, and
+// account are saved as the defaults. Empty fields are left as they are, so a
+// step only needs to fill in what it knows.
+func (s *Service) SetOnboardingProgress(p OnboardingProgress) error {
+	if p.DatadogAPIKey != "" {
+		if err := s.SetDatadogAPIKey(p.DatadogAPIKey); err != nil {
+			return err
+		}
+	} else if p.DatadogAccountID != "" {
+		// The Datadog account holds the key now
+		if err := s.ClearDatadogAPIKey(); err != nil {
+			s.logger.Warn("failed to clear datadog api key", "error", err)
+		}
+	}
+
+	for key, value := range map[string]string{
+		"onboarding_step":    p.Step,
+		"role":               p.Role,
+		"default_org_id":     p.OrgID,
+		"default_account_id": p.AccountID,
+		"datadog_site":       p.DatadogSite,
+		"datadog_account_id": p.DatadogAccountID,
+	} {
+		if value != "" {
+			s.store.Set(key, value)
+		}
+	}
+	return s.store.Save()
+}
+
+// ClearOnboardingProgress forgets everything onboarding saved, including the
+// default organization, account, and workspace, so it starts over.
+func (s *Service) ClearOnboardingProgress() error {
+	if err := s.ClearDatadogAPIKey(); err != nil {
+		return err
+	}
+	for _, key := range []string{"onboarding_step", "role", "default_org_id", "default_account_id", "default_workspace_id", "datadog_site", "datadog_account_id", "services"} {
+		s.store.Delete(key)
+	}
+	return s.store.Save()
+}
@@ -0,0 +1,152 @@
+package preferences
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/usetero/cli/internal/log/logtest"
+)
+
+func TestService_MigrateSecrets(t *testing.T) {
+	t.Run("moves a plaintext datadog api key into secure storage and scrubs it", func(t *testing.T) {
+		store := newMockStore(map[string]string{"datadog_api_key": "dd-secret", "email": "a@b.c"})
+		secrets := newMockSecrets()
+		svc := NewService(store, secrets, logtest.New(t))
+
+		if err := svc.MigrateSecrets(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := secrets.data["datadog_api_key"]; got != "dd-secret" {
+			t.Errorf("secure storage key = %q, want %q", got, "dd-secret")
+		}
+		if _, ok := store.data["datadog_api_key"]; ok {
+			t.Error("plaintext key still present in store")
+		}
+		if store.saves != 1 {
+			t.Errorf("store saved %d times, want 1", store.saves)
+		}
+		if got, _ := svc.GetDatadogAPIKey(); got != "dd-secret" {
+			t.Errorf("GetDatadogAPIKey() = %q, want %q", got, "dd-secret")
+		}
+	})
+
+	t.Run("keeps the plaintext key when secure storage fails", func(t *testing.T) {
+		store := newMockStore(map[string]string{"datadog_api_key": "dd-secret"})
+		secrets := newMockSecrets()
+		secrets.err = errors.New("keyring locked")
+		svc := NewService(store, secrets, logtest.New(t))
+
+		if err := svc.MigrateSecrets(); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+
+		if store.data["datadog_api_key"] != "dd-secret" {
+			t.Error("plaintext key was scrubbed before it was stored securely")
+		}
+	})
+
+	t.Run("does nothing when there is no plaintext key", func(t *testing.T) {
+		store := newMockStore(nil)
+		svc := NewService(store, newMockSecrets(), logtest.New(t))
+
+		if err := svc.MigrateSecrets(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if store.saves != 0 {
+			t.Errorf("store saved %d times, want 0", store.saves)
+		}
+	})
+}
+
+func TestService_OnboardingProgress(t *testing.T) {
+	t.Run("keeps fields a later step leaves empty", func(t *testing.T) {
+		store := newMockStore(nil)
+		secrets := newMockSecrets()
+		svc := NewService(store, secrets, logtest.New(t))
+
+		if err := svc.SetOnboardingProgress(OnboardingProgress{Step: "datadog_api_key", Role: "platform", OrgID: "org-1", AccountID: "account-1", DatadogSite: "US1", DatadogAPIKey: "dd-secret"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := svc.SetOnboardingProgress(OnboardingProgress{Step: "datadog_account", DatadogAccountID: "dd-1"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := OnboardingProgress{Step: "datadog_account", Role: "platform", OrgID: "org-1", AccountID: "account-1", DatadogSite: "US1", DatadogAccountID: "dd-1"}
+		if got := svc.GetOnboardingProgress(); got != want {
+			t.Errorf("GetOnboardingProgress() = %+v, want %+v", got, want)
+		}
+		if _, ok := secrets.data["datadog_api_key"]; ok {
+			t.Error("api key still in secure storage after the datadog account was saved")
+		}
+		if _, ok := store.data["datadog_api_key"]; ok {
+			t.Error("api key written to the plaintext store")
+		}
+	})
+
+	t.Run("clear forgets progress and defaults", func(t *testing.T) {
+		store := newMockStore(nil)
+		secrets := newMockSecrets()
+		svc := NewService(store, secrets, logtest.New(t))
+		_ = svc.SetOnboardingProgress(OnboardingProgress{Step: "datadog_api_key", Role: "engineer", OrgID: "org-1", AccountID: "account-1", DatadogSite: "EU1", DatadogAPIKey: "dd-secret"})
+		_ = svc.SetEmail("a@b.c")
+
+		if err := svc.ClearOnboardingProgress(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := svc.GetOnboardingProgress(); got != (OnboardingProgress{}) {
+			t.Errorf("GetOnboardingProgress() = %+v, want zero", got)
+		}
+		if svc.GetEmail() != "a@b.c" {
+			t.Error("clearing onboarding progress cleared the email")
+		}
+	})
+}
+
+// mockStore implements Store for testing
+type mockStore struct {
+	data  map[string]string
+	saves int
+}
+
+func newMockStore(data map[string]string) *mockStore {
+	if data == nil {
+		data = make(map[string]string)
+	}
+	return &mockStore{data: data}
+}
+
+func (m *mockStore) Get(key string) string               { return m.data[key] }
+func (m *mockStore) Set(key string, value string)        { m.data[key] = value }
+func (m *mockStore) GetBool(key string) bool             { return m.data[key] == "true" }
+func (m *mockStore) SetBool(key string, value bool)      {}
+func (m *mockStore) GetList(key string) []string         { return nil }
+func (m *mockStore) SetList(key string, values []string) {}
+func (m *mockStore) Delete(key string)                   { delete(m.data, key) }
+func (m *mockStore) Save() error                         { m.saves++; return nil }
+
+// mockSecrets implements auth.SecureStorage for testing
+type mockSecrets struct {
+	data map[string]string
+	err  error
+}
+
+func newMockSecrets() *mockSecrets {
+	return &mockSecrets{data: make(map[string]string)}
+}
+
+func (m *mockSecrets) Get(key string) (string, error) { return m.data[key], m.err }
+
+func (m *mockSecrets) Set(key string, value string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.data[key] = value
+	return nil
+}
+
+func (m *mockSecrets) Delete(key string) error {
+	delete(m.data, key)
+	return m.err
+}
@@ -2,7 +2,7 @@ package preferences
 
 // Store defines a generic key-value store with persistence.
 // This allows services to define domain concepts while keeping the storage implementation generic.
-// Concrete implementations: *config.Config (YAML file storage)
+// Concrete implementations: *config.Profile (a profile in the YAML config file)
 type Store interface {
 	// Get retrieves a string value by key
 	Get(key string) string
@@ -22,6 +22,9 @@ type Store interface {
 	// SetList stores a list of strings by key
 	SetList(key string, values []string)
 
+	// Delete removes a key entirely
+	Delete(key string)
+
 	// Save persists all changes to storage
 	Save() error
 }
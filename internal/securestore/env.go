@@ -0,0 +1,59 @@
+package securestore
+
+import (
+	"os"
+	"strings"
+	"sync"
+)
+
+// Env reads secrets from environment variables named TERO_<KEY>, e.g.
+// TERO_ACCESS_TOKEN or TERO_DATADOG_API_KEY. It implements auth.SecureStorage
+// for ephemeral environments where nothing should touch disk. Writes only
+// last for the current process.
+type Env struct {
+	mu        sync.Mutex
+	overrides map[string]*string // Set and Delete, shadowing the environment
+}
+
+// NewEnv creates an environment-backed store.
+func NewEnv() *Env {
+	return &Env{overrides: make(map[string]*string)}
+}
+
+// EnvVar returns the environment variable a key is read from
+func EnvVar(key string) string {
+	return "TERO_" + strings.ToUpper(key)
+}
+
+// Get retrieves a value by key.
+// Returns empty string if key doesn't exist.
+func (e *Env) Get(key string) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if v, ok := e.overrides[key]; ok {
+		if v == nil {
+			return "", nil
+		}
+		return *v, nil
+	}
+	return os.Getenv(EnvVar(key)), nil
+}
+
+// Set stores a value by key for the rest of the process.
+func (e *Env) Set(key string, value string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.overrides[key] = &value
+	return nil
+}
+
+// Delete hides a value by key for the rest of the process.
+func (e *Env) Delete(key string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.overrides[key] = nil
+	return nil
+}
@@ -0,0 +1,214 @@
+package securestore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// fileVersion is bumped if the file layout or key derivation changes
+	fileVersion = 1
+
+	// defaultIterations follows OWASP's PBKDF2-HMAC-SHA256 recommendation
+	defaultIterations = 600_000
+
+	keyLength  = 32 // AES-256
+	saltLength = 16
+)
+
+// ErrDecrypt is returned when the credentials file can't be decrypted,
+// usually because the passphrase changed or the file came from another machine.
+var ErrDecrypt = errors.New("can't decrypt stored credentials: the passphrase is wrong or the file was created on another machine")
+
+// File stores secrets in a file encrypted with AES-256-GCM, keyed by PBKDF2
+// from a passphrase. It implements auth.SecureStorage for hosts without an OS
+// keyring, such as SSH jump hosts and dev containers.
+type File struct {
+	path       string
+	passphrase string
+	iterations int
+
+	mu sync.Mutex
+	// Derived key for salt and keyIterations, cached because derivation is deliberately slow
+	key           []byte
+	salt          []byte
+	keyIterations int
+}
+
+// encryptedFile is the on-disk layout
+type encryptedFile struct {
+	Version    int    `json:"version"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// NewFile creates an encrypted file store at path, keyed by passphrase.
+// The file is created on the first Set.
+func NewFile(path, passphrase string) *File {
+	return &File{
+		path:       path,
+		passphrase: passphrase,
+		iterations: defaultIterations,
+	}
+}
+
+// Get retrieves a value by key.
+// Returns empty string if key doesn't exist.
+func (f *File) Get(key string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return "", err
+	}
+	return secrets[key], nil
+}
+
+// Set stores a value by key.
+func (f *File) Set(key string, value string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return err
+	}
+	secrets[key] = value
+	return f.write(secrets)
+}
+
+// Delete removes a value by key.
+func (f *File) Delete(key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[key]; !ok {
+		return nil // Not an error if it doesn't exist
+	}
+	delete(secrets, key)
+	return f.write(secrets)
+}
+
+// read decrypts the file. A missing file holds no secrets.
+func (f *File) read() (map[string]string, error) {
+	data, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return make(map[string]string), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var ef encryptedFile
+	if err := json.Unmarshal(data, &ef); err != nil {
+		return nil, fmt.Errorf("credentials file %s is corrupt: %w", f.path, err)
+	}
+	if ef.Version != fileVersion {
+		return nil, fmt.Errorf("credentials file %s has unsupported version %d", f.path, ef.Version)
+	}
+
+	gcm, err := f.cipher(ef.Salt, ef.Iterations)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, ef.Nonce, ef.Ciphertext, nil)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	secrets := make(map[string]string)
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, fmt.Errorf("credentials file %s is corrupt: %w", f.path, err)
+	}
+	return secrets, nil
+}
+
+// write encrypts secrets to the file, replacing it atomically so a crash
+// can't leave it half-written
+func (f *File) write(secrets map[string]string) error {
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	// Keep the salt once chosen so the cached key stays valid
+	salt := f.salt
+	if salt == nil {
+		salt = make([]byte, saltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+	}
+	gcm, err := f.cipher(salt, f.iterations)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(encryptedFile{
+		Version:    fileVersion,
+		Iterations: f.iterations,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name()) // No-op once renamed
+	}()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	// CreateTemp already uses 0600
+	return os.Rename(tmp.Name(), f.path)
+}
+
+// cipher returns AES-GCM keyed from the passphrase and salt
+func (f *File) cipher(salt []byte, iterations int) (cipher.AEAD, error) {
+	if f.key == nil || string(f.salt) != string(salt) || f.keyIterations != iterations {
+		key, err := pbkdf2.Key(sha256.New, f.passphrase, salt, iterations, keyLength)
+		if err != nil {
+			return nil, err
+		}
+		f.key, f.salt, f.keyIterations = key, salt, iterations
+	}
+
+	block, err := aes.NewCipher(f.key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
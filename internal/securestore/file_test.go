@@ -0,0 +1,88 @@
+package securestore
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newTestFile uses few iterations so tests don't spend seconds deriving keys
+func newTestFile(path, passphrase string) *File {
+	f := NewFile(path, passphrase)
+	f.iterations = 1000
+	return f
+}
+
+func TestFileRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials", "default")
+	store := newTestFile(path, "correct horse")
+
+	if got, err := store.Get("access_token"); err != nil || got != "" {
+		t.Fatalf("Get() before Set = %q, %v; want empty", got, err)
+	}
+	if err := store.Set("access_token", "at-123"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if err := store.Set("refresh_token", "rt-456"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("file mode = %o, want 600", perm)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if bytes.Contains(data, []byte("at-123")) {
+		t.Errorf("file holds the token in plaintext: %s", data)
+	}
+
+	// A fresh store with the same passphrase reads what was written
+	reopened := newTestFile(path, "correct horse")
+	if got, err := reopened.Get("access_token"); err != nil || got != "at-123" {
+		t.Errorf("Get(access_token) = %q, %v; want at-123", got, err)
+	}
+	if err := reopened.Delete("access_token"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if got, _ := reopened.Get("access_token"); got != "" {
+		t.Errorf("Get(access_token) after Delete = %q", got)
+	}
+	if got, _ := reopened.Get("refresh_token"); got != "rt-456" {
+		t.Errorf("Get(refresh_token) = %q, want rt-456", got)
+	}
+}
+
+func TestFileWrongPassphrase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "default")
+	if err := newTestFile(path, "right").Set("access_token", "at-123"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	_, err := newTestFile(path, "wrong").Get("access_token")
+	if !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Get() error = %v, want ErrDecrypt", err)
+	}
+}
+
+func TestEnvReadsPrefixedVariables(t *testing.T) {
+	t.Setenv("TERO_ACCESS_TOKEN", "from-env")
+	store := NewEnv()
+
+	if got, _ := store.Get("access_token"); got != "from-env" {
+		t.Errorf("Get(access_token) = %q, want from-env", got)
+	}
+	if err := store.Delete("access_token"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if got, _ := store.Get("access_token"); got != "" {
+		t.Errorf("Get(access_token) after Delete = %q, want empty", got)
+	}
+}
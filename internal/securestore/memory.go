@@ -0,0 +1,42 @@
+package securestore
+
+import "sync"
+
+// Memory keeps secrets in memory for the life of the process. It implements
+// auth.SecureStorage for demo mode, where nothing real should be read or saved.
+type Memory struct {
+	mu      sync.Mutex
+	secrets map[string]string
+}
+
+// NewMemory creates an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{secrets: make(map[string]string)}
+}
+
+// Get retrieves a value by key.
+// Returns empty string if key doesn't exist.
+func (m *Memory) Get(key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.secrets[key], nil
+}
+
+// Set stores a value by key.
+func (m *Memory) Set(key string, value string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.secrets[key] = value
+	return nil
+}
+
+// Delete removes a value by key.
+func (m *Memory) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.secrets, key)
+	return nil
+}
@@ -0,0 +1,117 @@
+// Package securestore chooses where the CLI keeps tokens and secrets: the OS
+// keyring, an encrypted file, or environment variables.
+package securestore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+
+	"github.com/usetero/cli/internal/auth"
+	"github.com/usetero/cli/internal/keyring"
+	"github.com/usetero/cli/internal/log"
+)
+
+// Store modes, selected with TERO_CREDENTIAL_STORE. ModeAuto uses the keyring
+// when it works and the encrypted file otherwise.
+const (
+	ModeAuto    = ""
+	ModeKeyring = "keyring"
+	ModeFile    = "file"
+	ModeEnv     = "env"
+)
+
+// machineIDPaths hold a stable per-host identifier on systemd and dbus systems
+var machineIDPaths = []string{"/etc/machine-id", "/var/lib/dbus/machine-id"}
+
+// errNoMachineID is returned by machineSecret on hosts without a machine ID,
+// such as minimal containers
+var errNoMachineID = errors.New("no machine ID found to encrypt credentials: set TERO_CREDENTIAL_PASSPHRASE")
+
+// Open returns the secure storage for mode, isolated to a namespace (a profile name).
+func Open(mode, namespace string, logger log.Logger) (auth.SecureStorage, error) {
+	switch mode {
+	case ModeKeyring:
+		return keyring.New(namespace), nil
+	case ModeFile:
+		return openFile(namespace)
+	case ModeEnv:
+		return NewEnv(), nil
+	case ModeAuto:
+		kr := keyring.New(namespace)
+		err := kr.Available()
+		if err == nil {
+			return kr, nil
+		}
+		logger.Warn("OS keyring unavailable, using encrypted file", "error", err)
+		f, err := openFile(namespace)
+		if errors.Is(err, errNoMachineID) {
+			// Nothing to key the file with. Rather than fail every command,
+			// read secrets from the environment; sign-ins last one process.
+			logger.Warn("no machine ID found, sign-ins won't be saved; set TERO_CREDENTIAL_PASSPHRASE to use the encrypted file")
+			return NewEnv(), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	default:
+		return nil, fmt.Errorf("unknown credential store %q: use keyring, file, or env", mode)
+	}
+}
+
+// FilePath returns where the encrypted file store for a namespace lives
+func FilePath(namespace string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	return filepath.Join(home, ".tero", "credentials", namespace), nil
+}
+
+// openFile opens the encrypted file store, keyed by TERO_CREDENTIAL_PASSPHRASE
+// if set, otherwise by a secret bound to this machine and user
+func openFile(namespace string) (*File, error) {
+	path, err := FilePath(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	passphrase := os.Getenv("TERO_CREDENTIAL_PASSPHRASE")
+	if passphrase == "" {
+		passphrase, err = machineSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewFile(path, passphrase), nil
+}
+
+// machineSecret derives a passphrase from the machine ID and user, so the file
+// is useless if copied to another host or account. It isn't a substitute for
+// a real passphrase against someone who can already read the machine ID.
+func machineSecret() (string, error) {
+	for _, path := range machineIDPaths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		id := strings.TrimSpace(string(data))
+		if id == "" {
+			continue
+		}
+
+		username := ""
+		if u, err := user.Current(); err == nil {
+			username = u.Username
+		}
+		return "tero-cli:" + id + ":" + username, nil
+	}
+	return "", errNoMachineID
+}
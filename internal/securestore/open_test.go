@@ -0,0 +1,58 @@
+package securestore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gokeyring "github.com/zalando/go-keyring"
+
+	"github.com/usetero/cli/internal/log/logtest"
+)
+
+func TestOpenAutoWithoutMachineID(t *testing.T) {
+	gokeyring.MockInitWithError(errors.New("no secret service"))
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("TERO_CREDENTIAL_PASSPHRASE", "")
+	t.Setenv("TERO_ACCESS_TOKEN", "at-env")
+
+	saved := machineIDPaths
+	machineIDPaths = []string{filepath.Join(home, "machine-id")}
+	t.Cleanup(func() { machineIDPaths = saved })
+
+	store, err := Open(ModeAuto, "default", logtest.New(t))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if _, ok := store.(*Env); !ok {
+		t.Fatalf("Open() = %T, want *Env", store)
+	}
+	if got, err := store.Get("access_token"); err != nil || got != "at-env" {
+		t.Errorf("Get(access_token) = %q, %v; want at-env", got, err)
+	}
+	if err := store.Set("refresh_token", "rt-123"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	// Nothing is written to disk, key or credentials
+	if _, err := os.Stat(filepath.Join(home, ".tero")); !os.IsNotExist(err) {
+		t.Errorf("Stat(~/.tero) error = %v, want not exist", err)
+	}
+
+	// Choosing the file store explicitly still asks for a passphrase
+	if _, err := Open(ModeFile, "default", logtest.New(t)); !errors.Is(err, errNoMachineID) {
+		t.Errorf("Open(ModeFile) error = %v, want %v", err, errNoMachineID)
+	}
+
+	// With a passphrase, auto mode uses the encrypted file
+	t.Setenv("TERO_CREDENTIAL_PASSPHRASE", "correct horse")
+	store, err = Open(ModeAuto, "default", logtest.New(t))
+	if err != nil {
+		t.Fatalf("Open() with passphrase error = %v", err)
+	}
+	if _, ok := store.(*File); !ok {
+		t.Errorf("Open() with passphrase = %T, want *File", store)
+	}
+}
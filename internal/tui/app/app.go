@@ -1,11 +1,25 @@
 package app
 
 import (
+	"fmt"
+
 	"github.com/charmbracelet/bubbles/v2/key"
 	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/usetero/cli/internal/api"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/app/chat"
+	"github.com/usetero/cli/internal/tui/app/logevents"
 	"github.com/usetero/cli/internal/tui/app/page"
+	"github.com/usetero/cli/internal/tui/app/services"
+	"github.com/usetero/cli/internal/tui/components/sidebar"
+	"github.com/usetero/cli/internal/tui/layouts"
+)
+
+// Sidebar navigation bindings (shown in the sidebar, not the footer)
+var (
+	chatPageBinding     = key.NewBinding(key.WithKeys("alt+1"))
+	servicesPageBinding = key.NewBinding(key.WithKeys("alt+2"))
 )
 
 // App represents the app mode - the main application with sidebar navigation.
@@ -16,32 +30,155 @@ type App struct {
 	orgID          string
 	accountID      string
 	globalBindings []key.Binding
+
+	// Pages are created on first visit and kept so their state survives navigation
+	pages       map[sidebar.Page]page.Page
+	current     sidebar.Page
+	drilldown   page.Page // Shown over the current page (e.g. a service's log events), or nil
+	tero        *api.API
+	preferences *preferences.Service
+
+	// Sidebar layout shared by every page, with the account data it shows
+	layout    *layouts.Sidebar
+	summaries SummaryFetcher
+
+	// Dimensions, for sizing pages created after the first resize
+	width  int
+	height int
 }
 
 // New creates a new app mode starting with the chat page
-func New(orgID string, accountID string, logger log.Logger, globalBindings []key.Binding) *App {
-	// Create chat page as the initial page
-	chatPage := chat.New(orgID, accountID, logger, globalBindings)
+func New(orgID string, accountID string, tero *api.API, preferencesService *preferences.Service, logger log.Logger, globalBindings []key.Binding) *App {
+	layout := layouts.NewSidebar(logger)
+	layout.SetUser(preferencesService.GetUserName(), preferencesService.GetEmail())
 
-	return &App{
-		currentPage:    chatPage,
+	m := &App{
 		logger:         logger,
 		orgID:          orgID,
 		accountID:      accountID,
 		globalBindings: globalBindings,
+		pages:          make(map[sidebar.Page]page.Page),
+		tero:           tero,
+		preferences:    preferencesService,
+		layout:         layout,
+		summaries:      tero.Accounts,
+	}
+
+	// Create chat page as the initial page
+	m.current = sidebar.PageChat
+	m.currentPage = m.newPage(sidebar.PageChat)
+	m.pages[sidebar.PageChat] = m.currentPage
+
+	return m
+}
+
+// UseAPIToken labels the session as an API token in the sidebar. The saved
+// user is whoever last signed in, who may not own the token.
+func (m *App) UseAPIToken() {
+	m.layout.SetUser("API token", "")
+}
+
+// newPage creates the page for a navigation item
+func (m *App) newPage(p sidebar.Page) page.Page {
+	switch p {
+	case sidebar.PageServices:
+		return services.New(m.accountID, m.tero.Services, m.layout, m.logger, m.globalBindings)
+	default:
+		return chat.New(m.orgID, m.accountID, m.tero.Chats, m.tero.Workspaces, m.preferences, m.layout, m.logger, m.globalBindings)
 	}
 }
 
 // Init initializes the app mode
 func (m *App) Init() tea.Cmd {
-	return m.currentPage.Init()
+	return tea.Batch(m.currentPage.Init(), m.loadSummary())
 }
 
 // Update handles messages and delegates to the current page
 func (m *App) Update(msg tea.Msg) tea.Cmd {
-	// Future: Handle sidebar navigation here
-	// For now, just delegate to current page
-	return m.currentPage.Update(msg)
+	switch msg := msg.(type) {
+	case summaryMsg:
+		m.handleSummary(msg)
+		return scheduleSummaryRefresh()
+	case refreshSummaryMsg:
+		return m.loadSummary()
+	case page.OpenLogEventsMsg:
+		return m.openDrilldown(logevents.New(msg.ServiceID, msg.ServiceName, m.tero.LogEvents, m.layout, m.logger, m.globalBindings))
+	case page.BackMsg:
+		return m.closeDrilldown()
+	case tea.KeyPressMsg:
+		switch {
+		case key.Matches(msg, chatPageBinding):
+			return m.navigate(sidebar.PageChat)
+		case key.Matches(msg, servicesPageBinding):
+			return m.navigate(sidebar.PageServices)
+		}
+		return m.currentPage.Update(msg)
+	case tea.MouseMsg, tea.PasteMsg:
+		// User input only goes to the page on screen
+		return m.currentPage.Update(msg)
+	}
+
+	// Background results (loads, ticks) go to every page so work started on a
+	// page finishes even after navigating away. The current page goes last so
+	// its key bindings and error win the shared layout's footer.
+	var cmds []tea.Cmd
+	for _, pg := range m.pages {
+		if pg != m.currentPage {
+			cmds = append(cmds, pg.Update(msg))
+		}
+	}
+	if m.drilldown != nil && m.drilldown != m.currentPage {
+		cmds = append(cmds, m.drilldown.Update(msg))
+	}
+	cmds = append(cmds, m.currentPage.Update(msg))
+	return tea.Batch(cmds...)
+}
+
+// navigate switches to a page, creating it on first visit
+func (m *App) navigate(p sidebar.Page) tea.Cmd {
+	if p == m.current && m.drilldown == nil {
+		return nil
+	}
+	m.logger.Info("navigating", "page", p)
+	m.drilldown = nil
+
+	var cmds []tea.Cmd
+	pg, ok := m.pages[p]
+	if !ok {
+		pg = m.newPage(p)
+		m.pages[p] = pg
+		if m.width > 0 && m.height > 0 {
+			pg.SetSize(m.width, m.height)
+		}
+		cmds = append(cmds, pg.Init())
+	}
+
+	m.current = p
+	m.currentPage = pg
+	m.layout.SetActive(p)
+	cmds = append(cmds, pg.Update(page.ShownMsg{}))
+	return tea.Batch(cmds...)
+}
+
+// openDrilldown shows a page over the current one until it sends page.BackMsg
+func (m *App) openDrilldown(pg page.Page) tea.Cmd {
+	m.logger.Info("opening drill-down", "page", fmt.Sprintf("%T", pg))
+	if m.width > 0 && m.height > 0 {
+		pg.SetSize(m.width, m.height)
+	}
+	m.drilldown = pg
+	m.currentPage = pg
+	return tea.Batch(pg.Init(), pg.Update(page.ShownMsg{}))
+}
+
+// closeDrilldown returns to the page the drill-down was opened from
+func (m *App) closeDrilldown() tea.Cmd {
+	if m.drilldown == nil {
+		return nil
+	}
+	m.drilldown = nil
+	m.currentPage = m.pages[m.current]
+	return m.currentPage.Update(page.ShownMsg{})
 }
 
 // View renders the current page
@@ -49,9 +186,26 @@ func (m *App) View() string {
 	return m.currentPage.View()
 }
 
-// SetSize sets dimensions and propagates to current page
+// SetSize sets dimensions and propagates to every page
 func (m *App) SetSize(width, height int) {
-	m.currentPage.SetSize(width, height)
+	m.width = width
+	m.height = height
+	for _, pg := range m.pages {
+		pg.SetSize(width, height)
+	}
+	if m.drilldown != nil {
+		m.drilldown.SetSize(width, height)
+	}
+}
+
+// CanGoBack returns true while the current page uses esc, so the TUI doesn't quit on it.
+// A drill-down always does, to go back to the page it was opened from.
+func (m *App) CanGoBack() bool {
+	if m.drilldown != nil {
+		return true
+	}
+	pg, ok := m.currentPage.(page.Backer)
+	return ok && pg.CanGoBack()
 }
 
 // IsComplete returns false - app mode never completes
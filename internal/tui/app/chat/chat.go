@@ -1,10 +1,15 @@
 package chat
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	"github.com/charmbracelet/bubbles/v2/help"
 	"github.com/charmbracelet/bubbles/v2/key"
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/usetero/cli/internal/api"
 	"github.com/usetero/cli/internal/log"
 	"github.com/usetero/cli/internal/tui/app/page"
 	"github.com/usetero/cli/internal/tui/keymap"
@@ -12,13 +17,43 @@ import (
 	"github.com/usetero/cli/internal/tui/styles"
 )
 
-// model represents the chat page state
+// ChatReader resumes the latest chat in a workspace
+type ChatReader interface {
+	Latest(ctx context.Context, workspaceID string) (*api.Chat, error)
+}
+
+// WorkspaceLister lists workspaces for an account
+type WorkspaceLister interface {
+	List(ctx context.Context, accountID string, opts api.ListOptions) ([]api.Workspace, error)
+}
+
+// WorkspacePreferences reads and saves the default workspace
+type WorkspacePreferences interface {
+	GetDefaultWorkspaceID() string
+	SetDefaultWorkspaceID(workspaceID string) error
+}
+
+// Key bindings for the chat page
+var (
+	retryBinding = key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("ctrl+r", "retry"),
+	)
+)
+
+// model represents the chat page state.
+// The control plane can't create chats or messages yet, and doesn't return
+// message content, so the page is read-only: it says chat isn't available
+// and mentions the last conversation, if there is one.
 type model struct {
 	// Identity - which org/account this chat session belongs to
 	orgID     string
 	accountID string
 
-	// TODO: Add services when we know what chat needs
+	// Services (defined by consumer interfaces)
+	chats       ChatReader
+	workspaces  WorkspaceLister
+	preferences WorkspacePreferences
 
 	// Logger
 	logger log.Logger
@@ -27,26 +62,87 @@ type model struct {
 	layout layouts.Layout
 	ready  bool
 
+	// Chat state
+	workspaceID string
+	chat        *api.Chat
+
+	// Status
+	loading bool // Resolving workspace and loading the latest chat
+	err     error
+
 	// Global key bindings (passed from TUI)
 	globalBindings []key.Binding
 }
 
+// chatReadyMsg is sent when the workspace is resolved and the latest chat (if any) is loaded
+type chatReadyMsg struct {
+	workspaceID string
+	chat        *api.Chat
+	err         error
+}
+
 // New creates a new chat page model.
 // Takes the accumulated onboarding data (orgID, accountID) plus logger and services.
-func New(orgID string, accountID string, logger log.Logger, globalBindings []key.Binding) page.Page {
+func New(orgID string, accountID string, chats ChatReader, workspaces WorkspaceLister, preferences WorkspacePreferences, layout layouts.Layout, logger log.Logger, globalBindings []key.Binding) page.Page {
+	if chats == nil {
+		panic("chats cannot be nil")
+	}
+	if workspaces == nil {
+		panic("workspaces cannot be nil")
+	}
+	if preferences == nil {
+		panic("preferences cannot be nil")
+	}
+	if layout == nil {
+		panic("layout cannot be nil")
+	}
+	if logger == nil {
+		panic("logger cannot be nil")
+	}
+
 	return &model{
 		orgID:          orgID,
 		accountID:      accountID,
+		chats:          chats,
+		workspaces:     workspaces,
+		preferences:    preferences,
 		logger:         logger,
 		ready:          false,
-		layout:         layouts.NewSidebar(logger),
+		layout:         layout,
 		globalBindings: globalBindings,
 	}
 }
 
 // Init is called when the program starts
 func (m *model) Init() tea.Cmd {
-	return nil
+	m.loading = true
+	return m.loadChat()
+}
+
+// loadChat resolves the default workspace and loads its most recent chat
+func (m *model) loadChat() tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+
+		workspaceID := m.preferences.GetDefaultWorkspaceID()
+		if workspaceID == "" {
+			workspaces, err := m.workspaces.List(ctx, m.accountID, api.ListOptions{Limit: 1})
+			if err != nil {
+				return chatReadyMsg{err: err}
+			}
+			if len(workspaces) == 0 {
+				return chatReadyMsg{err: errors.New("no workspace found for this account")}
+			}
+			workspaceID = workspaces[0].ID
+			if err := m.preferences.SetDefaultWorkspaceID(workspaceID); err != nil {
+				// Not fatal - we'll look it up again next time
+				m.logger.Warn("failed to save default workspace", "error", err)
+			}
+		}
+
+		chat, err := m.chats.Latest(ctx, workspaceID)
+		return chatReadyMsg{workspaceID: workspaceID, chat: chat, err: err}
+	}
 }
 
 // SetSize sets the width and height available for rendering
@@ -59,6 +155,31 @@ func (m *model) SetSize(width, height int) {
 func (m *model) Update(msg tea.Msg) tea.Cmd {
 	// Note: WindowSizeMsg is handled by parent (tui.go), not here
 	// Pages only handle their own specific messages
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case chatReadyMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.logger.Error("failed to load chat", "error", msg.err)
+			m.err = msg.err
+			break
+		}
+		m.err = nil
+		m.workspaceID = msg.workspaceID
+		m.chat = msg.chat
+		if m.chat != nil {
+			m.logger.Info("loaded latest chat", "chatID", m.chat.ID, "messages", len(m.chat.Messages))
+		}
+
+	case tea.KeyPressMsg:
+		if key.Matches(msg, retryBinding) && m.err != nil && !m.loading {
+			m.logger.Info("retrying chat load")
+			m.err = nil
+			m.loading = true
+			cmds = append(cmds, m.loadChat())
+		}
+	}
 
 	// Combine page bindings + global bindings
 	var bindings []key.Binding
@@ -70,14 +191,9 @@ func (m *model) Update(msg tea.Msg) tea.Cmd {
 	m.layout.SetError(m.Error())
 
 	// Cascade to layout
-	cmd := m.layout.Update(msg)
-
-	switch msg.(type) {
-	case tea.KeyMsg:
-		// Handle key messages when needed
-	}
+	cmds = append(cmds, m.layout.Update(msg))
 
-	return cmd
+	return tea.Batch(cmds...)
 }
 
 // View renders the page content as a string (implements pages.Page interface)
@@ -86,46 +202,66 @@ func (m *model) View() string {
 		return ""
 	}
 
-	// Ask layout for available content dimensions
-	contentWidth, contentHeight := m.layout.ContentSize()
+	common := styles.Common()
+	contentWidth, _ := m.layout.ContentSize()
+	body := lipgloss.NewStyle().Width(max(contentWidth, 1))
 
-	theme := styles.CurrentTheme()
-
-	// Render chat content inline
-	chatContent := lipgloss.NewStyle().
-		Foreground(theme.Text).
-		Render(
-			lipgloss.Place(
-				contentWidth,
-				contentHeight,
-				lipgloss.Center,
-				lipgloss.Center,
-				"Chat interface coming soon...",
-			),
-		)
+	var content string
+	switch {
+	case m.loading:
+		content = common.Help.Render("Loading your conversation...")
+	case m.err != nil:
+		content = "" // The layout shows the error, with retry in the footer
+	default:
+		lines := []string{
+			common.Title.Render("Chat isn't available yet"),
+			"",
+			body.Render(common.Body.Render("Asking Tero questions from the CLI needs control plane support that hasn't shipped yet. Press ⌥2 to browse your services in the meantime.")),
+		}
+		if notice := lastChatNotice(m.chat); notice != "" {
+			lines = append(lines, "", body.Render(common.Help.Render(notice)))
+		}
+		content = lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
 
 	// Layout handles sidebar + content + footer composition
-	return m.layout.Render(chatContent)
+	return m.layout.Render(content)
 }
 
-// IsBusy returns true if the chat is performing a background operation.
-// Currently never busy - will be true when streaming messages in the future.
+// IsBusy returns true while loading the chat
 func (m *model) IsBusy() bool {
-	return false
+	return m.loading
 }
 
-// HasError returns false - chat page has no error state currently
+// HasError returns true if loading the chat failed
 func (m *model) HasError() bool {
-	return false
+	return m.err != nil
 }
 
-// Error returns nil - chat page has no error state currently
+// Error returns the current error, or nil if no error
 func (m *model) Error() error {
-	return nil
+	return m.err
 }
 
 // Help returns key bindings for the chat page
 func (m *model) Help() help.KeyMap {
-	// Chat page has no custom bindings yet - will add when we implement message sending
+	if m.err != nil {
+		return keymap.Simple{Keys: []key.Binding{retryBinding}}
+	}
 	return keymap.Simple{Keys: []key.Binding{}}
 }
+
+// lastChatNotice mentions the workspace's last conversation, or returns "" if
+// there isn't one. The control plane doesn't return message content, so it
+// can't be shown.
+func lastChatNotice(chat *api.Chat) string {
+	if chat == nil || len(chat.Messages) == 0 {
+		return ""
+	}
+
+	title := chat.Title
+	if title == "" {
+		title = "Your last conversation"
+	}
+	return title + " was last updated " + chat.UpdatedAt.Local().Format(time.DateTime) + "."
+}
@@ -0,0 +1,164 @@
+package logevents
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/format"
+	"github.com/usetero/cli/internal/tui/styles"
+)
+
+// renderDetail renders a log event's description, volume, and rules.
+// Rules explain why the event is kept or dropped, and deployments show
+// whether a drop rule is actually live in each Datadog index.
+func renderDetail(detail *api.LogEventDetail, wow *api.WeekOverWeek, wowErr error, loading bool, width int) string {
+	theme := styles.CurrentTheme()
+	common := styles.Common()
+
+	wrap := lipgloss.NewStyle().Width(max(width, 20))
+	muted := lipgloss.NewStyle().Foreground(theme.TextMuted)
+	label := lipgloss.NewStyle().Foreground(theme.TextMuted).Width(12)
+
+	var b strings.Builder
+	section := func(title string) {
+		b.WriteString("\n")
+		b.WriteString(common.Title.Render(title))
+		b.WriteString("\n")
+	}
+
+	if detail.Description != "" {
+		b.WriteString(wrap.Render(common.Body.Render(detail.Description)))
+		b.WriteString("\n")
+	}
+
+	// Volume - the week's breakdown, and how it compares to the week before
+	section("Volume")
+	b.WriteString(label.Render("Last 7 days") + format.Count(detail.Stats.TotalVolume) + " " + renderChange(wow, wowErr))
+	b.WriteString("\n")
+	b.WriteString(label.Render("Breakdown") + strings.Join([]string{
+		lipgloss.NewStyle().Foreground(theme.Error).Render("waste " + format.Percent(detail.Stats.WastePercent, 1)),
+		lipgloss.NewStyle().Foreground(theme.Success).Render("saved " + format.Percent(detail.Stats.SavedPercent, 1)),
+		"valuable " + format.Percent(detail.Stats.ValuablePercent, 1),
+		muted.Render("unknown " + format.Percent(detail.Stats.UnknownPercent, 1)),
+	}, "  "))
+	b.WriteString("\n")
+
+	// Rules - one per workspace
+	section("Rules")
+	switch {
+	case loading:
+		b.WriteString(muted.Render("Loading rules..."))
+		b.WriteString("\n")
+	case len(detail.Rules) == 0:
+		b.WriteString(muted.Render("No workspace has a rule for this event yet."))
+		b.WriteString("\n")
+	}
+	for _, rule := range detail.Rules {
+		b.WriteString("\n")
+		b.WriteString(renderRule(rule, width, theme))
+	}
+
+	return b.String()
+}
+
+// renderChange renders the week-over-week change, e.g. "↑12% vs previous week"
+func renderChange(wow *api.WeekOverWeek, err error) string {
+	theme := styles.CurrentTheme()
+	muted := lipgloss.NewStyle().Foreground(theme.TextMuted)
+
+	switch {
+	case err != nil:
+		return muted.Render("(week-over-week unavailable)")
+	case wow == nil:
+		return muted.Render("(comparing to previous week...)")
+	}
+
+	change, ok := wow.ChangePercent()
+	if !ok {
+		return muted.Render("(no volume the previous week)")
+	}
+
+	// More volume is worse for a log event, so growth is red
+	arrow, color := "→", theme.TextMuted
+	switch {
+	case change >= 0.5:
+		arrow, color = "↑", theme.Error
+	case change <= -0.5:
+		arrow, color = "↓", theme.Success
+		change = -change
+	}
+	return lipgloss.NewStyle().Foreground(color).Render(arrow+strconv.FormatFloat(change, 'f', 0, 64)+"%") +
+		muted.Render(" vs previous week")
+}
+
+// renderRule renders a workspace's rule with its rationale, author, VRL, and deployments
+func renderRule(rule api.LogRule, width int, theme *styles.Theme) string {
+	muted := lipgloss.NewStyle().Foreground(theme.TextMuted)
+	indent := lipgloss.NewStyle().PaddingLeft(2).Width(max(width, 20))
+
+	workspace := rule.WorkspaceName
+	if workspace == "" {
+		workspace = rule.WorkspaceID
+	}
+
+	retentionColor := theme.Success
+	if rule.Retention == api.LogRuleRetentionDrop {
+		retentionColor = theme.Error
+	}
+	heading := lipgloss.NewStyle().Bold(true).Foreground(theme.Text).Render(workspace) + "  " +
+		lipgloss.NewStyle().Bold(true).Foreground(retentionColor).Render(strings.ToUpper(string(rule.Retention))) +
+		muted.Render(" · "+strings.ReplaceAll(string(rule.Confidence), "_", " ")+" confidence")
+	if !rule.IsActive() {
+		heading += lipgloss.NewStyle().Foreground(theme.Warning).Render("  ignored " + rule.IgnoredAt.Local().Format(time.DateOnly))
+	}
+
+	lines := []string{heading}
+	if rule.Rationale != "" {
+		lines = append(lines, indent.Render(rule.Rationale))
+	}
+	lines = append(lines, indent.Render(muted.Render("Created by "+rule.CreatedByType+" "+rule.CreatedByID+" on "+rule.CreatedAt.Local().Format(time.DateOnly))))
+
+	if rule.VRLScript != "" {
+		script := lipgloss.NewStyle().
+			Foreground(theme.Info).
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderLeft(true).
+			BorderForeground(theme.Border).
+			PaddingLeft(1).
+			MarginLeft(2).
+			Render(strings.TrimRight(rule.VRLScript, "\n"))
+		lines = append(lines, indent.Render(muted.Render("VRL")), script)
+	}
+
+	lines = append(lines, indent.Render(muted.Render("Deployments")))
+	if len(rule.Deployments) == 0 {
+		lines = append(lines, indent.Render(muted.Render("  Not deployed to any index")))
+	}
+	for _, d := range rule.Deployments {
+		lines = append(lines, indent.Render("  "+renderDeployment(d, theme)))
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// renderDeployment renders one index deployment with its status
+func renderDeployment(d api.LogRuleDeployment, theme *styles.Theme) string {
+	muted := lipgloss.NewStyle().Foreground(theme.TextMuted)
+
+	index := d.IndexName
+	if index == "" {
+		index = "(no index)"
+	}
+
+	switch {
+	case d.LastError != "":
+		return lipgloss.NewStyle().Foreground(theme.Error).Render("✗ "+index) + muted.Render("  "+d.LastError)
+	case d.ExternalID == "":
+		return lipgloss.NewStyle().Foreground(theme.Warning).Render("! "+index) + muted.Render("  not live (drifted or pending)")
+	default:
+		return lipgloss.NewStyle().Foreground(theme.Success).Render("✓ "+index) + muted.Render("  filter "+d.ExternalID)
+	}
+}
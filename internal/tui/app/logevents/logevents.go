@@ -0,0 +1,381 @@
+package logevents
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/bubbles/v2/help"
+	"github.com/charmbracelet/bubbles/v2/key"
+	"github.com/charmbracelet/bubbles/v2/viewport"
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/format"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/tui/app/page"
+	"github.com/usetero/cli/internal/tui/components/table"
+	"github.com/usetero/cli/internal/tui/keymap"
+	"github.com/usetero/cli/internal/tui/layouts"
+	"github.com/usetero/cli/internal/tui/styles"
+)
+
+// LogEventReader lists a service's log events and loads their details
+type LogEventReader interface {
+	ListForService(ctx context.Context, serviceID string, window api.TimeWindow, opts api.ListOptions) ([]api.LogEvent, error)
+	Get(ctx context.Context, id string) (*api.LogEventDetail, error)
+	GetWeekOverWeek(ctx context.Context, id string, now time.Time) (*api.WeekOverWeek, error)
+}
+
+// Key bindings for the log events page
+var (
+	openBinding = key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "details"),
+	)
+	backBinding = key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "back"),
+	)
+	scrollBinding = key.NewBinding(
+		key.WithKeys("up", "down", "pgup", "pgdown"),
+		key.WithHelp("↑/↓", "scroll"),
+	)
+)
+
+// headerHeight is the number of lines above the table or detail (title, subtitle, gap)
+const headerHeight = 3
+
+// model represents the log events page state.
+// It has two views: the service's events as a table, and one event's detail.
+type model struct {
+	// Identity - which service's events to show
+	serviceID   string
+	serviceName string
+
+	// Services (defined by consumer interfaces)
+	events LogEventReader
+
+	// Logger
+	logger log.Logger
+
+	// Layout
+	layout layouts.Layout
+	ready  bool
+
+	// List view
+	list    []api.LogEvent
+	table   *table.Table
+	loading bool
+
+	// Detail view (nil detail means the list is showing)
+	detail        *api.LogEventDetail
+	wow           *api.WeekOverWeek
+	wowErr        error
+	viewport      viewport.Model
+	loadingDetail bool
+
+	// Status
+	err error
+
+	// Global key bindings (passed from TUI)
+	globalBindings []key.Binding
+}
+
+// eventsLoadedMsg is sent when the service's events finish loading
+type eventsLoadedMsg struct {
+	events []api.LogEvent
+	err    error
+}
+
+// detailLoadedMsg is sent when an event's detail finishes loading
+type detailLoadedMsg struct {
+	id     string
+	detail *api.LogEventDetail
+	err    error
+}
+
+// wowLoadedMsg is sent when an event's week-over-week volume finishes loading
+type wowLoadedMsg struct {
+	id  string
+	wow *api.WeekOverWeek
+	err error
+}
+
+// New creates a new log events page for a service
+func New(serviceID, serviceName string, events LogEventReader, layout layouts.Layout, logger log.Logger, globalBindings []key.Binding) page.Page {
+	if events == nil {
+		panic("events cannot be nil")
+	}
+	if layout == nil {
+		panic("layout cannot be nil")
+	}
+	if logger == nil {
+		panic("logger cannot be nil")
+	}
+
+	t := table.New(columns(0))
+	t.SetFocused(true)
+
+	vp := viewport.New()
+	vp.KeyMap = viewport.KeyMap{
+		PageUp:   key.NewBinding(key.WithKeys("pgup")),
+		PageDown: key.NewBinding(key.WithKeys("pgdown")),
+		Up:       key.NewBinding(key.WithKeys("up", "k")),
+		Down:     key.NewBinding(key.WithKeys("down", "j")),
+	}
+
+	return &model{
+		serviceID:      serviceID,
+		serviceName:    serviceName,
+		events:         events,
+		logger:         logger,
+		layout:         layout,
+		table:          t,
+		viewport:       vp,
+		globalBindings: globalBindings,
+	}
+}
+
+// Init loads the service's log events
+func (m *model) Init() tea.Cmd {
+	m.loading = true
+	serviceID := m.serviceID
+	return func() tea.Msg {
+		events, err := m.events.ListForService(context.Background(), serviceID, api.TimeWindowWeek, api.ListOptions{})
+		return eventsLoadedMsg{events: events, err: err}
+	}
+}
+
+// open loads an event's detail and week-over-week volume in parallel
+func (m *model) open(event api.LogEvent) tea.Cmd {
+	m.logger.Info("opening log event", "logEventID", event.ID)
+	m.loadingDetail = true
+	m.detail = &api.LogEventDetail{LogEvent: event} // Show what we know while loading
+	m.wow = nil
+	m.wowErr = nil
+	m.refreshDetail()
+	m.viewport.GotoTop()
+
+	id := event.ID
+	return tea.Batch(
+		func() tea.Msg {
+			detail, err := m.events.Get(context.Background(), id)
+			return detailLoadedMsg{id: id, detail: detail, err: err}
+		},
+		func() tea.Msg {
+			wow, err := m.events.GetWeekOverWeek(context.Background(), id, time.Now())
+			return wowLoadedMsg{id: id, wow: wow, err: err}
+		},
+	)
+}
+
+// SetSize sets the width and height available for rendering
+func (m *model) SetSize(width, height int) {
+	m.layout.SetSize(width, height)
+
+	contentWidth, contentHeight := m.layout.ContentSize()
+	m.table.SetColumns(columns(contentWidth))
+	m.table.SetWidth(contentWidth)
+	m.table.SetHeight(max(contentHeight-headerHeight, 3))
+	m.viewport.SetWidth(contentWidth)
+	m.viewport.SetHeight(max(contentHeight-headerHeight, 3))
+	m.refreshDetail()
+
+	m.ready = true
+}
+
+// Update handles incoming messages and updates state
+func (m *model) Update(msg tea.Msg) tea.Cmd {
+	// Note: WindowSizeMsg is handled by parent (tui.go), not here
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case eventsLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.logger.Error("failed to load log events", "error", msg.err, "serviceID", m.serviceID)
+			m.err = msg.err
+			break
+		}
+		m.err = nil
+		m.list = msg.events
+		rows := make([]table.Row, len(m.list))
+		for i, e := range m.list {
+			rows[i] = table.Row{
+				e.Name,
+				format.Count(e.Stats.TotalVolume),
+				format.Percent(e.Stats.WastePercent, 1),
+				format.Percent(e.Stats.SavedPercent, 1),
+				e.Description,
+			}
+		}
+		m.table.SetRows(rows)
+
+	case detailLoadedMsg:
+		if m.detail == nil || msg.id != m.detail.ID {
+			break // The user already went back or opened another event
+		}
+		m.loadingDetail = false
+		if msg.err != nil {
+			m.logger.Error("failed to load log event", "error", msg.err, "logEventID", msg.id)
+			m.err = msg.err
+			break
+		}
+		if msg.detail != nil {
+			m.detail = msg.detail
+		}
+		m.refreshDetail()
+
+	case wowLoadedMsg:
+		if m.detail == nil || msg.id != m.detail.ID {
+			break
+		}
+		if msg.err != nil {
+			// Not fatal - the rest of the detail is still useful
+			m.logger.Warn("failed to load week-over-week volume", "error", msg.err, "logEventID", msg.id)
+		}
+		m.wow = msg.wow
+		m.wowErr = msg.err
+		m.refreshDetail()
+
+	case tea.KeyPressMsg:
+		cmds = append(cmds, m.handleKey(msg))
+
+	case tea.MouseWheelMsg:
+		if m.detail != nil {
+			var cmd tea.Cmd
+			m.viewport, cmd = m.viewport.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	// Combine page bindings + global bindings
+	var bindings []key.Binding
+	bindings = append(bindings, m.Help().ShortHelp()...)
+	bindings = append(bindings, m.globalBindings...)
+	m.layout.SetKeyBindings(bindings)
+
+	// Pass error state to layout (always set, even if nil to clear previous errors)
+	m.layout.SetError(m.Error())
+
+	// Cascade to layout
+	cmds = append(cmds, m.layout.Update(msg))
+
+	return tea.Batch(cmds...)
+}
+
+// handleKey handles a key press for whichever view is showing
+func (m *model) handleKey(msg tea.KeyPressMsg) tea.Cmd {
+	if m.detail != nil {
+		if key.Matches(msg, backBinding) {
+			m.detail = nil
+			m.loadingDetail = false
+			m.err = nil
+			return nil
+		}
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return cmd
+	}
+
+	switch {
+	case key.Matches(msg, backBinding):
+		return func() tea.Msg { return page.BackMsg{} }
+	case key.Matches(msg, openBinding):
+		i := m.table.Cursor()
+		if i >= 0 && i < len(m.list) {
+			return m.open(m.list[i])
+		}
+		return nil
+	default:
+		// Row navigation
+		return m.table.Update(msg)
+	}
+}
+
+// refreshDetail re-renders the detail view into the viewport
+func (m *model) refreshDetail() {
+	if m.detail == nil {
+		return
+	}
+	m.viewport.SetContent(renderDetail(m.detail, m.wow, m.wowErr, m.loadingDetail, m.viewport.Width()))
+}
+
+// columns returns the table columns, giving the description whatever width is left
+func columns(width int) []table.Column {
+	const (
+		nameWidth   = 28
+		numberWidth = 10
+		cellPadding = 2 // The table component pads each cell by one on each side
+	)
+	fixed := nameWidth + 3*numberWidth + 5*cellPadding
+	descriptionWidth := max(width-fixed, 12)
+
+	return []table.Column{
+		{Title: "Log event", Width: nameWidth},
+		{Title: "Logs (7d)", Width: numberWidth},
+		{Title: "Waste", Width: numberWidth},
+		{Title: "Saved", Width: numberWidth},
+		{Title: "Description", Width: descriptionWidth},
+	}
+}
+
+// View renders the page content as a string (implements pages.Page interface)
+func (m *model) View() string {
+	if !m.ready {
+		return ""
+	}
+
+	common := styles.Common()
+
+	var title, subtitle, body string
+	if m.detail != nil {
+		title = common.Title.Render(m.detail.Name)
+		subtitle = common.Subtitle.Render(m.serviceName + " · log event")
+		body = m.viewport.View()
+	} else {
+		title = common.Title.Render(m.serviceName)
+		subtitle = common.Subtitle.Render(strconv.Itoa(len(m.list)) + " log events · last 7 days")
+		switch {
+		case m.loading:
+			body = common.Help.Render("Loading log events...")
+		case m.err != nil:
+			body = ""
+		case len(m.list) == 0:
+			body = common.Body.Render("No log events discovered for this service yet.")
+		default:
+			body = m.table.View()
+		}
+	}
+
+	return m.layout.Render(lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		subtitle,
+		"",
+		body,
+	))
+}
+
+// IsBusy returns true while loading events or an event's detail
+func (m *model) IsBusy() bool {
+	return m.loading || m.loadingDetail
+}
+
+// HasError returns true if loading failed
+func (m *model) HasError() bool {
+	return m.err != nil
+}
+
+// Error returns the current error, or nil if no error
+func (m *model) Error() error {
+	return m.err
+}
+
+// Help returns key bindings for the current view
+func (m *model) Help() help.KeyMap {
+	if m.detail != nil {
+		return keymap.Simple{Keys: []key.Binding{scrollBinding, backBinding}}
+	}
+	return keymap.Simple{Keys: []key.Binding{openBinding, backBinding}}
+}
@@ -33,3 +33,24 @@ type Page interface {
 	// Help returns the help key bindings for this page
 	Help() help.KeyMap
 }
+
+// Backer is implemented by pages that use esc themselves (e.g. to clear a
+// filter). While CanGoBack returns true, esc goes to the page instead of
+// quitting.
+type Backer interface {
+	CanGoBack() bool
+}
+
+// ShownMsg is sent to a page when navigation makes it the current page.
+// Pages share the app's layout, so this lets the page reclaim the footer's
+// key bindings and error state even if it has nothing else to update.
+type ShownMsg struct{}
+
+// OpenLogEventsMsg asks the app to drill into a service's log events.
+type OpenLogEventsMsg struct {
+	ServiceID   string
+	ServiceName string
+}
+
+// BackMsg asks the app to leave a drill-down and return to the page it was opened from.
+type BackMsg struct{}
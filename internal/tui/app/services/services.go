@@ -0,0 +1,456 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/v2/help"
+	"github.com/charmbracelet/bubbles/v2/key"
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/format"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/tui/app/page"
+	"github.com/usetero/cli/internal/tui/components/input"
+	"github.com/usetero/cli/internal/tui/components/table"
+	"github.com/usetero/cli/internal/tui/keymap"
+	"github.com/usetero/cli/internal/tui/layouts"
+	"github.com/usetero/cli/internal/tui/styles"
+)
+
+// ServiceCatalog lists services with their volume stats and toggles analysis
+type ServiceCatalog interface {
+	ListVolumeStats(ctx context.Context, accountID string, window api.TimeWindow, opts api.ListOptions) ([]api.ServiceVolumeStats, error)
+	SetEnabled(ctx context.Context, serviceID string, enabled bool) (*api.Service, error)
+}
+
+// window is the lookback used for the volume columns
+const window = api.TimeWindowWeek
+
+// Key bindings for the services page
+var (
+	filterBinding = key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("/", "filter"),
+	)
+	clearFilterBinding = key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "clear filter"),
+	)
+	applyFilterBinding = key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "done"),
+	)
+	sortBinding = key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "sort"),
+	)
+	reverseBinding = key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "reverse"),
+	)
+	openBinding = key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "log events"),
+	)
+	toggleBinding = key.NewBinding(
+		key.WithKeys("space"),
+		key.WithHelp("space", "enable/disable"),
+	)
+	refreshBinding = key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("ctrl+r", "refresh"),
+	)
+)
+
+// headerHeight is the number of lines above the table (title, subtitle, filter, gap)
+const headerHeight = 4
+
+// model represents the services page state
+type model struct {
+	// Identity - which account's services to list
+	accountID string
+
+	// Services (defined by consumer interfaces)
+	catalog ServiceCatalog
+
+	// Logger
+	logger log.Logger
+
+	// Layout
+	layout layouts.Layout
+	ready  bool
+
+	// Data
+	services []api.ServiceVolumeStats // Everything, in API order
+	visible  []api.ServiceVolumeStats // Filtered and sorted, matching table rows
+	toggling map[string]bool          // Service IDs with an enable/disable in flight
+
+	// View state
+	sort       sortColumn
+	descending bool
+
+	// UI components
+	table  *table.Table
+	filter *input.Component
+
+	// Status
+	loading bool
+	err     error
+
+	// Global key bindings (passed from TUI)
+	globalBindings []key.Binding
+}
+
+// servicesLoadedMsg is sent when the service list finishes loading
+type servicesLoadedMsg struct {
+	services []api.ServiceVolumeStats
+	err      error
+}
+
+// serviceToggledMsg is sent when an enable/disable finishes
+type serviceToggledMsg struct {
+	serviceID string
+	service   *api.Service
+	err       error
+}
+
+// New creates a new services page model
+func New(accountID string, catalog ServiceCatalog, layout layouts.Layout, logger log.Logger, globalBindings []key.Binding) page.Page {
+	if catalog == nil {
+		panic("catalog cannot be nil")
+	}
+	if layout == nil {
+		panic("layout cannot be nil")
+	}
+	if logger == nil {
+		panic("logger cannot be nil")
+	}
+
+	filter := input.New(logger)
+	filter.SetVirtualCursor(true) // The sidebar layout composes layers, which drops the cursor marker
+	filter.SetPlaceholder("Press / to filter services")
+	filter.Blur()
+
+	t := table.New(columns(0))
+	t.SetFocused(true)
+
+	return &model{
+		accountID:      accountID,
+		catalog:        catalog,
+		logger:         logger,
+		layout:         layout,
+		toggling:       make(map[string]bool),
+		sort:           sortByWaste,
+		descending:     sortByWaste.defaultDescending(),
+		table:          t,
+		filter:         filter,
+		globalBindings: globalBindings,
+	}
+}
+
+// Init loads the services
+func (m *model) Init() tea.Cmd {
+	m.loading = true
+	return m.load()
+}
+
+// load fetches every service in the account with its volume stats
+func (m *model) load() tea.Cmd {
+	accountID := m.accountID
+	return func() tea.Msg {
+		services, err := m.catalog.ListVolumeStats(context.Background(), accountID, window, api.ListOptions{})
+		return servicesLoadedMsg{services: services, err: err}
+	}
+}
+
+// toggle flips analysis on or off for a service
+func (m *model) toggle(svc api.ServiceVolumeStats) tea.Cmd {
+	m.logger.Info("toggling service", "serviceID", svc.ID, "enabled", !svc.Enabled)
+	m.toggling[svc.ID] = true
+	m.refreshRows()
+
+	return func() tea.Msg {
+		updated, err := m.catalog.SetEnabled(context.Background(), svc.ID, !svc.Enabled)
+		return serviceToggledMsg{serviceID: svc.ID, service: updated, err: err}
+	}
+}
+
+// SetSize sets the width and height available for rendering
+func (m *model) SetSize(width, height int) {
+	m.layout.SetSize(width, height)
+
+	contentWidth, contentHeight := m.layout.ContentSize()
+	m.table.SetColumns(columns(contentWidth))
+	m.table.SetWidth(contentWidth)
+	m.table.SetHeight(max(contentHeight-headerHeight, 3))
+	m.filter.SetWidth(max(contentWidth-4, 10))
+
+	m.ready = true
+}
+
+// Update handles incoming messages and updates state
+func (m *model) Update(msg tea.Msg) tea.Cmd {
+	// Note: WindowSizeMsg is handled by parent (tui.go), not here
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case servicesLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.logger.Error("failed to load services", "error", msg.err)
+			m.err = msg.err
+			break
+		}
+		m.err = nil
+		m.services = msg.services
+		m.refreshRows()
+
+	case serviceToggledMsg:
+		delete(m.toggling, msg.serviceID)
+		if msg.err != nil {
+			m.logger.Error("failed to toggle service", "error", msg.err, "serviceID", msg.serviceID)
+			m.err = fmt.Errorf("couldn't update service: %w", msg.err)
+		} else {
+			m.err = nil
+			for i := range m.services {
+				if m.services[i].ID == msg.serviceID {
+					m.services[i].Enabled = msg.service.Enabled
+				}
+			}
+		}
+		m.refreshRows()
+
+	case tea.KeyPressMsg:
+		cmds = append(cmds, m.handleKey(msg))
+
+	default:
+		// Cursor blink and other input internals
+		if m.filter.Focused() {
+			cmds = append(cmds, m.filter.Update(msg))
+		}
+	}
+
+	// Combine page bindings + global bindings
+	var bindings []key.Binding
+	bindings = append(bindings, m.Help().ShortHelp()...)
+	bindings = append(bindings, m.globalBindings...)
+	m.layout.SetKeyBindings(bindings)
+
+	// Pass error state to layout (always set, even if nil to clear previous errors)
+	m.layout.SetError(m.Error())
+
+	// Cascade to layout
+	cmds = append(cmds, m.layout.Update(msg))
+
+	return tea.Batch(cmds...)
+}
+
+// CanGoBack returns true while esc clears the filter, so it doesn't quit
+func (m *model) CanGoBack() bool {
+	return m.filter.Focused() || m.filter.Value() != ""
+}
+
+// handleKey handles a key press, routing typing to the filter while it's focused
+func (m *model) handleKey(msg tea.KeyPressMsg) tea.Cmd {
+	if m.filter.Focused() {
+		switch {
+		case key.Matches(msg, clearFilterBinding):
+			m.filter.Reset()
+			m.filter.Blur()
+			m.refreshRows()
+			return nil
+		case key.Matches(msg, applyFilterBinding):
+			m.filter.Blur()
+			return nil
+		}
+		cmd := m.filter.Update(msg)
+		m.refreshRows()
+		return cmd
+	}
+
+	switch {
+	case key.Matches(msg, filterBinding):
+		return m.filter.Focus()
+	case key.Matches(msg, clearFilterBinding):
+		if m.filter.Value() != "" {
+			m.filter.Reset()
+			m.refreshRows()
+		}
+	case key.Matches(msg, sortBinding):
+		m.sort = m.sort.next()
+		m.descending = m.sort.defaultDescending()
+		m.refreshRows()
+	case key.Matches(msg, reverseBinding):
+		m.descending = !m.descending
+		m.refreshRows()
+	case key.Matches(msg, openBinding):
+		if svc, ok := m.selected(); ok {
+			return func() tea.Msg {
+				return page.OpenLogEventsMsg{ServiceID: svc.ID, ServiceName: svc.Name}
+			}
+		}
+	case key.Matches(msg, toggleBinding):
+		if svc, ok := m.selected(); ok && !m.toggling[svc.ID] {
+			return m.toggle(svc)
+		}
+	case key.Matches(msg, refreshBinding):
+		if !m.loading {
+			m.loading = true
+			return m.load()
+		}
+	default:
+		// Row navigation
+		return m.table.Update(msg)
+	}
+	return nil
+}
+
+// selected returns the service under the table cursor
+func (m *model) selected() (api.ServiceVolumeStats, bool) {
+	i := m.table.Cursor()
+	if i < 0 || i >= len(m.visible) {
+		return api.ServiceVolumeStats{}, false
+	}
+	return m.visible[i], true
+}
+
+// refreshRows re-applies the filter and sort, keeping the same service selected
+func (m *model) refreshRows() {
+	selectedID := ""
+	if svc, ok := m.selected(); ok {
+		selectedID = svc.ID
+	}
+
+	m.visible = filterServices(m.services, m.filter.Value())
+	sortServices(m.visible, m.sort, m.descending)
+
+	rows := make([]table.Row, len(m.visible))
+	cursor := 0
+	for i, svc := range m.visible {
+		rows[i] = m.row(svc)
+		if svc.ID == selectedID {
+			cursor = i
+		}
+	}
+	m.table.SetRows(rows)
+	m.table.SetCursor(cursor)
+}
+
+// row renders a service as a table row
+func (m *model) row(svc api.ServiceVolumeStats) table.Row {
+	enabled := "off"
+	if svc.Enabled {
+		enabled = "on"
+	}
+	if m.toggling[svc.ID] {
+		enabled = "…"
+	}
+
+	initial := "—"
+	if svc.InitialWeeklyLogCount != nil {
+		initial = format.Count(float64(*svc.InitialWeeklyLogCount))
+	}
+
+	return table.Row{
+		svc.Name,
+		enabled,
+		format.Count(svc.Stats.TotalVolume),
+		format.Percent(svc.Stats.WastePercent, 1),
+		format.Percent(svc.Stats.SavedPercent, 1),
+		initial,
+	}
+}
+
+// columns returns the table columns, giving the name column whatever width is left
+func columns(width int) []table.Column {
+	const (
+		enabledWidth = 8
+		numberWidth  = 10
+		cellPadding  = 2 // bubbles table pads each cell by one on each side
+	)
+	fixed := enabledWidth + 4*numberWidth + 6*cellPadding
+	nameWidth := max(width-fixed, 16)
+
+	return []table.Column{
+		{Title: "Service", Width: nameWidth},
+		{Title: "Analysis", Width: enabledWidth},
+		{Title: "Logs (7d)", Width: numberWidth},
+		{Title: "Waste", Width: numberWidth},
+		{Title: "Saved", Width: numberWidth},
+		{Title: "Initial/wk", Width: numberWidth},
+	}
+}
+
+// View renders the page content as a string (implements pages.Page interface)
+func (m *model) View() string {
+	if !m.ready {
+		return ""
+	}
+
+	common := styles.Common()
+
+	title := common.Title.Render("Services")
+
+	var body string
+	switch {
+	case m.loading && m.services == nil:
+		body = common.Help.Render("Loading services...")
+	case m.services == nil:
+		// Load failed before anything was shown; the footer has the error
+		body = common.Help.Render("Press ctrl+r to try again.")
+	case len(m.services) == 0:
+		body = common.Body.Render("No services discovered yet. Tero finds them as it analyzes your logs.")
+	case len(m.visible) == 0:
+		body = common.Help.Render("No services match \"" + m.filter.Value() + "\".")
+	default:
+		body = m.table.View()
+	}
+
+	return m.layout.Render(lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		common.Subtitle.Render(m.subtitle()),
+		m.filter.View(),
+		"",
+		body,
+	))
+}
+
+// subtitle summarizes what's shown and how it's ordered
+func (m *model) subtitle() string {
+	direction := "↑"
+	if m.descending {
+		direction = "↓"
+	}
+
+	count := strconv.Itoa(len(m.services)) + " services"
+	if len(m.visible) != len(m.services) {
+		count = strconv.Itoa(len(m.visible)) + " of " + count
+	}
+	return count + " · sorted by " + m.sort.String() + " " + direction
+}
+
+// IsBusy returns true while loading or toggling services
+func (m *model) IsBusy() bool {
+	return m.loading || len(m.toggling) > 0
+}
+
+// HasError returns true if loading or toggling failed
+func (m *model) HasError() bool {
+	return m.err != nil
+}
+
+// Error returns the current error, or nil if no error
+func (m *model) Error() error {
+	return m.err
+}
+
+// Help returns key bindings for the services page
+func (m *model) Help() help.KeyMap {
+	if m.filter.Focused() {
+		return keymap.Simple{Keys: []key.Binding{applyFilterBinding, clearFilterBinding}}
+	}
+	return keymap.Simple{Keys: []key.Binding{openBinding, filterBinding, sortBinding, reverseBinding, toggleBinding, refreshBinding}}
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/sahilm/fuzzy"
+	"github.com/usetero/cli/internal/api"
+)
+
+// sortColumn is the column the table is ordered by
+type sortColumn int
+
+const (
+	sortByWaste sortColumn = iota
+	sortByTotal
+	sortBySaved
+	sortByInitial
+	sortByName
+	numSortColumns
+)
+
+// String returns the column name shown in the page subtitle
+func (c sortColumn) String() string {
+	switch c {
+	case sortByWaste:
+		return "waste"
+	case sortByTotal:
+		return "volume"
+	case sortBySaved:
+		return "saved"
+	case sortByInitial:
+		return "initial volume"
+	case sortByName:
+		return "name"
+	default:
+		return "unknown"
+	}
+}
+
+// next returns the column after c, wrapping around
+func (c sortColumn) next() sortColumn {
+	return (c + 1) % numSortColumns
+}
+
+// defaultDescending returns whether the column sorts largest first by default.
+// Numbers read best biggest-first; names read best A to Z.
+func (c sortColumn) defaultDescending() bool {
+	return c != sortByName
+}
+
+// compare orders two services by the column, ascending
+func (c sortColumn) compare(a, b api.ServiceVolumeStats) int {
+	switch c {
+	case sortByWaste:
+		return cmp.Compare(a.Stats.WastePercent, b.Stats.WastePercent)
+	case sortByTotal:
+		return cmp.Compare(a.Stats.TotalVolume, b.Stats.TotalVolume)
+	case sortBySaved:
+		return cmp.Compare(a.Stats.SavedPercent, b.Stats.SavedPercent)
+	case sortByInitial:
+		return cmp.Compare(initialCount(a), initialCount(b))
+	default:
+		return 0
+	}
+}
+
+// initialCount returns the initial weekly log count, treating unknown as -1
+// so services without one sort below services that report zero
+func initialCount(s api.ServiceVolumeStats) int {
+	if s.InitialWeeklyLogCount == nil {
+		return -1
+	}
+	return *s.InitialWeeklyLogCount
+}
+
+// sortServices orders services in place by the column, breaking ties by name
+// so the order is stable across refreshes.
+func sortServices(services []api.ServiceVolumeStats, column sortColumn, descending bool) {
+	slices.SortStableFunc(services, func(a, b api.ServiceVolumeStats) int {
+		c := column.compare(a, b)
+		if descending {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+		byName := strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+		if column == sortByName && descending {
+			return -byName
+		}
+		return byName
+	})
+}
+
+// serviceNames adapts services to fuzzy.Source, matching on name
+type serviceNames []api.ServiceVolumeStats
+
+func (s serviceNames) String(i int) string { return s[i].Name }
+func (s serviceNames) Len() int            { return len(s) }
+
+// filterServices returns the services whose names fuzzy-match the query.
+// An empty query matches everything.
+func filterServices(services []api.ServiceVolumeStats, query string) []api.ServiceVolumeStats {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return slices.Clone(services)
+	}
+
+	matches := fuzzy.FindFrom(query, serviceNames(services))
+	filtered := make([]api.ServiceVolumeStats, len(matches))
+	for i, match := range matches {
+		filtered[i] = services[match.Index]
+	}
+	return filtered
+}
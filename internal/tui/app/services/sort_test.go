@@ -0,0 +1,78 @@
+package services
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/usetero/cli/internal/api"
+)
+
+func TestSortServices(t *testing.T) {
+	services := []api.ServiceVolumeStats{
+		service("checkout-api", 40, nil),
+		service("auth", 10, ptr(500)),
+		service("billing", 40, ptr(100)),
+		service("search", 0, ptr(0)),
+	}
+
+	tests := []struct {
+		name       string
+		column     sortColumn
+		descending bool
+		want       []string
+	}{
+		{"waste descending breaks ties by name", sortByWaste, true, []string{"billing", "checkout-api", "auth", "search"}},
+		{"name ascending", sortByName, false, []string{"auth", "billing", "checkout-api", "search"}},
+		{"name descending", sortByName, true, []string{"search", "checkout-api", "billing", "auth"}},
+		{"initial volume puts unknown last", sortByInitial, true, []string{"auth", "billing", "search", "checkout-api"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Clone(services)
+			sortServices(got, tt.column, tt.descending)
+			if names := names(got); !slices.Equal(names, tt.want) {
+				t.Errorf("order = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterServices(t *testing.T) {
+	services := []api.ServiceVolumeStats{
+		service("checkout-api", 0, nil),
+		service("auth", 0, nil),
+		service("cart-worker", 0, nil),
+	}
+
+	if got := names(filterServices(services, "")); len(got) != 3 {
+		t.Errorf("empty query matched %v, want everything", got)
+	}
+	if got := names(filterServices(services, "cht")); !slices.Equal(got, []string{"checkout-api"}) {
+		t.Errorf("fuzzy query matched %v, want [checkout-api]", got)
+	}
+	if got := names(filterServices(services, "zzz")); len(got) != 0 {
+		t.Errorf("unmatched query matched %v, want nothing", got)
+	}
+}
+
+func service(name string, wastePercent float64, initial *int) api.ServiceVolumeStats {
+	return api.ServiceVolumeStats{
+		ID:                    name,
+		Name:                  name,
+		Stats:                 api.VolumeStats{WastePercent: wastePercent},
+		InitialWeeklyLogCount: initial,
+	}
+}
+
+func names(services []api.ServiceVolumeStats) []string {
+	out := make([]string, len(services))
+	for i, s := range services {
+		out[i] = s.Name
+	}
+	return out
+}
+
+func ptr(n int) *int {
+	return &n
+}
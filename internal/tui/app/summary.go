@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/tui/components/sidebar"
+)
+
+// summaryRefreshInterval is how often the sidebar's account data is reloaded.
+// Volume stats are aggregated server-side, so more frequent polling adds load
+// without showing anything new.
+const summaryRefreshInterval = 5 * time.Minute
+
+// summaryTimeout bounds a single load so a hung request can't stall refreshes
+const summaryTimeout = 30 * time.Second
+
+// SummaryFetcher loads the account data shown in the sidebar
+type SummaryFetcher interface {
+	GetSummary(ctx context.Context, accountID string) (*api.AccountSummary, error)
+}
+
+// summaryMsg is sent when a sidebar data load finishes
+type summaryMsg struct {
+	summary *api.AccountSummary
+	err     error
+}
+
+// refreshSummaryMsg is sent when it's time to reload the sidebar data
+type refreshSummaryMsg struct{}
+
+// loadSummary fetches the account data for the sidebar
+func (m *App) loadSummary() tea.Cmd {
+	m.layout.SetSummaryLoading(true)
+	accountID := m.accountID
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), summaryTimeout)
+		defer cancel()
+
+		summary, err := m.summaries.GetSummary(ctx, accountID)
+		if err == nil && summary == nil {
+			err = errors.New("account not found")
+		}
+		return summaryMsg{summary: summary, err: err}
+	}
+}
+
+// handleSummary updates the sidebar with a finished load
+func (m *App) handleSummary(msg summaryMsg) {
+	if msg.err != nil {
+		// Keep showing the last good data; the sidebar marks it stale
+		m.logger.Warn("failed to load sidebar summary", "error", msg.err)
+		m.layout.SetSummaryError(msg.err)
+		return
+	}
+
+	m.layout.SetSummary(sidebar.Summary{
+		OrgName:       msg.summary.OrganizationName,
+		ServicesCount: msg.summary.ServicesCount,
+		LogsPerHour:   msg.summary.LogsPerHour(),
+		WastePercent:  msg.summary.Week.WastePercent,
+		WasteTrend:    msg.summary.WasteTrend(),
+		SavedPercent:  msg.summary.Week.SavedPercent,
+	})
+}
+
+// scheduleSummaryRefresh waits for the refresh interval, then asks for a reload
+func scheduleSummaryRefresh() tea.Cmd {
+	return tea.Tick(summaryRefreshInterval, func(time.Time) tea.Msg {
+		return refreshSummaryMsg{}
+	})
+}
@@ -0,0 +1,40 @@
+package blocks
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/tui/styles"
+)
+
+// renderAction renders suggested actions as numbered buttons with descriptions.
+func renderAction(block api.ContentBlock) (Block, error) {
+	var data api.ActionBlock
+	if err := block.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	return &static{render: func(width int) string {
+		theme := styles.CurrentTheme()
+
+		button := lipgloss.NewStyle().
+			Foreground(theme.Primary).
+			Bold(true)
+		description := lipgloss.NewStyle().Foreground(theme.TextMuted)
+		if width > 0 {
+			description = description.MaxWidth(width)
+		}
+
+		lines := make([]string, 0, len(data.Actions))
+		for i, a := range data.Actions {
+			line := button.Render("[" + strconv.Itoa(i+1) + "] " + a.Label)
+			if a.Description != "" {
+				line += description.Render("  " + a.Description)
+			}
+			lines = append(lines, line)
+		}
+		return strings.Join(lines, "\n")
+	}}, nil
+}
@@ -0,0 +1,66 @@
+package blocks
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/format"
+	"github.com/usetero/cli/internal/tui/components/progress"
+	"github.com/usetero/cli/internal/tui/styles"
+)
+
+// renderChart renders a chart block as a horizontal bar per point,
+// scaled against the largest value.
+func renderChart(block api.ContentBlock) (Block, error) {
+	var data api.ChartBlock
+	if err := block.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	bar := progress.New(0)
+	bar.SetShowPercentage(false)
+
+	return &static{render: func(width int) string {
+		common := styles.Common()
+		theme := styles.CurrentTheme()
+
+		labelWidth, valueWidth := 0, 0
+		peak := 0.0
+		values := make([]string, len(data.Points))
+		for i, p := range data.Points {
+			labelWidth = max(labelWidth, lipgloss.Width(p.Label))
+			values[i] = formatValue(p.Value, data.Unit)
+			valueWidth = max(valueWidth, lipgloss.Width(values[i]))
+			peak = max(peak, p.Value)
+		}
+
+		// label ␣ bar ␣ value
+		bar.SetWidth(max(width-labelWidth-valueWidth-2, 10))
+
+		labelStyle := lipgloss.NewStyle().Foreground(theme.TextMuted).Width(labelWidth)
+		valueStyle := lipgloss.NewStyle().Foreground(theme.Text).Width(valueWidth).Align(lipgloss.Right)
+
+		lines := make([]string, 0, len(data.Points)+1)
+		if data.Title != "" {
+			lines = append(lines, common.Subtitle.Bold(true).Render(data.Title))
+		}
+		for i, p := range data.Points {
+			percent := 0.0
+			if peak > 0 {
+				percent = p.Value / peak * 100
+			}
+			lines = append(lines, labelStyle.Render(p.Label)+" "+bar.ViewAs(percent)+" "+valueStyle.Render(values[i]))
+		}
+		return strings.Join(lines, "\n")
+	}}, nil
+}
+
+// formatValue formats a chart value with a compact suffix and its unit.
+func formatValue(v float64, unit string) string {
+	s := format.Count(v)
+	if unit != "" {
+		s += " " + unit
+	}
+	return s
+}
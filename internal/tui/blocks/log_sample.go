@@ -0,0 +1,73 @@
+package blocks
+
+import (
+	"image/color"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/tui/styles"
+)
+
+// renderLogSample renders example log lines with the timestamp, severity,
+// and service highlighted, one line per sample.
+func renderLogSample(block api.ContentBlock) (Block, error) {
+	var data api.LogSampleBlock
+	if err := block.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	return &static{render: func(width int) string {
+		theme := styles.CurrentTheme()
+		common := styles.Common()
+
+		muted := lipgloss.NewStyle().Foreground(theme.TextMuted)
+		service := lipgloss.NewStyle().Foreground(theme.Secondary)
+		body := lipgloss.NewStyle().Foreground(theme.Text)
+		line := lipgloss.NewStyle().
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderLeft(true).
+			BorderForeground(theme.Border).
+			PaddingLeft(1)
+		if width > 0 {
+			// Long log lines are cut rather than wrapped so samples stay one per line
+			line = line.MaxWidth(width)
+		}
+
+		lines := make([]string, 0, len(data.Samples)+1)
+		if data.Title != "" {
+			lines = append(lines, common.Subtitle.Bold(true).Render(data.Title))
+		}
+		for _, s := range data.Samples {
+			var parts []string
+			if !s.Timestamp.IsZero() {
+				parts = append(parts, muted.Render(s.Timestamp.Local().Format(time.TimeOnly)))
+			}
+			if s.Severity != "" {
+				sev := strings.ToUpper(s.Severity)
+				parts = append(parts, lipgloss.NewStyle().Bold(true).Foreground(severityColor(sev, theme)).Render(sev))
+			}
+			if s.Service != "" {
+				parts = append(parts, service.Render(s.Service))
+			}
+			parts = append(parts, body.Render(strings.ReplaceAll(s.Body, "\n", " ")))
+			lines = append(lines, line.Render(strings.Join(parts, " ")))
+		}
+		return strings.Join(lines, "\n")
+	}}, nil
+}
+
+// severityColor picks a theme color for a log severity.
+func severityColor(severity string, theme *styles.Theme) color.Color {
+	switch {
+	case strings.HasPrefix(severity, "ERR"), strings.HasPrefix(severity, "FATAL"), strings.HasPrefix(severity, "CRIT"):
+		return theme.Error
+	case strings.HasPrefix(severity, "WARN"):
+		return theme.Warning
+	case strings.HasPrefix(severity, "INFO"):
+		return theme.Info
+	default:
+		return theme.TextMuted
+	}
+}
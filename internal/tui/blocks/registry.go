@@ -0,0 +1,96 @@
+// Package blocks renders the content blocks that make up assistant responses.
+//
+// The control plane decides which blocks to send; the TUI decides how each
+// type looks in a terminal. Renderers are looked up by block type in a
+// Registry, so new types can be added without touching the pages that show
+// them. Types the registry doesn't know fall back to a short notice, which
+// lets the control plane ship new response shapes without breaking older CLIs.
+package blocks
+
+import (
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/log"
+)
+
+// Block is a rendered content block.
+// Blocks are components: they may animate (text streams in), so pages forward
+// messages to them and re-render their views.
+type Block interface {
+	// Init starts any animation and returns the initial command
+	Init() tea.Cmd
+
+	// Update handles a message and returns a command
+	Update(tea.Msg) tea.Cmd
+
+	// View renders the block at the last width set
+	View() string
+
+	// SetWidth sets the width available for rendering
+	SetWidth(width int)
+}
+
+// Renderer builds a Block from a content block's data.
+// Returning an error (e.g. data that doesn't decode) falls back to a notice.
+type Renderer func(block api.ContentBlock) (Block, error)
+
+// Registry maps content block types to renderers.
+type Registry struct {
+	renderers map[api.ContentBlockType]Renderer
+	logger    log.Logger
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry(logger log.Logger) *Registry {
+	if logger == nil {
+		panic("logger cannot be nil")
+	}
+
+	return &Registry{
+		renderers: make(map[api.ContentBlockType]Renderer),
+		logger:    logger,
+	}
+}
+
+// DefaultRegistry creates a registry with renderers for every block type
+// this version of the CLI understands.
+func DefaultRegistry(logger log.Logger) *Registry {
+	r := NewRegistry(logger)
+	r.Register(api.ContentBlockText, renderText)
+	r.Register(api.ContentBlockChart, renderChart)
+	r.Register(api.ContentBlockTable, renderTable)
+	r.Register(api.ContentBlockLogSample, renderLogSample)
+	r.Register(api.ContentBlockAction, renderAction)
+	return r
+}
+
+// Register sets the renderer for a block type, replacing any existing one.
+func (r *Registry) Register(blockType api.ContentBlockType, renderer Renderer) {
+	r.renderers[blockType] = renderer
+}
+
+// Render builds a Block for a content block.
+// Unknown types and undecodable data render as a notice instead of failing.
+func (r *Registry) Render(block api.ContentBlock) Block {
+	renderer, ok := r.renderers[block.Type]
+	if !ok {
+		r.logger.Warn("no renderer for content block", "type", block.Type)
+		return newNotice("This response includes content (" + string(block.Type) + ") this version of tero can't display. Update tero to see it.")
+	}
+
+	b, err := renderer(block)
+	if err != nil {
+		r.logger.Warn("failed to render content block", "type", block.Type, "error", err)
+		return newNotice("Part of this response (" + string(block.Type) + ") couldn't be displayed.")
+	}
+	return b
+}
+
+// RenderAll builds Blocks for each content block, in order.
+func (r *Registry) RenderAll(blocks []api.ContentBlock) []Block {
+	rendered := make([]Block, 0, len(blocks))
+	for _, block := range blocks {
+		rendered = append(rendered, r.Render(block))
+	}
+	return rendered
+}
@@ -0,0 +1,73 @@
+package blocks
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/log/logtest"
+)
+
+func TestRegistry_Render(t *testing.T) {
+	t.Run("renders known block types", func(t *testing.T) {
+		registry := DefaultRegistry(logtest.New(t))
+
+		b := registry.Render(block(t, api.ContentBlockTable, api.TableBlock{
+			Columns: []string{"Service", "Waste"},
+			Rows:    [][]string{{"checkout-api", "42%"}},
+		}))
+		b.SetWidth(60)
+
+		view := ansi.Strip(b.View())
+		if !strings.Contains(view, "checkout-api") || !strings.Contains(view, "Waste") {
+			t.Errorf("table view missing content:\n%s", view)
+		}
+	})
+
+	t.Run("falls back to a notice for unknown types", func(t *testing.T) {
+		registry := DefaultRegistry(logtest.New(t))
+
+		b := registry.Render(api.ContentBlock{Type: "heatmap", Data: json.RawMessage(`{"cells":[]}`)})
+
+		view := ansi.Strip(b.View())
+		if !strings.Contains(view, "heatmap") || !strings.Contains(view, "Update tero") {
+			t.Errorf("view = %q, want an update notice naming the type", view)
+		}
+	})
+
+	t.Run("falls back to a notice when data doesn't decode", func(t *testing.T) {
+		registry := DefaultRegistry(logtest.New(t))
+
+		b := registry.Render(api.ContentBlock{Type: api.ContentBlockChart, Data: json.RawMessage(`"not a chart"`)})
+
+		view := ansi.Strip(b.View())
+		if !strings.Contains(view, "couldn't be displayed") {
+			t.Errorf("view = %q, want a decode failure notice", view)
+		}
+	})
+
+	t.Run("uses registered renderers over defaults", func(t *testing.T) {
+		registry := DefaultRegistry(logtest.New(t))
+		registry.Register(api.ContentBlockText, func(api.ContentBlock) (Block, error) {
+			return newNotice("custom"), nil
+		})
+
+		b := registry.Render(api.NewTextBlock("hello"))
+
+		if view := ansi.Strip(b.View()); view != "custom" {
+			t.Errorf("view = %q, want custom", view)
+		}
+	})
+}
+
+// block builds a content block from typed data.
+func block(t *testing.T, blockType api.ContentBlockType, data any) api.ContentBlock {
+	t.Helper()
+	raw, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api.ContentBlock{Type: blockType, Data: raw}
+}
@@ -0,0 +1,44 @@
+package blocks
+
+import (
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/usetero/cli/internal/tui/styles"
+)
+
+// static is a Block that doesn't animate; its view depends only on its width.
+type static struct {
+	width  int
+	render func(width int) string
+}
+
+// Init does nothing - static blocks have no animation
+func (s *static) Init() tea.Cmd {
+	return nil
+}
+
+// Update does nothing - static blocks have no state
+func (s *static) Update(msg tea.Msg) tea.Cmd {
+	return nil
+}
+
+// View renders the block at the current width
+func (s *static) View() string {
+	return s.render(s.width)
+}
+
+// SetWidth sets the width available for rendering
+func (s *static) SetWidth(width int) {
+	s.width = width
+}
+
+// newNotice creates a block showing a muted one-line message.
+// Used as the fallback for blocks that can't be rendered.
+func newNotice(message string) Block {
+	return &static{render: func(width int) string {
+		style := styles.Common().Help.Italic(true)
+		if width > 0 {
+			style = style.Width(width)
+		}
+		return style.Render(message)
+	}}
+}
@@ -0,0 +1,82 @@
+package blocks
+
+import (
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/tui/components/table"
+	"github.com/usetero/cli/internal/tui/styles"
+)
+
+// renderTable renders a table block with the table component.
+// Columns are sized to their content and shrunk evenly to fit the width.
+func renderTable(block api.ContentBlock) (Block, error) {
+	var data api.TableBlock
+	if err := block.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	rows := make([]table.Row, len(data.Rows))
+	for i, r := range data.Rows {
+		// Pad or trim so every row matches the columns
+		row := make(table.Row, len(data.Columns))
+		copy(row, r)
+		rows[i] = row
+	}
+
+	return &static{render: func(width int) string {
+		columns := fitColumns(data.Columns, rows, width)
+
+		t := table.New(columns)
+		t.SetRows(rows)
+		t.SetHeight(len(rows) + 2) // +2 for the header and its border
+		if width > 0 {
+			t.SetWidth(width)
+		}
+
+		if data.Title == "" {
+			return t.View()
+		}
+		return lipgloss.JoinVertical(lipgloss.Left,
+			styles.Common().Subtitle.Bold(true).Render(data.Title),
+			t.View(),
+		)
+	}}, nil
+}
+
+// fitColumns sizes each column to its widest cell, then takes width away from
+// the widest columns until the table fits.
+func fitColumns(titles []string, rows []table.Row, width int) []table.Column {
+	const (
+		cellPadding = 2 // bubbles table pads each cell by one on each side
+		minWidth    = 4
+	)
+
+	columns := make([]table.Column, len(titles))
+	total := 0
+	for i, title := range titles {
+		w := lipgloss.Width(title)
+		for _, row := range rows {
+			w = max(w, lipgloss.Width(row[i]))
+		}
+		columns[i] = table.Column{Title: title, Width: w}
+		total += w + cellPadding
+	}
+
+	if width <= 0 {
+		return columns
+	}
+	for total > width {
+		widest := 0
+		for i := range columns {
+			if columns[i].Width > columns[widest].Width {
+				widest = i
+			}
+		}
+		if columns[widest].Width <= minWidth {
+			break
+		}
+		columns[widest].Width--
+		total--
+	}
+	return columns
+}
@@ -0,0 +1,26 @@
+package blocks
+
+import (
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/tui/components/stream"
+)
+
+// textBlock streams a natural language response word by word.
+type textBlock struct {
+	*stream.Component
+}
+
+// Init starts streaming immediately - the page shows its own thinker while waiting
+func (t *textBlock) Init() tea.Cmd {
+	return t.Start(0)
+}
+
+// renderText renders a text block with the stream component.
+func renderText(block api.ContentBlock) (Block, error) {
+	var data api.TextBlock
+	if err := block.Decode(&data); err != nil {
+		return nil, err
+	}
+	return &textBlock{Component: stream.New(data.Text)}, nil
+}
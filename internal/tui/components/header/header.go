@@ -15,8 +15,9 @@ const diag = `╱`
 
 // Component is a full-width header component with logo
 type Component struct {
-	width  int
-	logger log.Logger
+	width      int
+	breadcrumb string
+	logger     log.Logger
 }
 
 // Compile-time check that Component implements components.Component
@@ -43,6 +44,12 @@ func (c *Component) Update(msg tea.Msg) tea.Cmd {
 	return nil
 }
 
+// SetBreadcrumb sets the text shown under the logo, e.g. "Step 2 of 6 · Role".
+// Empty hides it.
+func (c *Component) SetBreadcrumb(text string) {
+	c.breadcrumb = text
+}
+
 // View renders the header with logo and diagonal fields on sides
 func (c *Component) View() string {
 	if c.width == 0 {
@@ -79,7 +86,7 @@ func (c *Component) View() string {
 	}
 
 	// Join horizontally: left diagonals + gap + logo + gap + right diagonals
-	return lipgloss.JoinHorizontal(
+	view := lipgloss.JoinHorizontal(
 		lipgloss.Top,
 		strings.TrimSpace(leftField.String()),
 		" ",
@@ -87,6 +94,17 @@ func (c *Component) View() string {
 		" ",
 		strings.TrimSpace(rightField.String()),
 	)
+	if c.breadcrumb == "" {
+		return view
+	}
+
+	// Breadcrumb right-aligned under the diagonals
+	breadcrumb := lipgloss.NewStyle().
+		Foreground(theme.TextSubtle).
+		Width(lipgloss.Width(view)).
+		Align(lipgloss.Right).
+		Render(c.breadcrumb)
+	return lipgloss.JoinVertical(lipgloss.Left, view, breadcrumb)
 }
 
 // IsBusy returns false - header components are never busy
@@ -81,12 +81,34 @@ func (c *Component) SetEchoCharacter(char rune) {
 	c.model.EchoCharacter = char
 }
 
+// SetVirtualCursor draws the cursor as part of the input's text instead of
+// positioning the real terminal cursor. Use this when the input is rendered
+// inside layers (e.g. the sidebar layout) that would strip the cursor marker.
+func (c *Component) SetVirtualCursor(virtual bool) {
+	c.model.VirtualCursor = virtual
+}
+
+// Reset clears the input value
+func (c *Component) Reset() {
+	c.model.Reset()
+}
+
 // Focus focuses the input
 func (c *Component) Focus() tea.Cmd {
 	c.logger.Debug("input focused")
 	return c.model.Focus()
 }
 
+// Blur removes focus from the input
+func (c *Component) Blur() {
+	c.model.Blur()
+}
+
+// Focused returns whether the input has focus
+func (c *Component) Focused() bool {
+	return c.model.Focused()
+}
+
 // Value returns the current input value
 func (c *Component) Value() string {
 	return c.model.Value()
@@ -106,6 +128,9 @@ func (c *Component) Cursor() *tea.Cursor {
 // View renders the input with cursor marker inserted
 func (c *Component) View() string {
 	view := c.model.View()
+	if c.model.VirtualCursor {
+		return view
+	}
 	cursor := c.model.Cursor()
 
 	// Insert cursor marker at cursor position
@@ -56,6 +56,11 @@ func (p *Progress) View() string {
 	return p.model.View()
 }
 
+// SetShowPercentage sets whether the percentage is shown after the bar.
+func (p *Progress) SetShowPercentage(show bool) {
+	p.model.ShowPercentage = show
+}
+
 // SetWidth updates the width of the progress bar.
 func (p *Progress) SetWidth(width int) {
 	p.model.SetWidth(width)
@@ -12,7 +12,7 @@ import (
 // NavItem represents a single navigation item in the sidebar
 type NavItem struct {
 	label     string
-	stat      string      // Optional stat to display on the right (e.g., "2", "1.54m/hr", "23% ↑2%")
+	stat      string      // Optional stat to display on the right (e.g., "2", "1.5M/hr", "23% ↑2%")
 	statColor color.Color // Color for the stat (theme.Error for red, nil for default theme.Field)
 	active    bool
 	indicator bool        // If true, shows a red dot (e.g., for unread messages or new activity)
@@ -1,63 +1,100 @@
 package sidebar
 
 import (
-	"fmt"
+	"image/color"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/v2/key"
 	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/usetero/cli/internal/format"
 	"github.com/usetero/cli/internal/log"
 	"github.com/usetero/cli/internal/tui/components/logo"
 	"github.com/usetero/cli/internal/tui/styles"
+	"github.com/usetero/cli/internal/version"
 )
 
 const diag = `╱`
 
+// wasteGoal is the waste percentage we consider healthy; above it, waste shows in red
+const wasteGoal = 10
+
+// Page identifies which navigation item is active
+type Page int
+
+const (
+	PageChat Page = iota
+	PageServices
+)
+
+// Summary is the account data shown in the sidebar
+type Summary struct {
+	OrgName       string
+	ServicesCount int
+	LogsPerHour   float64
+	WastePercent  float64 // 0-100, over the last week
+	WasteTrend    float64 // Percentage points, last day vs the week
+	SavedPercent  float64 // 0-100, over the last week
+}
+
 // Component represents the chat sidebar
 type Component struct {
 	width  int
 	height int
 	logger log.Logger
 
-	// Context information
-	orgName string
-
-	// Catalog stats (TODO: these will come from the control plane)
-	servicesCount int
-	logsRate      string // e.g. "1.54m/hr"
-
-	// Quality stats
-	wastePercent int
-	wasteTrend   string // e.g. "↑2%" or "↓4%"
-	savedAmount  string // e.g. "$847k/yr"
-
-	// Contracts stats
-	renewalDays   int    // Days until renewal (negative means days remaining)
-	renewalAmount string // e.g. "$2.3m"
+	// Account data (nil until the first load completes)
+	summary *Summary
+	loading bool
+	err     error // Last load error; a stale summary is kept and shown
 
 	// User info
 	userName  string
 	userEmail string
+
+	// Navigation
+	active Page
 }
 
-// New creates a new sidebar component
+// New creates a new sidebar component.
+// It starts in the loading state until SetSummary or SetError is called.
 func New(logger log.Logger) Component {
 	return Component{
-		logger: logger,
-		// TODO: These will be passed in from the chat page / control plane
-		orgName:       "Acme Corp",
-		servicesCount: 2,
-		logsRate:      "1.54m/hr",
-		wastePercent:  23,
-		wasteTrend:    "↑2%",
-		savedAmount:   "$847k/yr",
-		renewalDays:   -23,
-		renewalAmount: "$2.3m",
-		userName:      "Ben Johnson",
-		userEmail:     "ben@acme.com",
+		logger:  logger,
+		loading: true,
 	}
 }
 
+// SetSummary sets the account data and clears any loading or error state
+func (c *Component) SetSummary(summary Summary) {
+	c.summary = &summary
+	c.loading = false
+	c.err = nil
+}
+
+// SetLoading sets whether account data is being loaded
+func (c *Component) SetLoading(loading bool) {
+	c.loading = loading
+}
+
+// SetError records a failed load. Previously loaded data stays visible.
+func (c *Component) SetError(err error) {
+	c.err = err
+	c.loading = false
+}
+
+// SetActive highlights the navigation item for the current page
+func (c *Component) SetActive(page Page) {
+	c.active = page
+}
+
+// SetUser sets the signed-in user shown under the org name
+func (c *Component) SetUser(name, email string) {
+	c.userName = name
+	c.userEmail = email
+}
+
 // SetSize sets the dimensions for the sidebar
 func (c *Component) SetSize(width, height int) {
 	c.width = width
@@ -107,7 +144,7 @@ func (c *Component) Render() string {
 	// Version text (right-aligned on same line as last logo line)
 	versionText := lipgloss.NewStyle().
 		Foreground(theme.Field).
-		Render("v0.0.1")
+		Render("v" + version.Version)
 
 	// Add version to the last line of the logo
 	if len(logoLines) > 0 {
@@ -129,11 +166,10 @@ func (c *Component) Render() string {
 	// Section headers (no header for org name - it's self-explanatory)
 	navigationHeader := c.renderSection("Navigation", theme)
 	catalogHeader := c.renderSection("Catalog", theme)
-	contractsHeader := c.renderSection("Contracts", theme)
+	impactHeader := c.renderSection("Impact", theme)
 
 	// Org/Account section (no header, just the name)
-	orgStyle := lipgloss.NewStyle().Foreground(theme.Text)
-	orgName := orgStyle.Render(c.orgName)
+	orgName := c.renderOrgName(theme)
 	// TODO: Add accountName and workspace if > 1
 
 	// User info (right under org)
@@ -161,26 +197,33 @@ func (c *Component) Render() string {
 		key.WithKeys("alt+5"),
 		key.WithHelp("⌥5", "Saved"),
 	)
-	renewalKey := key.NewBinding(
-		key.WithKeys("alt+6"),
-		key.WithHelp("⌥6", "DD Renewal"),
-	)
+
+	// Stats show a placeholder until the first load completes
+	servicesStat, logsStat, wasteStat, savedStat := c.placeholder(), c.placeholder(), c.placeholder(), c.placeholder()
+	var wasteColor color.Color
+	wasteRising := false
+	if c.summary != nil {
+		servicesStat = strconv.Itoa(c.summary.ServicesCount)
+		logsStat = format.Count(c.summary.LogsPerHour) + "/hr"
+		wasteStat = format.Percent(c.summary.WastePercent, 0) + formatTrend(c.summary.WasteTrend)
+		savedStat = format.Percent(c.summary.SavedPercent, 0)
+		wasteRising = c.summary.WasteTrend >= 0.5
+		if c.summary.WastePercent > wasteGoal {
+			wasteColor = theme.Error
+		}
+	}
 
 	// Navigation section - Chat only
-	chatItem := NewNavItem("Chat", "", nil, true, false, chatKey) // Active, no indicator
+	chatItem := NewNavItem("Chat", "", nil, c.active == PageChat, false, chatKey)
 
 	// Catalog section - Services, Logs, Waste
-	servicesItem := NewNavItem("Services", fmt.Sprintf("%d", c.servicesCount), nil, false, false, servicesKey)
-	logsItem := NewNavItem("Logs", c.logsRate, nil, false, false, logsKey)
-	// Waste is red because 23% is over the typical 10% goal (in reality, this would come from control plane)
-	// The 'w' suffix indicates week-over-week change
-	wasteItem := NewNavItem("Waste", fmt.Sprintf("%d%% %sw", c.wastePercent, c.wasteTrend), theme.Error, false, true, wasteKey) // Show indicator for demo
-
-	// Contracts section - Saved, DD Renewal
-	// Saved is green because it's a positive outcome (money saved)
-	savedItem := NewNavItem("Saved", c.savedAmount, theme.Success, false, false, savedKey)
-	// Renewal date is red because -23d means renewing 23 days early (bad)
-	renewalItem := NewNavItem("DD Renewal", fmt.Sprintf("%dd, %s", c.renewalDays, c.renewalAmount), theme.Error, false, false, renewalKey)
+	servicesItem := NewNavItem("Services", servicesStat, nil, c.active == PageServices, false, servicesKey)
+	logsItem := NewNavItem("Logs", logsStat, nil, false, false, logsKey)
+	// Waste is red when over the goal; the indicator means waste grew in the last day
+	wasteItem := NewNavItem("Waste", wasteStat, wasteColor, false, wasteRising, wasteKey)
+
+	// Impact section - Saved is green because it's a positive outcome (volume already filtered)
+	savedItem := NewNavItem("Saved", savedStat, theme.Success, false, false, savedKey)
 
 	// All content
 	content := lipgloss.JoinVertical(
@@ -191,8 +234,8 @@ func (c *Component) Render() string {
 		logoWithVersion,
 		"",
 		orgName,
-		userNameStyle.Render(c.userName),
-		userEmailStyle.Render(c.userEmail),
+		userNameStyle.Render(truncate(c.userName, c.width)),
+		userEmailStyle.Render(truncate(c.userEmail, c.width)),
 		"",
 		navigationHeader,
 		"",
@@ -204,11 +247,65 @@ func (c *Component) Render() string {
 		logsItem.Render(c.width, theme),
 		wasteItem.Render(c.width, theme),
 		"",
-		contractsHeader,
+		impactHeader,
 		"",
 		savedItem.Render(c.width, theme),
-		renewalItem.Render(c.width, theme),
+		"",
+		c.renderStatus(theme),
 	)
 
 	return style.Render(content)
 }
+
+// renderOrgName renders the org name, or the loading/error state before the first load
+func (c *Component) renderOrgName(theme *styles.Theme) string {
+	switch {
+	case c.summary != nil:
+		return lipgloss.NewStyle().Foreground(theme.Text).Render(truncate(c.summary.OrgName, c.width))
+	case c.err != nil && !c.loading:
+		return lipgloss.NewStyle().Foreground(theme.Error).Render("Couldn't load account")
+	default:
+		return lipgloss.NewStyle().Foreground(theme.TextMuted).Render("Loading account…")
+	}
+}
+
+// renderStatus renders a note while shown data is being refreshed, or when
+// it's stale because the last refresh failed
+func (c *Component) renderStatus(theme *styles.Theme) string {
+	switch {
+	case c.summary == nil:
+		return ""
+	case c.loading:
+		return lipgloss.NewStyle().Foreground(theme.TextMuted).Render("Refreshing…")
+	case c.err != nil:
+		return lipgloss.NewStyle().Foreground(theme.Warning).Render("Couldn't refresh, retrying")
+	default:
+		return ""
+	}
+}
+
+// placeholder returns the stat shown before data has loaded
+func (c *Component) placeholder() string {
+	if c.err != nil && !c.loading {
+		return "—"
+	}
+	return "…"
+}
+
+// formatTrend formats a change in percentage points, e.g. " ↑2%".
+// Changes under half a point are too small to show.
+func formatTrend(points float64) string {
+	switch {
+	case points >= 0.5:
+		return " ↑" + format.Percent(points, 0)
+	case points <= -0.5:
+		return " ↓" + format.Percent(-points, 0)
+	default:
+		return ""
+	}
+}
+
+// truncate shortens s to fit width, adding an ellipsis if it was cut
+func truncate(s string, width int) string {
+	return ansi.Truncate(s, width, "…")
+}
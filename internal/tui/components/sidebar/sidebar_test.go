@@ -0,0 +1,89 @@
+package sidebar
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/usetero/cli/internal/log/logtest"
+)
+
+func TestComponent_RenderStatus(t *testing.T) {
+	summary := Summary{OrgName: "Acme", ServicesCount: 3, LogsPerHour: 1_540_000}
+	loadErr := errors.New("connection refused")
+
+	tests := []struct {
+		name    string
+		setup   func(c *Component)
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "first load",
+			setup:   func(c *Component) {},
+			want:    []string{"Loading account…"},
+			notWant: []string{"Refreshing…"},
+		},
+		{
+			name:    "loaded",
+			setup:   func(c *Component) { c.SetSummary(summary) },
+			want:    []string{"Acme", "1.5M/hr"},
+			notWant: []string{"Refreshing…", "Couldn't refresh"},
+		},
+		{
+			name: "refreshing",
+			setup: func(c *Component) {
+				c.SetSummary(summary)
+				c.SetLoading(true)
+			},
+			want:    []string{"Acme", "Refreshing…"},
+			notWant: []string{"Couldn't refresh"},
+		},
+		{
+			name: "refresh failed",
+			setup: func(c *Component) {
+				c.SetSummary(summary)
+				c.SetLoading(true)
+				c.SetError(loadErr)
+			},
+			want:    []string{"Acme", "Couldn't refresh, retrying"},
+			notWant: []string{"Refreshing…"},
+		},
+		{
+			name:    "first load failed",
+			setup:   func(c *Component) { c.SetError(loadErr) },
+			want:    []string{"Couldn't load account"},
+			notWant: []string{"Loading account…"},
+		},
+		{
+			name: "retrying first load",
+			setup: func(c *Component) {
+				c.SetError(loadErr)
+				c.SetLoading(true)
+			},
+			want:    []string{"Loading account…"},
+			notWant: []string{"Couldn't load account"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(logtest.New(t))
+			c.SetSize(40, 60)
+			tt.setup(&c)
+
+			view := ansi.Strip(c.Render())
+			for _, s := range tt.want {
+				if !strings.Contains(view, s) {
+					t.Errorf("view missing %q:\n%s", s, view)
+				}
+			}
+			for _, s := range tt.notWant {
+				if strings.Contains(view, s) {
+					t.Errorf("view shouldn't contain %q:\n%s", s, view)
+				}
+			}
+		})
+	}
+}
@@ -29,9 +29,11 @@ func New(columns []Column) *Table {
 		table.WithFocused(false), // No cursor by default
 	)
 
-	// Apply theme-aware styles
+	// Apply theme-aware styles. Cells are padded by one on each side so
+	// adjacent columns never run together.
 	t.SetStyles(table.Styles{
 		Header: lipgloss.NewStyle().
+			Padding(0, 1).
 			Bold(true).
 			Foreground(theme.Primary).
 			BorderStyle(lipgloss.NormalBorder()).
@@ -41,6 +43,7 @@ func New(columns []Column) *Table {
 			Foreground(theme.Primary).
 			Bold(true),
 		Cell: lipgloss.NewStyle().
+			Padding(0, 1).
 			Foreground(theme.Text),
 	})
 
@@ -82,6 +85,21 @@ func (c *Table) Rows() []Row {
 	return c.table.Rows()
 }
 
+// Cursor returns the index of the selected row
+func (c *Table) Cursor() int {
+	return c.table.Cursor()
+}
+
+// SetCursor moves the selection to the row at index n
+func (c *Table) SetCursor(n int) {
+	c.table.SetCursor(n)
+}
+
+// SetColumns replaces the table's columns
+func (c *Table) SetColumns(columns []Column) {
+	c.table.SetColumns(columns)
+}
+
 // SetWidth sets the table width
 func (c *Table) SetWidth(width int) {
 	c.table.SetWidth(width)
@@ -54,6 +54,12 @@ func (h *Header) SetKeyBindings(bindings []key.Binding) {
 	h.base.SetKeyBindings(bindings)
 }
 
+// SetBreadcrumb sets the progress text shown under the header, e.g.
+// "Step 2 of 6 · Role". Empty hides it.
+func (h *Header) SetBreadcrumb(text string) {
+	h.header.SetBreadcrumb(text)
+}
+
 // SetError sets the error to display in the footer
 func (h *Header) SetError(err error) {
 	h.base.SetError(err)
@@ -63,6 +63,31 @@ func (s *Sidebar) SetError(err error) {
 	s.base.SetError(err)
 }
 
+// SetSummary sets the account data shown in the sidebar
+func (s *Sidebar) SetSummary(summary sidebar.Summary) {
+	s.sidebar.SetSummary(summary)
+}
+
+// SetSummaryLoading sets whether the sidebar's account data is loading
+func (s *Sidebar) SetSummaryLoading(loading bool) {
+	s.sidebar.SetLoading(loading)
+}
+
+// SetSummaryError records a failed load of the sidebar's account data
+func (s *Sidebar) SetSummaryError(err error) {
+	s.sidebar.SetError(err)
+}
+
+// SetActive highlights the sidebar navigation item for the current page
+func (s *Sidebar) SetActive(page sidebar.Page) {
+	s.sidebar.SetActive(page)
+}
+
+// SetUser sets the signed-in user shown in the sidebar
+func (s *Sidebar) SetUser(name, email string) {
+	s.sidebar.SetUser(name, email)
+}
+
 // ContentSize returns the available space for content (width x height after sidebar and footer)
 func (s *Sidebar) ContentSize() (int, int) {
 	if s.width == 0 || s.height == 0 {
@@ -70,10 +95,10 @@ func (s *Sidebar) ContentSize() (int, int) {
 	}
 
 	// Base handles footer and padding calculation
-	_, baseContentHeight := s.base.ContentSize()
+	baseContentWidth, baseContentHeight := s.base.ContentSize()
 
 	// Sidebar takes fixed width from the left
-	contentWidth := s.width - SidebarWidth
+	contentWidth := baseContentWidth - SidebarWidth
 
 	return contentWidth, baseContentHeight
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/lipgloss/v2"
 	"github.com/usetero/cli/internal/api"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/components/input"
 	"github.com/usetero/cli/internal/tui/keymap"
 	"github.com/usetero/cli/internal/tui/onboarding/datadog"
@@ -216,6 +217,26 @@ func (s *CreateStep) IsComplete() bool {
 	return s.created && s.err == nil
 }
 
+// Stage returns the step's part of onboarding
+func (s *CreateStep) Stage() step.Stage {
+	return step.StageAccount
+}
+
+// Commits returns true - going back past a created account would create another
+func (s *CreateStep) Commits() bool {
+	return true
+}
+
+// Checkpoint returns the created account
+func (s *CreateStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	return preferences.OnboardingProgress{
+		Step:      step.CheckpointAccount,
+		Role:      s.role,
+		OrgID:     s.orgID,
+		AccountID: s.CreatedAccountID(),
+	}, true
+}
+
 // CreatedAccountID returns the ID of the created account
 func (s *CreateStep) CreatedAccountID() string {
 	if s.createdAccount != nil {
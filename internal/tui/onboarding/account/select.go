@@ -11,6 +11,7 @@ import (
 	"github.com/charmbracelet/lipgloss/v2"
 	"github.com/usetero/cli/internal/api"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/components/list"
 	"github.com/usetero/cli/internal/tui/components/remotelist"
 	"github.com/usetero/cli/internal/tui/keymap"
@@ -23,7 +24,7 @@ const createNewAccountID = "__CREATE_NEW__"
 
 // AccountLister lists accounts
 type AccountLister interface {
-	List(ctx context.Context, orgID string) ([]api.Account, error)
+	List(ctx context.Context, orgID string, opts api.ListOptions) ([]api.Account, error)
 }
 
 // accountItem implements list.Item for the list component
@@ -122,7 +123,7 @@ func (s *SelectStep) Init() tea.Cmd {
 		ctx := context.Background()
 
 		s.logger.Info("loading accounts", "organizationID", s.orgID)
-		accounts, err := s.accountLister.List(ctx, s.orgID)
+		accounts, err := s.accountLister.List(ctx, s.orgID, api.ListOptions{})
 		if err != nil {
 			s.logger.Error("failed to load accounts", "error", err, "organizationID", s.orgID)
 			return remotelist.LoadResultMsg{Items: nil, Err: err}
@@ -257,6 +258,31 @@ func (s *SelectStep) IsComplete() bool {
 	return s.selectedAccountID != ""
 }
 
+// Stage returns the step's part of onboarding
+func (s *SelectStep) Stage() step.Stage {
+	return step.StageAccount
+}
+
+// Revisit clears the selection so the user can pick another account
+func (s *SelectStep) Revisit() tea.Cmd {
+	s.selectedAccountID = ""
+	return nil
+}
+
+// Checkpoint returns the selected account. Creating one isn't a checkpoint;
+// CreateStep saves progress once it's created.
+func (s *SelectStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	if s.IsCreateSelected() {
+		return preferences.OnboardingProgress{}, false
+	}
+	return preferences.OnboardingProgress{
+		Step:      step.CheckpointAccount,
+		Role:      s.role,
+		OrgID:     s.orgID,
+		AccountID: s.SelectedAccountID(),
+	}, true
+}
+
 // IsCreateSelected returns true if user chose to create a new account
 func (s *SelectStep) IsCreateSelected() bool {
 	return s.selectedAccountID == createNewAccountID
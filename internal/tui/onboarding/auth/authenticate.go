@@ -15,7 +15,7 @@ import (
 	"github.com/usetero/cli/internal/log"
 	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/keymap"
-	"github.com/usetero/cli/internal/tui/onboarding/role"
+	"github.com/usetero/cli/internal/tui/onboarding/resume"
 	"github.com/usetero/cli/internal/tui/onboarding/step"
 	"github.com/usetero/cli/internal/tui/styles"
 	"github.com/usetero/cli/pkg/client"
@@ -27,6 +27,7 @@ type Authenticator interface {
 	IsAuthenticated() bool
 	StartDeviceAuth(ctx context.Context) (*authservice.DeviceAuth, error)
 	WaitForAuth(ctx context.Context, deviceCode string, interval time.Duration) (*authservice.Result, error)
+	RefreshAccessToken(ctx context.Context) (string, error)
 }
 
 // authState tracks the current state of the authentication flow
@@ -45,7 +46,7 @@ type AuthenticateStep struct {
 
 	// Pass-through to next step
 	preferencesService *preferences.Service
-	apiEndpoint        string
+	clients            *client.Factory
 	logger             log.Logger
 	globalBindings     []key.Binding
 
@@ -74,7 +75,7 @@ type authCompleteMsg struct {
 }
 
 // NewAuthenticateStep creates a new authentication step
-func NewAuthenticateStep(logger log.Logger, authenticator Authenticator, preferencesService *preferences.Service, apiEndpoint string, globalBindings []key.Binding) step.Step {
+func NewAuthenticateStep(logger log.Logger, authenticator Authenticator, preferencesService *preferences.Service, clients *client.Factory, globalBindings []key.Binding) step.Step {
 	if logger == nil {
 		panic("logger cannot be nil")
 	}
@@ -84,6 +85,9 @@ func NewAuthenticateStep(logger log.Logger, authenticator Authenticator, prefere
 	if preferencesService == nil {
 		panic("preferencesService cannot be nil")
 	}
+	if clients == nil {
+		panic("clients cannot be nil")
+	}
 
 	theme := styles.CurrentTheme()
 
@@ -94,7 +98,7 @@ func NewAuthenticateStep(logger log.Logger, authenticator Authenticator, prefere
 	return &AuthenticateStep{
 		authenticator:      authenticator,
 		preferencesService: preferencesService,
-		apiEndpoint:        apiEndpoint,
+		clients:            clients,
 		logger:             logger,
 		globalBindings:     globalBindings,
 		state:              stateInitializing,
@@ -154,6 +158,14 @@ func (s *AuthenticateStep) Update(msg tea.Msg) (step.Step, tea.Cmd) {
 		s.authResult = msg.result
 		s.state = stateComplete
 		s.logger.Info("authentication complete", "user_email", s.authResult.User.Email)
+
+		// Remember who signed in so the app can show it without another lookup
+		if err := s.preferencesService.SetEmail(s.authResult.User.Email); err != nil {
+			s.logger.Warn("failed to save user email", "error", err)
+		}
+		if err := s.preferencesService.SetUserName(s.authResult.User.Name()); err != nil {
+			s.logger.Warn("failed to save user name", "error", err)
+		}
 		return s, nil
 
 	case spinner.TickMsg:
@@ -298,6 +310,11 @@ func (s *AuthenticateStep) IsComplete() bool {
 	return s.state == stateComplete
 }
 
+// Stage returns the step's part of onboarding
+func (s *AuthenticateStep) Stage() step.Stage {
+	return step.StageSignIn
+}
+
 // IsBusy returns true while waiting for authentication
 func (s *AuthenticateStep) IsBusy() bool {
 	return s.state == stateInitializing || s.polling
@@ -347,8 +364,8 @@ func (s *AuthenticateStep) Help() help.KeyMap {
 // Creates an authenticated API client and passes it to the role step
 func (s *AuthenticateStep) Next() step.Step {
 	// Create authenticated API client with the access token from auth result
-	apiClient := client.New(s.apiEndpoint, s.authResult.AccessToken)
+	apiClient := s.clients.New(s.authResult.AccessToken, client.WithTokenRefresher(s.authenticator))
 
 	// Pass authenticated client, preferences service, and other dependencies to next step
-	return role.NewSelectStep(apiClient, s.preferencesService, s.logger, s.globalBindings)
+	return resume.Step(apiClient, s.preferencesService, s.logger, s.globalBindings)
 }
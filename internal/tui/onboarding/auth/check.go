@@ -10,7 +10,7 @@ import (
 	"github.com/usetero/cli/internal/log"
 	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/keymap"
-	"github.com/usetero/cli/internal/tui/onboarding/role"
+	"github.com/usetero/cli/internal/tui/onboarding/resume"
 	"github.com/usetero/cli/internal/tui/onboarding/step"
 	"github.com/usetero/cli/internal/tui/styles"
 	"github.com/usetero/cli/pkg/client"
@@ -20,6 +20,7 @@ import (
 type TokenValidator interface {
 	IsAuthenticated() bool
 	GetAccessToken(ctx context.Context) (string, error)
+	RefreshSession(ctx context.Context) (*authservice.Result, error)
 	ClearTokens() error
 }
 
@@ -31,7 +32,7 @@ type CheckAuthStep struct {
 
 	// Pass-through to next step
 	preferencesService *preferences.Service
-	apiEndpoint        string
+	clients            *client.Factory
 	logger             log.Logger
 	globalBindings     []key.Binding
 
@@ -45,7 +46,7 @@ type CheckAuthStep struct {
 }
 
 // NewCheckAuthStep creates a new auth check step
-func NewCheckAuthStep(tokenValidator TokenValidator, authService *authservice.Service, preferencesService *preferences.Service, apiEndpoint string, logger log.Logger, globalBindings []key.Binding) step.Step {
+func NewCheckAuthStep(tokenValidator TokenValidator, authService *authservice.Service, preferencesService *preferences.Service, clients *client.Factory, logger log.Logger, globalBindings []key.Binding) step.Step {
 	if tokenValidator == nil {
 		panic("tokenValidator cannot be nil")
 	}
@@ -55,6 +56,9 @@ func NewCheckAuthStep(tokenValidator TokenValidator, authService *authservice.Se
 	if preferencesService == nil {
 		panic("preferencesService cannot be nil")
 	}
+	if clients == nil {
+		panic("clients cannot be nil")
+	}
 	if logger == nil {
 		panic("logger cannot be nil")
 	}
@@ -63,7 +67,7 @@ func NewCheckAuthStep(tokenValidator TokenValidator, authService *authservice.Se
 		tokenValidator:     tokenValidator,
 		authService:        authService,
 		preferencesService: preferencesService,
-		apiEndpoint:        apiEndpoint,
+		clients:            clients,
 		logger:             logger,
 		globalBindings:     globalBindings,
 		width:              80,
@@ -74,6 +78,7 @@ func NewCheckAuthStep(tokenValidator TokenValidator, authService *authservice.Se
 type checkAuthMsg struct {
 	hasValidAuth bool
 	accessToken  string
+	user         *authservice.User // Set when the session was refreshed to learn who's signed in
 	err          error
 }
 
@@ -102,8 +107,22 @@ func (s *CheckAuthStep) checkAuth() tea.Cmd {
 			return checkAuthMsg{hasValidAuth: false}
 		}
 
-		// TODO: Optionally validate token with API
-		// For now, just check if it exists
+		// Sessions from before the user was saved at sign-in don't know who's
+		// signed in. Refreshing validates the session and returns the user.
+		if s.preferencesService.GetEmail() == "" {
+			result, err := s.tokenValidator.RefreshSession(ctx)
+			if err != nil {
+				if !s.tokenValidator.IsAuthenticated() {
+					// The refresh token was rejected and the tokens cleared
+					return checkAuthMsg{hasValidAuth: false}
+				}
+				// Not fatal - the stored token still works, the sidebar just can't show who's signed in
+				s.logger.Warn("failed to refresh session to load the signed-in user", "error", err)
+				return checkAuthMsg{hasValidAuth: true, accessToken: accessToken}
+			}
+			return checkAuthMsg{hasValidAuth: true, accessToken: result.AccessToken, user: &result.User}
+		}
+
 		return checkAuthMsg{hasValidAuth: true, accessToken: accessToken}
 	}
 }
@@ -124,6 +143,9 @@ func (s *CheckAuthStep) Update(msg tea.Msg) (step.Step, tea.Cmd) {
 		s.checked = true
 		s.hasValidAuth = msg.hasValidAuth
 		s.accessToken = msg.accessToken
+		if msg.user != nil {
+			s.saveUser(*msg.user)
+		}
 
 		if s.hasValidAuth {
 			s.logger.Info("valid authentication found")
@@ -148,6 +170,16 @@ func (s *CheckAuthStep) Update(msg tea.Msg) (step.Step, tea.Cmd) {
 	return s, nil
 }
 
+// saveUser remembers who's signed in so the app can show it
+func (s *CheckAuthStep) saveUser(user authservice.User) {
+	if err := s.preferencesService.SetEmail(user.Email); err != nil {
+		s.logger.Warn("failed to save user email", "error", err)
+	}
+	if err := s.preferencesService.SetUserName(user.Name()); err != nil {
+		s.logger.Warn("failed to save user name", "error", err)
+	}
+}
+
 // View renders the check UI
 func (s *CheckAuthStep) View() string {
 	common := styles.Common()
@@ -173,6 +205,11 @@ func (s *CheckAuthStep) IsComplete() bool {
 	return s.checked && s.err == nil
 }
 
+// Stage returns the step's part of onboarding
+func (s *CheckAuthStep) Stage() step.Stage {
+	return step.StageSignIn
+}
+
 // NeedsAuth returns true if user needs to authenticate
 func (s *CheckAuthStep) NeedsAuth() bool {
 	return s.checked && !s.hasValidAuth
@@ -197,12 +234,12 @@ func (s *CheckAuthStep) Error() error {
 func (s *CheckAuthStep) Next() step.Step {
 	if s.NeedsAuth() {
 		// No valid auth - go to auth step
-		return NewAuthenticateStep(s.logger, s.authService, s.preferencesService, s.apiEndpoint, s.globalBindings)
+		return NewAuthenticateStep(s.logger, s.authService, s.preferencesService, s.clients, s.globalBindings)
 	}
 
-	// Has valid auth - create authenticated client and go to role selection
-	apiClient := client.New(s.apiEndpoint, s.accessToken)
-	return role.NewSelectStep(apiClient, s.preferencesService, s.logger, s.globalBindings)
+	// Has valid auth - create authenticated client and resume onboarding
+	apiClient := s.clients.New(s.accessToken, client.WithTokenRefresher(s.authService))
+	return resume.Step(apiClient, s.preferencesService, s.logger, s.globalBindings)
 }
 
 // Help returns the key bindings for this step
@@ -15,9 +15,16 @@ import (
 type CompleteStep struct {
 	logger         log.Logger
 	width          int
+	done           bool
 	globalBindings []key.Binding
 }
 
+// continueBinding moves on from onboarding into the app
+var continueBinding = key.NewBinding(
+	key.WithKeys("enter"),
+	key.WithHelp("enter", "continue"),
+)
+
 // NewCompleteStep creates a new completion step
 func NewCompleteStep(logger log.Logger, globalBindings []key.Binding) step.Step {
 	if logger == nil {
@@ -39,6 +46,10 @@ func (s *CompleteStep) Init() tea.Cmd {
 
 // Update handles messages
 func (s *CompleteStep) Update(msg tea.Msg) (step.Step, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyPressMsg); ok && key.Matches(msg, continueBinding) {
+		s.logger.Info("continuing to app")
+		s.done = true
+	}
 	return s, nil
 }
 
@@ -57,6 +68,8 @@ func (s *CompleteStep) View() string {
 	contact := common.Help.Render("Questions in the meantime? Reach out: ") +
 		common.URL.Render("team@usetero.com")
 
+	next := common.Help.Render("Press enter to start chatting with Tero.")
+
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		title,
@@ -68,6 +81,8 @@ func (s *CompleteStep) View() string {
 		body4,
 		"",
 		contact,
+		"",
+		next,
 	)
 }
 
@@ -76,9 +91,9 @@ func (s *CompleteStep) SetSize(width, height int) {
 	s.width = width
 }
 
-// IsComplete returns false - this step stays visible
+// IsComplete returns true once the user continues to the app
 func (s *CompleteStep) IsComplete() bool {
-	return false
+	return s.done
 }
 
 // IsBusy returns false - no background work
@@ -101,7 +116,7 @@ func (s *CompleteStep) Next() step.Step {
 	return nil
 }
 
-// Help returns empty key bindings - no actions available
+// Help returns the continue binding
 func (s *CompleteStep) Help() help.KeyMap {
-	return keymap.Simple{Keys: []key.Binding{}}
+	return keymap.Simple{Keys: []key.Binding{continueBinding}}
 }
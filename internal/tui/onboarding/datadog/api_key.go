@@ -15,6 +15,7 @@ import (
 	"github.com/usetero/cli/internal/api"
 	ddvendor "github.com/usetero/cli/internal/datadog"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/components/input"
 	"github.com/usetero/cli/internal/tui/keymap"
 	"github.com/usetero/cli/internal/tui/onboarding/step"
@@ -318,6 +319,35 @@ func (s *APIKeyStep) IsComplete() bool {
 	return s.validated && s.validatedKey != ""
 }
 
+// Stage returns the step's part of onboarding
+func (s *APIKeyStep) Stage() step.Stage {
+	return step.StageDatadog
+}
+
+// Revisit discards the validated key so the user can enter another
+func (s *APIKeyStep) Revisit() tea.Cmd {
+	s.validated = false
+	s.validatedKey = ""
+	s.validationErr = nil
+	if s.showingInput {
+		return s.input.Focus()
+	}
+	return nil
+}
+
+// Checkpoint returns the validated API key, so a restart can go straight to
+// the app key
+func (s *APIKeyStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	return preferences.OnboardingProgress{
+		Step:          step.CheckpointDatadogAPIKey,
+		Role:          s.role,
+		OrgID:         s.orgID,
+		AccountID:     s.accountID,
+		DatadogSite:   s.site,
+		DatadogAPIKey: s.validatedKey,
+	}, true
+}
+
 // IsBusy returns true while validating
 func (s *APIKeyStep) IsBusy() bool {
 	return s.validating
@@ -13,6 +13,7 @@ import (
 	"github.com/usetero/cli/internal/api"
 	ddvendor "github.com/usetero/cli/internal/datadog"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/components/input"
 	"github.com/usetero/cli/internal/tui/keymap"
 	"github.com/usetero/cli/internal/tui/onboarding/services"
@@ -290,6 +291,28 @@ func (s *AppKeyStep) IsComplete() bool {
 	return s.created && s.createdAccount != nil && s.err == nil
 }
 
+// Stage returns the step's part of onboarding
+func (s *AppKeyStep) Stage() step.Stage {
+	return step.StageDatadog
+}
+
+// Commits returns true - going back past a created Datadog account would create another
+func (s *AppKeyStep) Commits() bool {
+	return true
+}
+
+// Checkpoint returns the created Datadog account
+func (s *AppKeyStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	return preferences.OnboardingProgress{
+		Step:             step.CheckpointDatadogAccount,
+		Role:             s.role,
+		OrgID:            s.orgID,
+		AccountID:        s.accountID,
+		DatadogSite:      s.site,
+		DatadogAccountID: s.createdAccount.ID,
+	}, true
+}
+
 // IsBusy returns true while creating the account
 func (s *AppKeyStep) IsBusy() bool {
 	return s.creating
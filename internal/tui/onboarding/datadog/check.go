@@ -8,6 +8,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/usetero/cli/internal/api"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/keymap"
 	"github.com/usetero/cli/internal/tui/onboarding/services"
 	"github.com/usetero/cli/internal/tui/onboarding/step"
@@ -170,6 +171,26 @@ func (s *CheckDatadogStep) IsComplete() bool {
 	return s.checked && s.err == nil
 }
 
+// Stage returns the step's part of onboarding
+func (s *CheckDatadogStep) Stage() step.Stage {
+	return step.StageDatadog
+}
+
+// Checkpoint returns the account's existing Datadog account. Without one
+// there's nothing new to save, so onboarding resumes by checking again.
+func (s *CheckDatadogStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	if s.NeedsDatadogSetup() {
+		return preferences.OnboardingProgress{}, false
+	}
+	return preferences.OnboardingProgress{
+		Step:             step.CheckpointDatadogAccount,
+		Role:             s.role,
+		OrgID:            s.orgID,
+		AccountID:        s.accountID,
+		DatadogAccountID: s.datadogAccount.ID,
+	}, true
+}
+
 // NeedsDatadogSetup returns true if account doesn't have Datadog configured
 func (s *CheckDatadogStep) NeedsDatadogSetup() bool {
 	return s.checked && !s.hasDatadog
@@ -12,6 +12,7 @@ import (
 	"github.com/usetero/cli/internal/api"
 	"github.com/usetero/cli/internal/datadog"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/components/list"
 	"github.com/usetero/cli/internal/tui/keymap"
 	"github.com/usetero/cli/internal/tui/onboarding/step"
@@ -175,6 +176,28 @@ func (s *SelectRegionStep) IsComplete() bool {
 	return s.selectedRegion != ""
 }
 
+// Stage returns the step's part of onboarding
+func (s *SelectRegionStep) Stage() step.Stage {
+	return step.StageDatadog
+}
+
+// Revisit clears the selection so the user can pick another region
+func (s *SelectRegionStep) Revisit() tea.Cmd {
+	s.selectedRegion = ""
+	return nil
+}
+
+// Checkpoint returns the selected region
+func (s *SelectRegionStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	return preferences.OnboardingProgress{
+		Step:        step.CheckpointDatadogRegion,
+		Role:        s.role,
+		OrgID:       s.orgID,
+		AccountID:   s.accountID,
+		DatadogSite: s.selectedRegion,
+	}, true
+}
+
 // SelectedRegion returns the selected Datadog region site identifier
 func (s *SelectRegionStep) SelectedRegion() string {
 	return s.selectedRegion
@@ -11,7 +11,9 @@ import (
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/charmbracelet/lipgloss/v2"
 	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/format"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/components/progress"
 	"github.com/usetero/cli/internal/tui/keymap"
 	"github.com/usetero/cli/internal/tui/onboarding/complete"
@@ -313,19 +315,7 @@ func (s *DiscoveryStep) formatLogCounts() string {
 	discovered := int64(s.discoveredWeeklyVolume)
 	total := s.weeklyVolume
 
-	return fmt.Sprintf("Analyzed %s / %s logs", formatVolume(discovered), formatVolume(total))
-}
-
-// formatVolume formats a volume number in a human-readable way (e.g., 4.6M, 7.6M)
-func formatVolume(volume int64) string {
-	if volume < 1000 {
-		return fmt.Sprintf("%d", volume)
-	} else if volume < 1000000 {
-		return fmt.Sprintf("%.1fK", float64(volume)/1000)
-	} else if volume < 1000000000 {
-		return fmt.Sprintf("%.1fM", float64(volume)/1000000)
-	}
-	return fmt.Sprintf("%.1fB", float64(volume)/1000000000)
+	return fmt.Sprintf("Analyzed %s / %s logs", format.Count(float64(discovered)), format.Count(float64(total)))
 }
 
 // SetSize sets the width and height available for rendering
@@ -338,6 +328,25 @@ func (s *DiscoveryStep) IsComplete() bool {
 	return s.isComplete()
 }
 
+// Stage returns the step's part of onboarding
+func (s *DiscoveryStep) Stage() step.Stage {
+	return step.StageDiscovery
+}
+
+// Checkpoint records that log events have been discovered
+func (s *DiscoveryStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	p := preferences.OnboardingProgress{
+		Step:      step.CheckpointLogEvents,
+		Role:      s.role,
+		OrgID:     s.orgID,
+		AccountID: s.accountID,
+	}
+	if s.datadogAccountID != nil {
+		p.DatadogAccountID = *s.datadogAccountID
+	}
+	return p, true
+}
+
 // IsBusy returns true while actively discovering
 func (s *DiscoveryStep) IsBusy() bool {
 	return !s.isComplete() && s.err == nil
@@ -1,6 +1,8 @@
 package onboarding
 
 import (
+	"fmt"
+
 	"github.com/charmbracelet/bubbles/v2/key"
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/charmbracelet/lipgloss/v2"
@@ -9,7 +11,9 @@ import (
 	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/layouts"
 	authcheck "github.com/usetero/cli/internal/tui/onboarding/auth"
+	"github.com/usetero/cli/internal/tui/onboarding/resume"
 	"github.com/usetero/cli/internal/tui/onboarding/step"
+	"github.com/usetero/cli/pkg/client"
 )
 
 // PreferencesReader reads onboarding completion state from preferences
@@ -18,6 +22,11 @@ type PreferencesReader interface {
 	GetDefaultAccountID() string
 }
 
+// BreadcrumbSetter is implemented by layouts that show progress through the flow
+type BreadcrumbSetter interface {
+	SetBreadcrumb(text string)
+}
+
 // Onboarding orchestrates the onboarding flow.
 // It manages the step-by-step progression through authentication,
 // role selection, organization setup, account setup, and datadog integration.
@@ -38,19 +47,27 @@ type Onboarding struct {
 	logger         log.Logger
 }
 
-// New creates a new onboarding model starting with auth
+// New creates a new onboarding model starting with auth.
+// With an API token, the auth steps are skipped and the token is used as-is.
 func New(
 	logger log.Logger,
 	authService *auth.Service,
 	preferencesService *preferences.Service,
-	apiEndpoint string,
+	clients *client.Factory,
+	apiToken string,
 	globalBindings []key.Binding,
 ) *Onboarding {
 	// Start onboarding flow with auth check step
 	// Check step validates existing auth, or proceeds to auth step if needed
-	flow := step.NewFlow(
-		authcheck.NewCheckAuthStep(authService, authService, preferencesService, apiEndpoint, logger, globalBindings),
-	)
+	first := authcheck.NewCheckAuthStep(authService, authService, preferencesService, clients, logger, globalBindings)
+	if apiToken != "" {
+		logger.Info("using API token, skipping sign-in")
+		first = resume.Step(clients.New(apiToken), preferencesService, logger, globalBindings)
+	}
+	flow := step.NewFlow(first)
+	flow.OnStepComplete(func(s step.Step) {
+		saveProgress(s, preferencesService, logger)
+	})
 
 	return &Onboarding{
 		flow:               flow,
@@ -62,6 +79,25 @@ func New(
 	}
 }
 
+// saveProgress saves the step's checkpoint, if it has one, so onboarding
+// resumes after it next time
+func saveProgress(s step.Step, preferencesService *preferences.Service, logger log.Logger) {
+	checkpointer, ok := s.(step.Checkpointer)
+	if !ok {
+		return
+	}
+	progress, ok := checkpointer.Checkpoint()
+	if !ok {
+		return
+	}
+	if err := preferencesService.SetOnboardingProgress(progress); err != nil {
+		// Not fatal - onboarding continues, it just can't resume from here
+		logger.Warn("failed to save onboarding progress", "checkpoint", progress.Step, "error", err)
+		return
+	}
+	logger.Debug("saved onboarding progress", "checkpoint", progress.Step)
+}
+
 // Init initializes the onboarding flow
 func (m *Onboarding) Init() tea.Cmd {
 	return m.flow.Init()
@@ -81,6 +117,11 @@ func (m *Onboarding) Update(msg tea.Msg) tea.Cmd {
 	// Pass error state to layout (always set, even if nil to clear previous errors)
 	m.layout.SetError(m.flow.Error())
 
+	// Show how far along the flow is
+	if layout, ok := m.layout.(BreadcrumbSetter); ok {
+		layout.SetBreadcrumb(m.breadcrumb())
+	}
+
 	// Cascade to layout
 	layoutCmd := m.layout.Update(msg)
 
@@ -96,6 +137,21 @@ func (m *Onboarding) Update(msg tea.Msg) tea.Cmd {
 	return tea.Batch(layoutCmd, flowCmd)
 }
 
+// breadcrumb returns "Step n of m · Stage" for the current step, or empty if
+// it isn't part of a stage
+func (m *Onboarding) breadcrumb() string {
+	stage, ok := m.flow.Stage()
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("Step %d of %d · %s", stage, step.StageCount, stage)
+}
+
+// CanGoBack returns true if esc goes back a step rather than quitting
+func (m *Onboarding) CanGoBack() bool {
+	return m.flow.CanGoBack()
+}
+
 // View renders the onboarding header + current step content
 func (m *Onboarding) View() string {
 	if !m.ready {
@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/lipgloss/v2"
 	"github.com/usetero/cli/internal/api"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/components/input"
 	"github.com/usetero/cli/internal/tui/keymap"
 	"github.com/usetero/cli/internal/tui/onboarding/datadog"
@@ -217,6 +218,26 @@ func (s *CreateStep) IsComplete() bool {
 	return s.created && s.err == nil
 }
 
+// Stage returns the step's part of onboarding
+func (s *CreateStep) Stage() step.Stage {
+	return step.StageOrganization
+}
+
+// Commits returns true - going back past a created organization would create another
+func (s *CreateStep) Commits() bool {
+	return true
+}
+
+// Checkpoint returns the created organization and its account
+func (s *CreateStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	return preferences.OnboardingProgress{
+		Step:      step.CheckpointAccount,
+		Role:      s.role,
+		OrgID:     s.CreatedOrgID(),
+		AccountID: s.CreatedAccountID(),
+	}, true
+}
+
 // CreatedOrgID returns the ID of the created organization
 func (s *CreateStep) CreatedOrgID() string {
 	if s.createdResult != nil {
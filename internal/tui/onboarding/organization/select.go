@@ -11,6 +11,7 @@ import (
 	"github.com/charmbracelet/lipgloss/v2"
 	"github.com/usetero/cli/internal/api"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/components/list"
 	"github.com/usetero/cli/internal/tui/components/remotelist"
 	"github.com/usetero/cli/internal/tui/keymap"
@@ -23,7 +24,7 @@ const createNewOrgID = "__CREATE_NEW__"
 
 // OrganizationLister lists organizations
 type OrganizationLister interface {
-	List(ctx context.Context) ([]api.Organization, error)
+	List(ctx context.Context, opts api.ListOptions) ([]api.Organization, error)
 }
 
 // orgItem implements list.Item for the list component
@@ -125,7 +126,7 @@ func (s *SelectStep) Init() tea.Cmd {
 		s.logger.Info("loading organizations")
 		ctx := context.Background()
 
-		orgs, err := s.organizationLister.List(ctx)
+		orgs, err := s.organizationLister.List(ctx, api.ListOptions{})
 		if err != nil {
 			s.logger.Error("failed to load organizations", "error", err)
 			return remotelist.LoadResultMsg{Items: nil, Err: err}
@@ -259,6 +260,30 @@ func (s *SelectStep) IsComplete() bool {
 	return s.selectedOrgID != ""
 }
 
+// Stage returns the step's part of onboarding
+func (s *SelectStep) Stage() step.Stage {
+	return step.StageOrganization
+}
+
+// Revisit clears the selection so the user can pick another organization
+func (s *SelectStep) Revisit() tea.Cmd {
+	s.selectedOrgID = ""
+	return nil
+}
+
+// Checkpoint returns the selected organization. Creating one isn't a
+// checkpoint; CreateStep saves progress once it's created.
+func (s *SelectStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	if s.IsCreateSelected() {
+		return preferences.OnboardingProgress{}, false
+	}
+	return preferences.OnboardingProgress{
+		Step:  step.CheckpointOrganization,
+		Role:  s.role,
+		OrgID: s.SelectedOrgID(),
+	}, true
+}
+
 // IsCreateSelected returns true if user chose to create a new organization
 func (s *SelectStep) IsCreateSelected() bool {
 	return s.selectedOrgID == createNewOrgID
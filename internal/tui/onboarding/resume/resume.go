@@ -0,0 +1,73 @@
+// Package resume picks the onboarding step to start at from saved progress,
+// so quitting partway through doesn't mean starting over.
+package resume
+
+import (
+	"github.com/charmbracelet/bubbles/v2/key"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
+	"github.com/usetero/cli/internal/tui/onboarding/account"
+	"github.com/usetero/cli/internal/tui/onboarding/complete"
+	"github.com/usetero/cli/internal/tui/onboarding/datadog"
+	"github.com/usetero/cli/internal/tui/onboarding/log_events"
+	"github.com/usetero/cli/internal/tui/onboarding/organization"
+	"github.com/usetero/cli/internal/tui/onboarding/role"
+	"github.com/usetero/cli/internal/tui/onboarding/services"
+	"github.com/usetero/cli/internal/tui/onboarding/step"
+)
+
+// Step returns the first step after the last saved checkpoint. With no
+// progress, or progress missing what the next step needs, onboarding starts
+// at role selection, where saved preferences fast-forward it as before.
+func Step(apiClient api.Client, preferencesService *preferences.Service, logger log.Logger, globalBindings []key.Binding) step.Step {
+	p := preferencesService.GetOnboardingProgress()
+	if p.Step != "" {
+		logger.Info("resuming onboarding", "checkpoint", p.Step, "orgID", p.OrgID, "accountID", p.AccountID)
+	}
+
+	switch {
+	case p.Step == step.CheckpointRole && p.Role != "":
+		organizationService := api.NewOrganizationService(apiClient, logger)
+		return organization.NewSelectStep(p.Role, organizationService, apiClient, preferencesService, preferencesService, logger, globalBindings)
+
+	case p.Step == step.CheckpointOrganization && p.OrgID != "":
+		accountService := api.NewAccountService(apiClient, logger)
+		return account.NewSelectStep(p.Role, p.OrgID, accountService, preferencesService, apiClient, logger, globalBindings)
+
+	case p.OrgID == "" || p.AccountID == "":
+		// Everything past here is for an account
+		break
+
+	case p.Step == step.CheckpointAccount:
+		datadogService := api.NewDatadogAccountService(apiClient, logger)
+		return datadog.NewCheckDatadogStep(p.Role, p.OrgID, p.AccountID, datadogService, apiClient, logger, globalBindings)
+
+	case p.Step == step.CheckpointDatadogAPIKey && p.DatadogSite != "" && p.DatadogAPIKey != "":
+		datadogService := api.NewDatadogAccountService(apiClient, logger)
+		return datadog.NewAppKeyStep(p.Role, p.OrgID, p.AccountID, p.DatadogSite, p.DatadogAPIKey, datadogService, apiClient, logger, globalBindings)
+
+	case (p.Step == step.CheckpointDatadogRegion || p.Step == step.CheckpointDatadogAPIKey) && p.DatadogSite != "":
+		// Without a saved API key (e.g., secure storage failed) it's asked for again
+		datadogService := api.NewDatadogAccountService(apiClient, logger)
+		return datadog.NewAPIKeyStep(p.Role, p.OrgID, p.AccountID, p.DatadogSite, datadogService, apiClient, logger, globalBindings)
+
+	case p.DatadogAccountID == "":
+		break
+
+	case p.Step == step.CheckpointDatadogAccount:
+		serviceService := api.NewServiceService(apiClient, logger)
+		return services.NewDiscoveryStep(p.Role, p.OrgID, p.AccountID, &p.DatadogAccountID, serviceService, apiClient, logger, globalBindings)
+
+	case p.Step == step.CheckpointServices:
+		return log_events.NewDiscoveryStep(p.Role, p.OrgID, p.AccountID, &p.DatadogAccountID, apiClient, logger, globalBindings)
+
+	case p.Step == step.CheckpointLogEvents:
+		return complete.NewCompleteStep(logger, globalBindings)
+	}
+
+	if p.Step != "" {
+		logger.Warn("saved onboarding progress is incomplete, resuming from role selection", "checkpoint", p.Step)
+	}
+	return role.NewSelectStep(apiClient, preferencesService, logger, globalBindings)
+}
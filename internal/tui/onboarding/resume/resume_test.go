@@ -0,0 +1,75 @@
+package resume
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/log/logtest"
+	"github.com/usetero/cli/internal/preferences"
+	"github.com/usetero/cli/internal/securestore"
+	"github.com/usetero/cli/internal/tui/onboarding/complete"
+	"github.com/usetero/cli/internal/tui/onboarding/datadog"
+	"github.com/usetero/cli/internal/tui/onboarding/log_events"
+	"github.com/usetero/cli/internal/tui/onboarding/organization"
+	"github.com/usetero/cli/internal/tui/onboarding/role"
+	"github.com/usetero/cli/internal/tui/onboarding/services"
+	"github.com/usetero/cli/internal/tui/onboarding/step"
+	"github.com/usetero/cli/pkg/client"
+)
+
+func TestStep(t *testing.T) {
+	account := preferences.OnboardingProgress{Role: role.Platform, OrgID: "org-1", AccountID: "account-1"}
+	withStep := func(p preferences.OnboardingProgress, name string) preferences.OnboardingProgress {
+		p.Step = name
+		return p
+	}
+	withSite := func(p preferences.OnboardingProgress) preferences.OnboardingProgress {
+		p.DatadogSite = "US1"
+		return p
+	}
+	withDatadog := func(p preferences.OnboardingProgress) preferences.OnboardingProgress {
+		p.DatadogAccountID = "dd-1"
+		return p
+	}
+
+	tests := []struct {
+		name     string
+		progress preferences.OnboardingProgress
+		want     step.Step
+	}{
+		{"no progress starts at role", preferences.OnboardingProgress{}, &role.SelectStep{}},
+		{"role goes to organization", preferences.OnboardingProgress{Step: step.CheckpointRole, Role: role.Engineer}, &organization.SelectStep{}},
+		{"account goes to the datadog check", withStep(account, step.CheckpointAccount), &datadog.CheckDatadogStep{}},
+		{"region goes to the api key", withStep(withSite(account), step.CheckpointDatadogRegion), &datadog.APIKeyStep{}},
+		{"api key goes to the app key", func() preferences.OnboardingProgress {
+			p := withStep(withSite(account), step.CheckpointDatadogAPIKey)
+			p.DatadogAPIKey = "dd-secret"
+			return p
+		}(), &datadog.AppKeyStep{}},
+		{"api key without a saved key asks again", withStep(withSite(account), step.CheckpointDatadogAPIKey), &datadog.APIKeyStep{}},
+		{"datadog account goes to service discovery", withStep(withDatadog(account), step.CheckpointDatadogAccount), &services.DiscoveryStep{}},
+		{"services go to log event discovery", withStep(withDatadog(account), step.CheckpointServices), &log_events.DiscoveryStep{}},
+		{"log events go to complete", withStep(withDatadog(account), step.CheckpointLogEvents), &complete.CompleteStep{}},
+		{"missing account starts at role", preferences.OnboardingProgress{Step: step.CheckpointServices, DatadogAccountID: "dd-1"}, &role.SelectStep{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := logtest.New(t)
+			prefs := preferences.NewService(config.InMemory().Profile("test"), securestore.NewMemory(), logger)
+			if err := prefs.SetOnboardingProgress(tt.progress); err != nil {
+				t.Fatal(err)
+			}
+
+			got := Step(client.New("http://localhost/graphql", "token"), prefs, logger, nil)
+			if gotType, wantType := typeName(got), typeName(tt.want); gotType != wantType {
+				t.Errorf("Step() = %s, want %s", gotType, wantType)
+			}
+		})
+	}
+}
+
+func typeName(s step.Step) string {
+	return fmt.Sprintf("%T", s)
+}
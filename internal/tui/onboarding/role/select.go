@@ -19,6 +19,7 @@ import (
 type RoleSaver interface {
 	SetRole(role string) error
 	GetRole() string
+	ClearRole() error
 }
 
 const (
@@ -251,6 +252,26 @@ func (s *SelectStep) IsComplete() bool {
 	return isComplete
 }
 
+// Stage returns the step's part of onboarding
+func (s *SelectStep) Stage() step.Stage {
+	return step.StageRole
+}
+
+// Revisit clears the saved role so the step asks again, starting from the
+// previous choice
+func (s *SelectStep) Revisit() tea.Cmd {
+	if err := s.roleSaver.ClearRole(); err != nil {
+		s.logger.Error("failed to clear role", "error", err)
+		s.err = err
+	}
+	return nil
+}
+
+// Checkpoint returns the saved role
+func (s *SelectStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	return preferences.OnboardingProgress{Step: step.CheckpointRole, Role: s.roleSaver.GetRole()}, true
+}
+
 // IsBusy returns false - role selection is never busy.
 func (s *SelectStep) IsBusy() bool {
 	return false
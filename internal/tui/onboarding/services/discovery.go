@@ -12,6 +12,7 @@ import (
 	"github.com/charmbracelet/lipgloss/v2"
 	"github.com/usetero/cli/internal/api"
 	"github.com/usetero/cli/internal/log"
+	"github.com/usetero/cli/internal/preferences"
 	"github.com/usetero/cli/internal/tui/keymap"
 	"github.com/usetero/cli/internal/tui/onboarding/log_events"
 	"github.com/usetero/cli/internal/tui/onboarding/step"
@@ -235,6 +236,25 @@ func (s *DiscoveryStep) IsComplete() bool {
 	return s.complete && s.err == nil
 }
 
+// Stage returns the step's part of onboarding
+func (s *DiscoveryStep) Stage() step.Stage {
+	return step.StageDiscovery
+}
+
+// Checkpoint records that services have been discovered
+func (s *DiscoveryStep) Checkpoint() (preferences.OnboardingProgress, bool) {
+	p := preferences.OnboardingProgress{
+		Step:      step.CheckpointServices,
+		Role:      s.role,
+		OrgID:     s.orgID,
+		AccountID: s.accountID,
+	}
+	if s.datadogAccountID != nil {
+		p.DatadogAccountID = *s.datadogAccountID
+	}
+	return p, true
+}
+
 // IsBusy returns true while checking discovery status
 func (s *DiscoveryStep) IsBusy() bool {
 	// Not busy if there's an error or if complete
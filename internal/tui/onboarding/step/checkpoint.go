@@ -0,0 +1,24 @@
+package step
+
+import "github.com/usetero/cli/internal/preferences"
+
+// Checkpoint names, saved as preferences.OnboardingProgress.Step once the step
+// completes. Onboarding resumes at the step after the last checkpoint.
+const (
+	CheckpointRole           = "role"
+	CheckpointOrganization   = "organization"
+	CheckpointAccount        = "account"
+	CheckpointDatadogRegion  = "datadog_region"
+	CheckpointDatadogAPIKey  = "datadog_api_key"
+	CheckpointDatadogAccount = "datadog_account"
+	CheckpointServices       = "services"
+	CheckpointLogEvents      = "log_events"
+)
+
+// Checkpointer is implemented by steps worth resuming after. Flow asks for the
+// checkpoint once the step completes.
+type Checkpointer interface {
+	// Checkpoint returns the progress to save, or false if there's nothing
+	// to resume from (e.g., the user chose to create an organization).
+	Checkpoint() (preferences.OnboardingProgress, bool)
+}
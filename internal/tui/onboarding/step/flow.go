@@ -9,11 +9,14 @@ import (
 
 // Flow orchestrates a chain of steps.
 // Steps transition automatically when complete by calling Next() to get the next step.
+// Completed steps are kept as history so the user can go back (see Revisitable).
 // Uses pointer receiver pattern for efficiency.
 type Flow struct {
-	current Step
-	width   int
-	height  int
+	current    Step
+	history    []Step
+	onComplete func(Step)
+	width      int
+	height     int
 }
 
 // NewFlow creates a new flow starting with the given step
@@ -23,6 +26,12 @@ func NewFlow(startStep Step) *Flow {
 	}
 }
 
+// OnStepComplete registers a function called with each step as it completes,
+// before the flow moves on. Onboarding uses it to save progress.
+func (f *Flow) OnStepComplete(fn func(Step)) {
+	f.onComplete = fn
+}
+
 // Init initializes the current step
 func (f *Flow) Init() tea.Cmd {
 	if f.current == nil {
@@ -37,6 +46,10 @@ func (f *Flow) Update(msg tea.Msg) tea.Cmd {
 		return nil
 	}
 
+	if msg, ok := msg.(tea.KeyPressMsg); ok && key.Matches(msg, BackBinding) && f.CanGoBack() {
+		return f.back()
+	}
+
 	// Update current step
 	var cmd tea.Cmd
 	f.current, cmd = f.current.Update(msg)
@@ -47,6 +60,15 @@ func (f *Flow) Update(msg tea.Msg) tea.Cmd {
 		// (e.g., from saved preferences). This loop handles chains of
 		// pre-satisfied steps gracefully.
 		for f.current.IsComplete() {
+			if f.onComplete != nil {
+				f.onComplete(f.current)
+			}
+			if c, ok := f.current.(Committer); ok && c.Commits() {
+				f.history = nil
+			} else {
+				f.history = append(f.history, f.current)
+			}
+
 			nextStep := f.current.Next()
 			if nextStep == nil {
 				// No more steps - flow complete
@@ -64,6 +86,47 @@ func (f *Flow) Update(msg tea.Msg) tea.Cmd {
 	return cmd
 }
 
+// CanGoBack returns true if there's a previous step to go back to and the
+// current step isn't in the middle of something
+func (f *Flow) CanGoBack() bool {
+	if f.current == nil || f.current.IsBusy() {
+		return false
+	}
+	for _, s := range f.history {
+		if _, ok := s.(Revisitable); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// back returns to the most recent step the user can change, dropping any
+// in between that can't be revisited (e.g., checks that ran on their own)
+func (f *Flow) back() tea.Cmd {
+	for len(f.history) > 0 {
+		prev := f.history[len(f.history)-1]
+		f.history = f.history[:len(f.history)-1]
+
+		revisitable, ok := prev.(Revisitable)
+		if !ok {
+			continue
+		}
+		f.current = prev
+		f.current.SetSize(f.width, f.height)
+		return revisitable.Revisit()
+	}
+	return nil
+}
+
+// Stage returns the current step's stage, or false if it has none
+func (f *Flow) Stage() (Stage, bool) {
+	staged, ok := f.current.(Staged)
+	if !ok {
+		return 0, false
+	}
+	return staged.Stage(), true
+}
+
 // View renders the current step
 func (f *Flow) View() string {
 	if f.current == nil {
@@ -115,10 +178,15 @@ func (f *Flow) Current() Step {
 	return f.current
 }
 
-// Help delegates to the current step's help
+// Help delegates to the current step's help, adding the back binding when
+// there's somewhere to go back to
 func (f *Flow) Help() help.KeyMap {
 	if f.current == nil {
 		return keymap.Simple{Keys: []key.Binding{}}
 	}
-	return f.current.Help()
+	if !f.CanGoBack() {
+		return f.current.Help()
+	}
+	bindings := append([]key.Binding{BackBinding}, f.current.Help().ShortHelp()...)
+	return keymap.Simple{Keys: bindings}
 }
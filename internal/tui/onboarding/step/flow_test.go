@@ -0,0 +1,142 @@
+package step_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/usetero/cli/internal/tui/onboarding/step"
+	"github.com/usetero/cli/internal/tui/onboarding/step/steptest"
+)
+
+// testStep completes on enter and can optionally be revisited or commit
+type testStep struct {
+	*steptest.MockStep
+	self      step.Step // What Update returns, so wrappers stay wrapped
+	name      string
+	done      bool
+	revisited int
+}
+
+func newTestStep(name string, next step.Step) *testStep {
+	s := &testStep{MockStep: steptest.NewMockStep(), name: name}
+	s.self = s
+	s.IsCompleteFunc = func() bool { return s.done }
+	s.NextFunc = func() step.Step { return next }
+	s.UpdateFunc = func(msg tea.Msg) (step.Step, tea.Cmd) {
+		if msg, ok := msg.(tea.KeyPressMsg); ok && msg.String() == "enter" {
+			s.done = true
+		}
+		return s.self, nil
+	}
+	return s
+}
+
+type revisitableStep struct{ *testStep }
+
+func newRevisitableStep(name string, next step.Step) revisitableStep {
+	s := revisitableStep{newTestStep(name, next)}
+	s.self = s
+	return s
+}
+
+func (s revisitableStep) Revisit() tea.Cmd {
+	s.done = false
+	s.revisited++
+	return nil
+}
+
+type committerStep struct{ *testStep }
+
+func newCommitterStep(name string, next step.Step) committerStep {
+	s := committerStep{newTestStep(name, next)}
+	s.self = s
+	return s
+}
+
+func (s committerStep) Commits() bool { return true }
+
+var (
+	enter = tea.KeyPressMsg{Code: tea.KeyEnter}
+	esc   = tea.KeyPressMsg{Code: tea.KeyEscape}
+)
+
+func name(s step.Step) string {
+	switch s := s.(type) {
+	case *testStep:
+		return s.name
+	case revisitableStep:
+		return s.name
+	case committerStep:
+		return s.name
+	}
+	return "?"
+}
+
+func TestFlow_Back(t *testing.T) {
+	t.Run("returns to the last revisitable step, skipping others", func(t *testing.T) {
+		third := newTestStep("third", nil)
+		check := newTestStep("check", third)
+		first := newRevisitableStep("first", check)
+		check.done = true // Completes on its own, like the Datadog check
+
+		flow := step.NewFlow(first)
+		flow.Update(enter)
+		if got := name(flow.Current()); got != "third" {
+			t.Fatalf("current = %s, want third", got)
+		}
+		if !flow.CanGoBack() {
+			t.Fatal("CanGoBack() = false, want true")
+		}
+
+		flow.Update(esc)
+		if got := name(flow.Current()); got != "first" {
+			t.Fatalf("after back, current = %s, want first", got)
+		}
+		if first.revisited != 1 {
+			t.Errorf("revisited %d times, want 1", first.revisited)
+		}
+		if flow.CanGoBack() {
+			t.Error("CanGoBack() = true at the first step")
+		}
+
+		// Completing it again moves forward as before
+		flow.Update(enter)
+		if got := name(flow.Current()); got != "third" {
+			t.Errorf("current = %s, want third", got)
+		}
+	})
+
+	t.Run("forgets history once a step commits", func(t *testing.T) {
+		last := newTestStep("last", nil)
+		create := newCommitterStep("create", last)
+		first := newRevisitableStep("first", create)
+
+		flow := step.NewFlow(first)
+		flow.Update(enter)
+		if !flow.CanGoBack() {
+			t.Fatal("CanGoBack() = false before the commit, want true")
+		}
+		flow.Update(enter)
+		if got := name(flow.Current()); got != "last" {
+			t.Fatalf("current = %s, want last", got)
+		}
+		if flow.CanGoBack() {
+			t.Error("CanGoBack() = true past a committed step")
+		}
+	})
+
+	t.Run("doesn't go back while the step is busy", func(t *testing.T) {
+		second := newTestStep("second", nil)
+		second.IsBusyFunc = func() bool { return true }
+		flow := step.NewFlow(newRevisitableStep("first", second))
+		flow.Update(enter)
+
+		if flow.CanGoBack() {
+			t.Error("CanGoBack() = true while busy")
+		}
+		flow.Update(esc)
+		if got := name(flow.Current()); got != "second" {
+			t.Errorf("current = %s, want second", got)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package step
+
+import (
+	"github.com/charmbracelet/bubbles/v2/key"
+	tea "github.com/charmbracelet/bubbletea/v2"
+)
+
+// BackBinding returns to the previous step the user can change.
+var BackBinding = key.NewBinding(
+	key.WithKeys("esc"),
+	key.WithHelp("esc", "back"),
+)
+
+// Revisitable is implemented by steps the user can go back to. Flow keeps
+// completed steps, so going back returns to the same step with its state
+// (the list cursor, what was typed) intact.
+type Revisitable interface {
+	// Revisit undoes the step's completion so it asks again. It returns any
+	// command needed to resume, e.g. focusing an input.
+	Revisit() tea.Cmd
+}
+
+// Committer is implemented by steps that create something on the control
+// plane, like an organization or Datadog account. Going back past one would
+// create it again, so Flow forgets its history once one completes.
+type Committer interface {
+	Commits() bool
+}
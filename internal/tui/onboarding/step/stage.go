@@ -0,0 +1,44 @@
+package step
+
+// Stage is a part of onboarding, shown in the header as "Step n of m" so
+// users know how far they are from the chat. A stage can span several steps
+// (e.g., connecting Datadog is a region, an API key, and an app key).
+type Stage int
+
+const (
+	StageSignIn Stage = iota + 1
+	StageRole
+	StageOrganization
+	StageAccount
+	StageDatadog
+	StageDiscovery
+)
+
+// StageCount is the number of stages
+const StageCount = int(StageDiscovery)
+
+// String returns the stage's name for the breadcrumb
+func (s Stage) String() string {
+	switch s {
+	case StageSignIn:
+		return "Sign in"
+	case StageRole:
+		return "Role"
+	case StageOrganization:
+		return "Organization"
+	case StageAccount:
+		return "Account"
+	case StageDatadog:
+		return "Connect Datadog"
+	case StageDiscovery:
+		return "Discovery"
+	default:
+		return ""
+	}
+}
+
+// Staged is implemented by steps that belong to a stage. Steps that don't,
+// like the completion message, show no breadcrumb.
+type Staged interface {
+	Stage() Stage
+}
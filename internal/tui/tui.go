@@ -1,17 +1,22 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"math/rand/v2"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/v2/key"
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/usetero/cli/internal/api"
 	"github.com/usetero/cli/internal/auth"
 	"github.com/usetero/cli/internal/config"
-	"github.com/usetero/cli/internal/keyring"
+	"github.com/usetero/cli/internal/demo"
+	"github.com/usetero/cli/internal/diagnostics"
 	"github.com/usetero/cli/internal/log"
 	"github.com/usetero/cli/internal/preferences"
 	tuiapp "github.com/usetero/cli/internal/tui/app"
@@ -19,15 +24,13 @@ import (
 	"github.com/usetero/cli/internal/tui/onboarding"
 	"github.com/usetero/cli/internal/tui/styles"
 	"github.com/usetero/cli/internal/workos"
+	"github.com/usetero/cli/pkg/client"
 )
 
 const (
-	// Minimum window dimensions
-	minWidth  = 80
-	minHeight = 24
-
-	// WorkOS authentication configuration
-	workosBaseURL = "https://api.workos.com"
+	// MinWidth and MinHeight are the smallest window the UI renders in
+	MinWidth  = 80
+	MinHeight = 24
 )
 
 var (
@@ -63,9 +66,12 @@ func DefaultKeyMap() KeyMap {
 
 // TUI is the top-level model that routes between modes (onboarding, app).
 type TUI struct {
-	config             *config.Config
+	profile            *config.Profile
 	logger             log.Logger
+	authService        *auth.Service
 	preferencesService *preferences.Service
+	clients            *client.Factory
+	apiToken           string // Set when authenticating with an API token instead of signing in
 
 	// Current mode (onboarding or app)
 	currentMode mode.Mode
@@ -80,27 +86,54 @@ type TUI struct {
 	// sendProgressBar instructs the TUI to send progress bar updates to the
 	// terminal. Only enabled for supported terminals (Windows Terminal, Ghostty).
 	sendProgressBar bool
+
+	// terminal is recorded for bug reports (see 'tero debug bundle'), once it
+	// stops changing (see scheduleSaveTerminal)
+	terminal    diagnostics.Terminal
+	terminalSeq int
+
+	// demo shows a banner saying the data is sample data (see NewDemo)
+	demo bool
 }
 
-// New creates a new TUI model
-func New(cfg *config.Config, apiEndpoint string, workosClientID string, logger log.Logger) tea.Model {
-	// Create WorkOS client for authentication
-	workosClient := workos.NewClient(workosBaseURL, workosClientID)
+// New creates a new TUI model for a profile, keeping its tokens and secrets in secrets.
+// Control plane clients come from clients.
+// A non-empty apiToken skips the interactive sign-in and is used for every request.
+func New(profile *config.Profile, secrets auth.SecureStorage, clients *client.Factory, workosClientID string, apiToken string, logger log.Logger) tea.Model {
+	return newTUI(profile, secrets, clients, workosClientID, apiToken, logger)
+}
 
-	// Create keyring for secure token storage
-	tokenStore := keyring.New()
+// NewDemo creates a TUI for demo mode: clients serve sample data and accept
+// demoToken, so sign-in is skipped, and a banner across the top says the data
+// isn't real.
+func NewDemo(profile *config.Profile, secrets auth.SecureStorage, clients *client.Factory, demoToken string, logger log.Logger) tea.Model {
+	m := newTUI(profile, secrets, clients, "", demoToken, logger)
+	m.demo = true
+	return m
+}
+
+func newTUI(profile *config.Profile, secrets auth.SecureStorage, clients *client.Factory, workosClientID string, apiToken string, logger log.Logger) *TUI {
+	// Create WorkOS client for authentication
+	workosClient := workos.NewClient(workos.DefaultBaseURL, workosClientID)
 
 	// Create domain services
-	authService := auth.NewService(workosClient, tokenStore, logger)
-	preferencesService := preferences.NewService(cfg, logger)
+	authService := auth.NewService(workosClient, secrets, logger)
+	preferencesService := preferences.NewService(profile, secrets, logger)
+	if err := preferencesService.MigrateSecrets(); err != nil {
+		// Not fatal - the plaintext copy is kept and migration retries next launch
+		logger.Warn("failed to migrate secrets to secure storage", "error", err)
+	}
 
 	// Start with onboarding mode
-	onboardingMode := onboarding.New(logger, authService, preferencesService, apiEndpoint, globalBindings)
+	onboardingMode := onboarding.New(logger, authService, preferencesService, clients, apiToken, globalBindings)
 
 	return &TUI{
-		config:             cfg,
+		profile:            profile,
 		logger:             logger,
+		authService:        authService,
 		preferencesService: preferencesService,
+		clients:            clients,
+		apiToken:           apiToken,
 		currentMode:        onboardingMode,
 		keyMap:             DefaultKeyMap(),
 	}
@@ -115,6 +148,13 @@ func (m *TUI) Init() tea.Cmd {
 	)
 }
 
+// canGoBack returns true if the current mode uses esc to go back a step, so
+// it doesn't quit
+func (m *TUI) canGoBack() bool {
+	mode, ok := m.currentMode.(interface{ CanGoBack() bool })
+	return ok && mode.CanGoBack()
+}
+
 // setWindowTitle returns a command that sets the terminal window title
 func setWindowTitle(title string) tea.Cmd {
 	return func() tea.Msg {
@@ -128,7 +168,7 @@ func (m *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
 		// Global key bindings (checked first)
-		if key.Matches(msg, m.keyMap.Quit) || key.Matches(msg, m.keyMap.Exit) {
+		if key.Matches(msg, m.keyMap.Quit) || (key.Matches(msg, m.keyMap.Exit) && !m.canGoBack()) {
 			m.logger.Info("user quit", "key", msg.String())
 			return m, tea.Quit
 		}
@@ -140,6 +180,8 @@ func (m *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.logger.Info("enabled progress bar", "terminal", "Windows Terminal")
 			}
 		}
+		// Saved with the window size, which always follows
+		m.terminal.Env = diagnostics.TerminalEnv(msg.Getenv)
 	case tea.TerminalVersionMsg:
 		// Detect Ghostty
 		termVersion := strings.ToLower(string(msg))
@@ -150,7 +192,13 @@ func (m *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.logger.Info("enabled progress bar", "terminal", "Ghostty")
 			}
 		}
-		return m, nil
+		m.terminal.Version = string(msg)
+		return m, m.scheduleSaveTerminal()
+	case saveTerminalMsg:
+		if msg.seq != m.terminalSeq {
+			return m, nil // Changed since; a later save is scheduled
+		}
+		return m, m.saveTerminal()
 	}
 
 	switch msg := msg.(type) {
@@ -165,9 +213,10 @@ func (m *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			"mode", modeType)
 
 		// Modes get full terminal dimensions (layouts handle padding)
-		m.currentMode.SetSize(msg.Width, msg.Height)
+		m.currentMode.SetSize(m.modeSize())
 
-		return m, nil
+		m.terminal.Width, m.terminal.Height = msg.Width, msg.Height
+		return m, m.scheduleSaveTerminal()
 	}
 
 	// Route message to current mode
@@ -185,12 +234,15 @@ func (m *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				"orgID", orgID,
 				"accountID", accountID)
 
-			// Create app mode (chat page will be created when we know what services it needs)
-			m.currentMode = tuiapp.New(orgID, accountID, m.logger, globalBindings)
+			app := tuiapp.New(orgID, accountID, api.New(m.newAPIClient(), m.logger), m.preferencesService, m.logger, globalBindings)
+			if m.apiToken != "" {
+				app.UseAPIToken()
+			}
+			m.currentMode = app
 
 			// Set size on new mode before initializing
 			if m.width > 0 && m.height > 0 {
-				m.currentMode.SetSize(m.width, m.height)
+				m.currentMode.SetSize(m.modeSize())
 			}
 
 			return m, m.currentMode.Init()
@@ -200,6 +252,72 @@ func (m *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// modeSize returns the space for the current mode: the window, less the demo banner
+func (m *TUI) modeSize() (int, int) {
+	if m.demo {
+		return m.width, max(m.height-1, 0)
+	}
+	return m.width, m.height
+}
+
+// demoBanner renders the one-line banner shown in demo mode
+func (m *TUI) demoBanner() string {
+	theme := styles.CurrentTheme()
+	return lipgloss.NewStyle().
+		Width(m.width).
+		Padding(0, 1).
+		Background(theme.WarningBackground).
+		Foreground(theme.Text).
+		Bold(true).
+		Render(ansi.Truncate(demo.Banner, max(m.width-2, 0), "…")) // Wrapping would push the mode down
+}
+
+// terminalSaveDelay is how long the terminal must stay unchanged before it's
+// recorded, so dragging a window edge doesn't rewrite the file on every resize
+const terminalSaveDelay = time.Second
+
+// saveTerminalMsg records the terminal if nothing changed since it was scheduled
+type saveTerminalMsg struct {
+	seq int
+}
+
+// scheduleSaveTerminal records the terminal after terminalSaveDelay, unless it
+// changes again first
+func (m *TUI) scheduleSaveTerminal() tea.Cmd {
+	m.terminalSeq++
+	seq := m.terminalSeq
+	return tea.Tick(terminalSaveDelay, func(time.Time) tea.Msg {
+		return saveTerminalMsg{seq: seq}
+	})
+}
+
+// saveTerminal records the terminal for bug reports
+func (m *TUI) saveTerminal() tea.Cmd {
+	terminal := m.terminal
+	terminal.RecordedAt = time.Now()
+	return func() tea.Msg {
+		if err := diagnostics.SaveTerminal(terminal); err != nil {
+			m.logger.Warn("failed to record terminal", "error", err)
+		}
+		return nil
+	}
+}
+
+// newAPIClient creates the control plane client for app mode
+func (m *TUI) newAPIClient() *client.Client {
+	if m.apiToken != "" {
+		return m.clients.New(m.apiToken)
+	}
+
+	// Onboarding just authenticated, so the stored token is current.
+	// If it can't be read, the refresher recovers on the first 401.
+	accessToken, err := m.authService.GetAccessToken(context.Background())
+	if err != nil {
+		m.logger.Warn("failed to get access token for app", "error", err)
+	}
+	return m.clients.New(accessToken, client.WithTokenRefresher(m.authService))
+}
+
 // isBusy returns true if the TUI is currently performing a background operation
 // and should show the progress bar animation
 func (m *TUI) isBusy() bool {
@@ -211,7 +329,7 @@ func (m *TUI) View() tea.View {
 	theme := styles.CurrentTheme()
 
 	// Check minimum window size
-	if !DisableMinSizeCheck && (m.width < minWidth || m.height < minHeight) {
+	if !DisableMinSizeCheck && (m.width < MinWidth || m.height < MinHeight) {
 		view := tea.View{
 			BackgroundColor: theme.Background,
 			AltScreen:       true,
@@ -243,6 +361,13 @@ func (m *TUI) View() tea.View {
 	// Extract cursor before creating layers
 	finalView, cursor := ExtractCursor(modeView)
 
+	if m.demo {
+		finalView = lipgloss.JoinVertical(lipgloss.Left, m.demoBanner(), finalView)
+		if cursor != nil {
+			cursor.Y++
+		}
+	}
+
 	// Create layers (base layer with page content)
 	layers := []*lipgloss.Layer{
 		lipgloss.NewLayer(finalView),
@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/usetero/cli/internal/api"
+	"github.com/usetero/cli/internal/config"
+	"github.com/usetero/cli/internal/log/logtest"
+	"github.com/usetero/cli/internal/preferences"
+	"github.com/usetero/cli/internal/securestore"
+	tuiapp "github.com/usetero/cli/internal/tui/app"
+	"github.com/usetero/cli/internal/tui/app/page"
+	"github.com/usetero/cli/pkg/client/clienttest"
+	"github.com/usetero/cli/pkg/client/fakeserver"
+)
+
+var (
+	esc         = tea.KeyPressMsg{Code: tea.KeyEscape}
+	enter       = tea.KeyPressMsg{Code: tea.KeyEnter}
+	slash       = tea.KeyPressMsg{Code: '/', Text: "/"}
+	servicesKey = tea.KeyPressMsg{Code: '2', Mod: tea.ModAlt}
+)
+
+// newAppTUI returns a TUI in app mode, as after onboarding
+func newAppTUI(t *testing.T) *TUI {
+	t.Helper()
+
+	logger := logtest.New(t)
+	srv := clienttest.NewServer(t, fakeserver.Seed())
+	prefs := preferences.NewService(config.InMemory().Profile("test"), securestore.NewMemory(), logger)
+	return &TUI{
+		logger:             logger,
+		preferencesService: prefs,
+		currentMode:        tuiapp.New(fakeserver.OrganizationID, fakeserver.AccountID, api.New(srv.Client(), logger), prefs, logger, globalBindings),
+		keyMap:             DefaultKeyMap(),
+	}
+}
+
+// quits reports whether a command returned by Update quits the program
+func quits(cmd tea.Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+	_, ok := cmd().(tea.QuitMsg)
+	return ok
+}
+
+func TestTUI_EscInAppMode(t *testing.T) {
+	t.Run("quits when the page doesn't use esc", func(t *testing.T) {
+		m := newAppTUI(t)
+		m.Update(servicesKey)
+
+		if _, cmd := m.Update(esc); !quits(cmd) {
+			t.Error("esc should quit")
+		}
+	})
+
+	t.Run("clears a focused filter instead of quitting", func(t *testing.T) {
+		m := newAppTUI(t)
+		m.Update(servicesKey)
+		m.Update(slash)
+
+		if _, cmd := m.Update(esc); quits(cmd) {
+			t.Error("esc should clear the filter, not quit")
+		}
+		if _, cmd := m.Update(esc); !quits(cmd) {
+			t.Error("esc should quit once the filter is cleared")
+		}
+	})
+
+	t.Run("clears an applied filter instead of quitting", func(t *testing.T) {
+		m := newAppTUI(t)
+		m.Update(servicesKey)
+		m.Update(slash)
+		m.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+		m.Update(enter)
+
+		if _, cmd := m.Update(esc); quits(cmd) {
+			t.Error("esc should clear the filter, not quit")
+		}
+		if _, cmd := m.Update(esc); !quits(cmd) {
+			t.Error("esc should quit once the filter is cleared")
+		}
+	})
+
+	t.Run("goes back from a drill-down instead of quitting", func(t *testing.T) {
+		m := newAppTUI(t)
+		m.Update(servicesKey)
+		m.Update(page.OpenLogEventsMsg{ServiceID: "svc", ServiceName: "checkout"})
+
+		_, cmd := m.Update(esc)
+		if cmd == nil {
+			t.Fatal("esc should ask to go back")
+		}
+		if _, ok := cmd().(page.BackMsg); !ok {
+			t.Fatal("esc should ask to go back, not quit")
+		}
+		m.Update(page.BackMsg{})
+
+		if _, cmd := m.Update(esc); !quits(cmd) {
+			t.Error("esc should quit once back on the services page")
+		}
+	})
+}
+
+func TestTUI_SavesTerminalOnceResizingStops(t *testing.T) {
+	m := newAppTUI(t)
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	if _, cmd := m.Update(saveTerminalMsg{seq: 1}); cmd != nil {
+		t.Error("a save scheduled before the last resize should be skipped")
+	}
+	if _, cmd := m.Update(saveTerminalMsg{seq: 2}); cmd == nil {
+		t.Error("the save scheduled by the last resize should run")
+	}
+}
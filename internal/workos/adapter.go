@@ -47,12 +47,34 @@ func (c *Client) PollAuthentication(ctx context.Context, deviceCode string) (*au
 	}, nil
 }
 
+// RefreshToken implements auth.OAuthProvider interface.
+// Converts WorkOS response to auth.AuthenticationResponse.
+func (c *Client) RefreshToken(ctx context.Context, refreshToken string) (*auth.AuthenticationResponse, error) {
+	resp, err := c.refreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &auth.AuthenticationResponse{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		User: auth.User{
+			ID:            resp.User.ID,
+			Email:         resp.User.Email,
+			EmailVerified: resp.User.EmailVerified,
+			FirstName:     resp.User.FirstName,
+			LastName:      resp.User.LastName,
+		},
+	}, nil
+}
+
 // convertError converts WorkOS errors to auth errors.
 func convertError(err error) error {
 	var pendingErr *AuthorizationPendingError
 	var slowDownErr *SlowDownError
 	var expiredErr *ExpiredTokenError
 	var deniedErr *AccessDeniedError
+	var invalidGrantErr *InvalidGrantError
 
 	if errors.As(err, &pendingErr) {
 		return &auth.AuthorizationPendingError{}
@@ -66,5 +88,8 @@ func convertError(err error) error {
 	if errors.As(err, &deniedErr) {
 		return &auth.AccessDeniedError{}
 	}
+	if errors.As(err, &invalidGrantErr) {
+		return &auth.InvalidGrantError{}
+	}
 	return err
 }
@@ -23,13 +23,30 @@ type AuthResponse struct {
 // Returns AuthResponse on success, or a specific error type based on the response.
 // This is the internal implementation - use PollAuthentication from adapter.go for app.AuthClient interface.
 func (c *Client) pollAuthentication(ctx context.Context, deviceCode string) (*AuthResponse, error) {
-	endpoint := fmt.Sprintf("%s/user_management/authenticate", c.baseURL)
-
 	data := url.Values{}
 	data.Set("client_id", c.clientID)
 	data.Set("device_code", deviceCode)
 	data.Set("grant_type", "urn:ietf:params:oauth:grant-type:device_code")
 
+	return c.authenticate(ctx, data)
+}
+
+// refreshToken exchanges a refresh token for a new access token and refresh token.
+// WorkOS rotates refresh tokens, so the returned refresh token replaces the old one.
+// This is the internal implementation - use RefreshToken from adapter.go for auth.OAuthProvider interface.
+func (c *Client) refreshToken(ctx context.Context, refreshToken string) (*AuthResponse, error) {
+	data := url.Values{}
+	data.Set("client_id", c.clientID)
+	data.Set("refresh_token", refreshToken)
+	data.Set("grant_type", "refresh_token")
+
+	return c.authenticate(ctx, data)
+}
+
+// authenticate posts a grant to the WorkOS authenticate endpoint.
+func (c *Client) authenticate(ctx context.Context, data url.Values) (*AuthResponse, error) {
+	endpoint := fmt.Sprintf("%s/user_management/authenticate", c.baseURL)
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(data.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -81,6 +98,8 @@ func parseError(code, description string, statusCode int) error {
 		return &ExpiredTokenError{}
 	case "access_denied":
 		return &AccessDeniedError{}
+	case "invalid_grant":
+		return &InvalidGrantError{Description: description}
 	default:
 		return &UnknownError{
 			Code:        code,
@@ -5,6 +5,9 @@ import (
 	"time"
 )
 
+// DefaultBaseURL is the WorkOS API base URL used for authentication.
+const DefaultBaseURL = "https://api.workos.com"
+
 // Client provides access to WorkOS device code flow authentication.
 type Client struct {
 	baseURL    string
@@ -33,6 +33,19 @@ func (e *AccessDeniedError) Error() string {
 	return "access denied: user denied authorization"
 }
 
+// InvalidGrantError indicates the refresh token is invalid, expired, or revoked.
+// The user must sign in again.
+type InvalidGrantError struct {
+	Description string
+}
+
+func (e *InvalidGrantError) Error() string {
+	if e.Description != "" {
+		return "invalid grant: " + e.Description
+	}
+	return "invalid grant: refresh token is invalid or expired"
+}
+
 // UnknownError represents an error from WorkOS that we don't have a specific type for.
 // This is the fallback for unrecognized error codes.
 type UnknownError struct {
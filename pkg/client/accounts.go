@@ -2,9 +2,9 @@ package client
 
 import "context"
 
-// ListAccounts returns all accounts for a given organization
-func (c *Client) ListAccounts(ctx context.Context, organizationID string) (*ListAccountsResponse, error) {
-	return ListAccounts(ctx, c.gql, organizationID)
+// ListAccounts returns one page of accounts for a given organization
+func (c *Client) ListAccounts(ctx context.Context, organizationID string, first int, after *string) (*ListAccountsResponse, error) {
+	return ListAccounts(ctx, c.gql, organizationID, first, after)
 }
 
 // CreateAccount creates a new account within an organization
@@ -12,7 +12,22 @@ func (c *Client) CreateAccount(ctx context.Context, input CreateAccountInput) (*
 	return CreateAccount(ctx, c.gql, input)
 }
 
+// RenameAccount changes an account's name
+func (c *Client) RenameAccount(ctx context.Context, id, name string) (*RenameAccountResponse, error) {
+	return RenameAccount(ctx, c.gql, id, name)
+}
+
 // GetAccount retrieves a specific account by ID
 func (c *Client) GetAccount(ctx context.Context, id string) (*GetAccountResponse, error) {
 	return GetAccount(ctx, c.gql, id)
 }
+
+// GetAccountVolumeStats retrieves volume stats for an account and its services
+func (c *Client) GetAccountVolumeStats(ctx context.Context, id string, lookback TimeWindow) (*GetAccountVolumeStatsResponse, error) {
+	return GetAccountVolumeStats(ctx, c.gql, id, lookback)
+}
+
+// GetAccountSummary retrieves an account's organization, service count, and day/week volume stats
+func (c *Client) GetAccountSummary(ctx context.Context, id string) (*GetAccountSummaryResponse, error) {
+	return GetAccountSummary(ctx, c.gql, id)
+}
@@ -0,0 +1,8 @@
+package client
+
+import "context"
+
+// GetLatestChat retrieves the most recently active chat in a workspace
+func (c *Client) GetLatestChat(ctx context.Context, workspaceID string) (*GetLatestChatResponse, error) {
+	return GetLatestChat(ctx, c.gql, workspaceID)
+}
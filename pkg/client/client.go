@@ -2,9 +2,14 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -14,21 +19,204 @@ type Client struct {
 	gql graphql.Client
 }
 
+// ErrUnauthorized is returned when the control plane rejects the access token,
+// after any refresh has been tried.
+var ErrUnauthorized = errors.New("unauthorized: the control plane rejected the access token")
+
+// CodeInvalidDatadogCredentials is the GraphQL error code the control plane
+// returns when Datadog rejects the keys an account is connected with.
+const CodeInvalidDatadogCredentials = "INVALID_DATADOG_CREDENTIALS"
+
+// GraphQLError is returned when the control plane responds with GraphQL
+// errors. Its message is theirs joined, without gqlerror's "input: <path>" prefix.
+type GraphQLError struct {
+	Message string
+
+	// Codes are the errors' extensions codes, e.g. "NOT_FOUND", for those that have one
+	Codes []string
+}
+
+func (e *GraphQLError) Error() string {
+	return e.Message
+}
+
+// HasCode returns true if any of the errors has code
+func (e *GraphQLError) HasCode(code string) bool {
+	return slices.Contains(e.Codes, code)
+}
+
+// TokenRefresher obtains a new access token when the current one is rejected.
+// Implementations are responsible for persisting the rotated tokens.
+// Concrete implementation: *auth.Service
+type TokenRefresher interface {
+	RefreshAccessToken(ctx context.Context) (string, error)
+}
+
+// Operation describes a completed GraphQL request, for diagnostics.
+type Operation struct {
+	Name string
+
+	// Variables as sent. They can hold secrets such as API keys, so redact
+	// them before logging.
+	Variables json.RawMessage
+
+	Start         time.Time
+	Duration      time.Duration
+	ResponseBytes int64
+
+	Err error // Cleaned, as returned to the caller
+
+	// ErrorExtensions are the extensions of each GraphQL error, e.g. error
+	// codes, which cleaning drops from Err
+	ErrorExtensions []map[string]interface{}
+}
+
+// Observer is called after every GraphQL operation completes.
+type Observer func(op Operation)
+
+// options holds optional Client behavior.
+type options struct {
+	refresher TokenRefresher
+	observers []Observer
+}
+
+// Option configures optional Client behavior.
+type Option func(*options)
+
+// WithTokenRefresher enables transparent token refresh. When a request is
+// rejected with 401 Unauthorized, the refresher is called once and the
+// request is retried with the new token.
+func WithTokenRefresher(refresher TokenRefresher) Option {
+	return func(o *options) {
+		o.refresher = refresher
+	}
+}
+
+// WithObserver reports every operation to observer, e.g. to record recent
+// operations for bug reports.
+func WithObserver(observer Observer) Option {
+	return func(o *options) {
+		o.observers = append(o.observers, observer)
+	}
+}
+
 // New creates a new authenticated GraphQL client.
 // The accessToken is added to all requests via Authorization header.
-func New(endpoint string, accessToken string) *Client {
-	httpClient := &http.Client{
-		Transport: &authTransport{
-			accessToken: accessToken,
-			base:        http.DefaultTransport,
-		},
+func New(endpoint string, accessToken string, opts ...Option) *Client {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	transport := &authTransport{
+		accessToken: accessToken,
+		refresher:   o.refresher,
+		base:        http.DefaultTransport,
+	}
+
+	httpClient := &http.Client{Transport: transport}
+
+	var gql graphql.Client
+	if len(o.observers) > 0 {
+		// Observers see raw errors, for their extensions, and the response size
+		httpClient.Transport = &countingTransport{base: transport}
+		gql = &observingClient{base: graphql.NewClient(endpoint, httpClient), observers: o.observers}
+	} else {
+		gql = graphql.NewClient(endpoint, httpClient)
+	}
+
+	return &Client{gql: &errorCleaningClient{base: gql}}
+}
+
+// Factory creates clients for one endpoint with options shared by every
+// client, such as observers, so code that only holds a token doesn't need to
+// know about them.
+type Factory struct {
+	endpoint string
+	opts     []Option
+}
+
+// NewFactory creates a factory for clients of endpoint.
+func NewFactory(endpoint string, opts ...Option) *Factory {
+	return &Factory{endpoint: endpoint, opts: opts}
+}
+
+// Endpoint returns the GraphQL endpoint the factory's clients use.
+func (f *Factory) Endpoint() string {
+	return f.endpoint
+}
+
+// New creates a client authenticated with accessToken, with the factory's
+// options followed by opts.
+func (f *Factory) New(accessToken string, opts ...Option) *Client {
+	return New(f.endpoint, accessToken, append(slices.Clone(f.opts), opts...)...)
+}
+
+// observingClient wraps a graphql.Client and reports each operation to observers.
+type observingClient struct {
+	base      graphql.Client
+	observers []Observer
+}
+
+// MakeRequest implements graphql.Client by timing the base client's request.
+func (c *observingClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	var size int64
+	ctx = context.WithValue(ctx, responseSizeKey{}, &size)
+
+	start := time.Now()
+	err := c.base.MakeRequest(ctx, req, resp)
+	op := Operation{
+		Name:          req.OpName,
+		Start:         start,
+		Duration:      time.Since(start),
+		ResponseBytes: size,
+		Err:           cleanGraphQLError(err),
+	}
+	if req.Variables != nil {
+		op.Variables, _ = json.Marshal(req.Variables)
+	}
+	var gqlErrList gqlerror.List
+	if errors.As(err, &gqlErrList) {
+		for _, gqlErr := range gqlErrList {
+			if len(gqlErr.Extensions) > 0 {
+				op.ErrorExtensions = append(op.ErrorExtensions, gqlErr.Extensions)
+			}
+		}
+	}
+
+	for _, observe := range c.observers {
+		observe(op)
 	}
+	return err
+}
 
-	baseClient := graphql.NewClient(endpoint, httpClient)
+// responseSizeKey holds an *int64 that countingTransport adds response bytes to
+type responseSizeKey struct{}
 
-	return &Client{
-		gql: &errorCleaningClient{base: baseClient},
+// countingTransport counts response body bytes for requests whose context
+// carries a responseSizeKey counter.
+type countingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if counter, ok := req.Context().Value(responseSizeKey{}).(*int64); ok && err == nil {
+		resp.Body = &countingBody{ReadCloser: resp.Body, n: counter}
 	}
+	return resp, err
+}
+
+// countingBody adds the bytes read to n
+type countingBody struct {
+	io.ReadCloser
+	n *int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	*b.n += int64(n)
+	return n, err
 }
 
 // errorCleaningClient wraps a graphql.Client and cleans up error messages
@@ -49,50 +237,135 @@ func (c *errorCleaningClient) MakeRequest(ctx context.Context, req *graphql.Requ
 
 // cleanGraphQLError removes GraphQL-specific prefixes from error messages.
 // gqlerror.Error.Error() formats errors as "input: <path> <message>".
-// We strip the "input: <path>" prefix to show clean user-friendly messages.
+// We strip the "input: <path>" prefix to show clean user-friendly messages,
+// keeping error codes in a GraphQLError so callers can tell failures apart.
+// A 401 becomes ErrUnauthorized so callers can tell a bad token from other failures.
 func cleanGraphQLError(err error) error {
 	if err == nil {
 		return nil
 	}
 
+	var httpErr *graphql.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusUnauthorized {
+		return ErrUnauthorized
+	}
+
 	// Handle gqlerror.List (multiple errors)
 	var gqlErrList gqlerror.List
 	if errors.As(err, &gqlErrList) {
 		cleaned := make([]string, 0, len(gqlErrList))
+		var codes []string
 		for _, gqlErr := range gqlErrList {
 			// Use the Message field directly instead of Error() which adds prefixes
 			if gqlErr.Message != "" {
 				cleaned = append(cleaned, gqlErr.Message)
 			}
+			codes = appendCode(codes, gqlErr)
 		}
 		if len(cleaned) > 0 {
-			return errors.New(strings.Join(cleaned, "\n"))
+			return &GraphQLError{Message: strings.Join(cleaned, "\n"), Codes: codes}
 		}
 	}
 
 	// Handle single gqlerror.Error
 	var gqlErr *gqlerror.Error
 	if errors.As(err, &gqlErr) && gqlErr.Message != "" {
-		return errors.New(gqlErr.Message)
+		return &GraphQLError{Message: gqlErr.Message, Codes: appendCode(nil, gqlErr)}
 	}
 
 	// Fallback to original error
 	return err
 }
 
-// authTransport adds Authorization header to all requests
+// appendCode appends the error's extensions code, if it has one
+func appendCode(codes []string, gqlErr *gqlerror.Error) []string {
+	if code, ok := gqlErr.Extensions["code"].(string); ok && code != "" {
+		return append(codes, code)
+	}
+	return codes
+}
+
+// authTransport adds Authorization header to all requests and, when a
+// refresher is configured, retries once with a fresh token on 401.
 type authTransport struct {
+	mu          sync.Mutex
 	accessToken string
+	refresher   TokenRefresher
 	base        http.RoundTripper
 }
 
 func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// send replays the body via GetBody, so the original is ours to close
+	if req.Body != nil && req.GetBody != nil {
+		defer func() {
+			_ = req.Body.Close()
+		}()
+	}
+
+	token := t.token()
+
+	resp, err := t.send(req, token)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || t.refresher == nil {
+		return resp, err
+	}
+
+	// The body has already been consumed, so we can only retry if it can be rebuilt
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	newToken, err := t.refresh(req.Context(), token)
+	if err != nil {
+		// Surface the original 401 so callers see the real failure
+		return resp, nil
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	return t.send(req, newToken)
+}
+
+// send executes a copy of req with the given bearer token.
+func (t *authTransport) send(req *http.Request, token string) (*http.Response, error) {
 	// Clone request to avoid modifying the original
-	req = req.Clone(req.Context())
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
 
 	// Add Authorization header
-	req.Header.Set("Authorization", "Bearer "+t.accessToken)
+	clone.Header.Set("Authorization", "Bearer "+token)
 
 	// Execute request
-	return t.base.RoundTrip(req)
+	return t.base.RoundTrip(clone)
+}
+
+// token returns the current access token.
+func (t *authTransport) token() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.accessToken
+}
+
+// refresh replaces a rejected token. Concurrent requests that failed with the
+// same stale token share a single refresh.
+func (t *authTransport) refresh(ctx context.Context, stale string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.accessToken != stale {
+		return t.accessToken, nil
+	}
+
+	token, err := t.refresher.RefreshAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	t.accessToken = token
+	return token, nil
 }
@@ -2,6 +2,11 @@ package client
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/Khan/genqlient/graphql"
@@ -39,6 +44,144 @@ func TestErrorCleaningClient(t *testing.T) {
 			t.Errorf("error message = %q, want %q", got, want)
 		}
 	})
+	t.Run("keeps error codes", func(t *testing.T) {
+		mockBase := &mockGraphQLClient{
+			makeRequestFunc: func(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+				return gqlerror.List{
+					{Message: "Invalid Datadog credentials", Extensions: map[string]interface{}{"code": CodeInvalidDatadogCredentials}},
+					{Message: "no code"},
+				}
+			},
+		}
+
+		client := &errorCleaningClient{base: mockBase}
+		err := client.MakeRequest(context.Background(), &graphql.Request{}, &graphql.Response{})
+
+		var gqlErr *GraphQLError
+		if !errors.As(err, &gqlErr) {
+			t.Fatalf("error = %#v, want *GraphQLError", err)
+		}
+		if !gqlErr.HasCode(CodeInvalidDatadogCredentials) || gqlErr.HasCode("NOT_FOUND") {
+			t.Errorf("codes = %v, want only %s", gqlErr.Codes, CodeInvalidDatadogCredentials)
+		}
+	})
+	t.Run("reports a rejected token as ErrUnauthorized", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		_, err := ListOrganizations(context.Background(), New(server.URL, "bad-token").gql, 10, nil)
+		if !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("error = %v, want ErrUnauthorized", err)
+		}
+	})
+}
+
+func TestAuthTransport_RoundTrip(t *testing.T) {
+	t.Run("refreshes once and retries with the new token on 401", func(t *testing.T) {
+		var gotTokens, gotBodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			gotTokens = append(gotTokens, r.Header.Get("Authorization"))
+			gotBodies = append(gotBodies, string(body))
+			if r.Header.Get("Authorization") != "Bearer fresh" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		refresher := &mockTokenRefresher{token: "fresh"}
+		transport := &authTransport{accessToken: "stale", refresher: refresher, base: http.DefaultTransport}
+
+		resp := doRequest(t, transport, server.URL, `{"query":"{ viewer }"}`)
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+		if refresher.calls != 1 {
+			t.Errorf("refresh calls = %d, want 1", refresher.calls)
+		}
+		wantTokens := []string{"Bearer stale", "Bearer fresh"}
+		if strings.Join(gotTokens, ",") != strings.Join(wantTokens, ",") {
+			t.Errorf("tokens = %v, want %v", gotTokens, wantTokens)
+		}
+		if gotBodies[1] != gotBodies[0] {
+			t.Errorf("retried body = %q, want %q", gotBodies[1], gotBodies[0])
+		}
+
+		// Later requests reuse the refreshed token without refreshing again
+		resp2 := doRequest(t, transport, server.URL, `{}`)
+		defer func() { _ = resp2.Body.Close() }()
+		if refresher.calls != 1 {
+			t.Errorf("refresh calls after second request = %d, want 1", refresher.calls)
+		}
+	})
+
+	t.Run("returns the original 401 when refresh fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		refresher := &mockTokenRefresher{err: errors.New("session expired")}
+		transport := &authTransport{accessToken: "stale", refresher: refresher, base: http.DefaultTransport}
+
+		resp := doRequest(t, transport, server.URL, `{}`)
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+		}
+		if refresher.calls != 1 {
+			t.Errorf("refresh calls = %d, want 1", refresher.calls)
+		}
+	})
+
+	t.Run("does not refresh without a refresher", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		transport := &authTransport{accessToken: "stale", base: http.DefaultTransport}
+
+		resp := doRequest(t, transport, server.URL, `{}`)
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+		}
+	})
+}
+
+// doRequest sends a POST through the transport the same way genqlient does.
+func doRequest(t *testing.T, transport http.RoundTripper, url, body string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	return resp
+}
+
+// mockTokenRefresher implements TokenRefresher for testing
+type mockTokenRefresher struct {
+	token string
+	err   error
+	calls int
+}
+
+func (m *mockTokenRefresher) RefreshAccessToken(ctx context.Context) (string, error) {
+	m.calls++
+	return m.token, m.err
 }
 
 // mockGraphQLClient implements graphql.Client for testing
@@ -52,3 +195,61 @@ func (m *mockGraphQLClient) MakeRequest(ctx context.Context, req *graphql.Reques
 	}
 	return nil
 }
+
+func TestObservingClient(t *testing.T) {
+	t.Run("reports each operation with its cleaned error and extensions", func(t *testing.T) {
+		mockBase := &mockGraphQLClient{
+			makeRequestFunc: func(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+				return gqlerror.List{{
+					Message:    "organization not found",
+					Path:       ast.Path{ast.PathName("organization")},
+					Extensions: map[string]interface{}{"code": "NOT_FOUND"},
+				}}
+			},
+		}
+		var ops []Operation
+		c := &observingClient{
+			base:      mockBase,
+			observers: []Observer{func(op Operation) { ops = append(ops, op) }},
+		}
+
+		req := &graphql.Request{OpName: "GetOrganization", Variables: map[string]string{"id": "org-1"}}
+		_ = c.MakeRequest(context.Background(), req, &graphql.Response{})
+
+		if len(ops) != 1 {
+			t.Fatalf("observed %d operations, want 1", len(ops))
+		}
+		op := ops[0]
+		if op.Name != "GetOrganization" {
+			t.Errorf("Name = %q, want GetOrganization", op.Name)
+		}
+		if string(op.Variables) != `{"id":"org-1"}` {
+			t.Errorf("Variables = %s", op.Variables)
+		}
+		if op.Err == nil || op.Err.Error() != "organization not found" {
+			t.Errorf("Err = %v, want the cleaned error", op.Err)
+		}
+		if len(op.ErrorExtensions) != 1 || op.ErrorExtensions[0]["code"] != "NOT_FOUND" {
+			t.Errorf("ErrorExtensions = %v", op.ErrorExtensions)
+		}
+	})
+
+	t.Run("counts response bytes", func(t *testing.T) {
+		body := `{"data":{"organizations":null}}`
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, body)
+		}))
+		defer server.Close()
+
+		var ops []Operation
+		c := New(server.URL, "token", WithObserver(func(op Operation) { ops = append(ops, op) }))
+		var data struct{}
+		if err := c.gql.MakeRequest(context.Background(), &graphql.Request{OpName: "ListOrganizations"}, &graphql.Response{Data: &data}); err != nil {
+			t.Fatalf("MakeRequest() error = %v", err)
+		}
+
+		if len(ops) != 1 || ops[0].ResponseBytes != int64(len(body)) {
+			t.Errorf("ops = %+v, want one with %d response bytes", ops, len(body))
+		}
+	})
+}
@@ -0,0 +1,282 @@
+package clienttest
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/usetero/cli/pkg/client"
+	"github.com/usetero/cli/pkg/client/fakeserver"
+)
+
+var operationName = regexp.MustCompile(`(?m)^(?:query|mutation) (\w+)`)
+
+// queryOperations returns the name of every operation in client.Queries
+func queryOperations(t *testing.T) []string {
+	t.Helper()
+
+	var names []string
+	err := fs.WalkDir(client.Queries, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(client.Queries, path)
+		if err != nil {
+			return err
+		}
+		for _, m := range operationName.FindAllStringSubmatch(string(data), -1) {
+			names = append(names, m[1])
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return names
+}
+
+func TestServerRunsEveryOperation(t *testing.T) {
+	srv := NewServer(t, fakeserver.Seed())
+	c := srv.Client()
+	ctx := context.Background()
+	since := time.Now().Add(-48 * time.Hour)
+
+	calls := map[string]func() error{
+		"ListAccounts": func() error {
+			resp, err := c.ListAccounts(ctx, fakeserver.OrganizationID, 10, nil)
+			if err == nil && resp.Accounts.TotalCount != 1 {
+				t.Errorf("accounts = %d, want 1", resp.Accounts.TotalCount)
+			}
+			return err
+		},
+		"CreateAccount": func() error {
+			_, err := c.CreateAccount(ctx, client.CreateAccountInput{Name: "Staging", OrganizationID: fakeserver.OrganizationID})
+			return err
+		},
+		"RenameAccount": func() error {
+			resp, err := c.RenameAccount(ctx, fakeserver.AccountID, "Production")
+			if err == nil && resp.UpdateAccount.Name != "Production" {
+				t.Errorf("name = %q, want Production", resp.UpdateAccount.Name)
+			}
+			return err
+		},
+		"GetAccount": func() error {
+			resp, err := c.GetAccount(ctx, fakeserver.AccountID)
+			if err == nil && resp.Accounts.Edges[0].Node.DatadogAccount.Id != fakeserver.DatadogAccountID {
+				t.Errorf("datadog account = %+v, want %s", resp.Accounts.Edges[0].Node.DatadogAccount, fakeserver.DatadogAccountID)
+			}
+			return err
+		},
+		"GetAccountVolumeStats": func() error {
+			resp, err := c.GetAccountVolumeStats(ctx, fakeserver.AccountID, client.TimeWindowWeek)
+			if want := len(srv.Fixtures.All("Service")); err == nil && len(resp.Accounts.Edges[0].Node.Services) != want {
+				t.Errorf("services = %d, want %d", len(resp.Accounts.Edges[0].Node.Services), want)
+			}
+			return err
+		},
+		"GetAccountSummary": func() error {
+			resp, err := c.GetAccountSummary(ctx, fakeserver.AccountID)
+			if err == nil {
+				node := resp.Accounts.Edges[0].Node
+				if node.Week.TotalVolume != 7*node.Day.TotalVolume || node.Day.TotalVolume == 0 {
+					t.Errorf("day = %v, week = %v, want week = 7 × day", node.Day.TotalVolume, node.Week.TotalVolume)
+				}
+			}
+			return err
+		},
+		"GetLatestChat": func() error {
+			resp, err := c.GetLatestChat(ctx, fakeserver.WorkspaceID)
+			if err == nil && len(resp.Chats.Edges[0].Node.Messages) != 2 {
+				t.Errorf("messages = %d, want 2", len(resp.Chats.Edges[0].Node.Messages))
+			}
+			return err
+		},
+		"CreateDatadogAccountWithCredentials": func() error {
+			_, err := c.CreateDatadogAccountWithCredentials(ctx, client.CreateDatadogAccountWithCredentialsInput{
+				Attributes:  client.CreateDatadogAccountInput{Name: "Staging", Site: client.DatadogAccountSiteUs1, AccountID: fakeserver.AccountID},
+				Credentials: client.CreateDatadogCredentialsInput{ApiKey: "api", AppKey: "app"},
+			})
+			return err
+		},
+		"ValidateDatadogApiKey": func() error {
+			resp, err := c.ValidateDatadogApiKey(ctx, client.ValidateDatadogApiKeyInput{ApiKey: "invalid", Site: client.DatadogAccountSiteUs1})
+			if err == nil && resp.ValidateDatadogApiKey.Valid {
+				t.Error("invalid key validated")
+			}
+			return err
+		},
+		"GetDatadogAccountServiceDiscoveryProgress": func() error {
+			resp, err := c.GetDatadogAccountServiceDiscoveryProgress(ctx, fakeserver.DatadogAccountID)
+			if err == nil && resp.DatadogAccounts.Edges[0].Node.ServiceDiscoveryProgress.Status != client.DiscoveryStatusReady {
+				t.Errorf("status = %s, want READY", resp.DatadogAccounts.Edges[0].Node.ServiceDiscoveryProgress.Status)
+			}
+			return err
+		},
+		"GetDatadogAccountLogDiscoveryProgress": func() error {
+			_, err := c.GetDatadogAccountLogDiscoveryProgress(ctx, fakeserver.DatadogAccountID)
+			return err
+		},
+		"ListLogEventsForService": func() error {
+			resp, err := c.ListLogEventsForService(ctx, fakeserver.ServiceID, client.TimeWindowDay, 10, nil)
+			if err == nil && resp.LogEvents.TotalCount != 3 {
+				t.Errorf("log events = %d, want 3", resp.LogEvents.TotalCount)
+			}
+			return err
+		},
+		"GetLogEventDetail": func() error {
+			resp, err := c.GetLogEventDetail(ctx, fakeserver.LogEventID)
+			if err == nil {
+				node := resp.LogEvents.Edges[0].Node
+				if node.Service.Id != fakeserver.ServiceID || len(node.LogRules) != 1 || len(node.LogRules[0].Deployments) != 1 {
+					t.Errorf("detail = %+v, want service, one rule, and one deployment", node)
+				}
+			}
+			return err
+		},
+		"ListLogEventVolumes": func() error {
+			resp, err := c.ListLogEventVolumes(ctx, fakeserver.LogEventID, since, 10, nil)
+			if err == nil && len(resp.LogEventVolumes.Edges) != 2 {
+				t.Errorf("volumes since %s = %d, want 2", since, len(resp.LogEventVolumes.Edges))
+			}
+			return err
+		},
+		"ListLogRulesForService": func() error {
+			resp, err := c.ListLogRulesForService(ctx, fakeserver.ServiceID, 10, nil)
+			if err == nil && resp.LogRules.TotalCount != 3 {
+				t.Errorf("rules = %d, want 3", resp.LogRules.TotalCount)
+			}
+			return err
+		},
+		"ListLogRulesForWorkspace": func() error {
+			_, err := c.ListLogRulesForWorkspace(ctx, fakeserver.WorkspaceID, 10, nil)
+			return err
+		},
+		"ListOrganizations": func() error {
+			_, err := c.ListOrganizations(ctx, 10, nil)
+			return err
+		},
+		"CreateOrganizationAndBootstrap": func() error {
+			resp, err := c.CreateOrganizationAndBootstrap(ctx, client.CreateOrganizationInput{Name: "Initech"})
+			if err == nil && resp.CreateOrganizationAndBootstrap.Workspace.Id == "" {
+				t.Error("no workspace bootstrapped")
+			}
+			return err
+		},
+		"ListServices": func() error {
+			_, err := c.ListServices(ctx, 10, nil)
+			return err
+		},
+		"GetService": func() error {
+			resp, err := c.GetService(ctx, fakeserver.ServiceID)
+			if err == nil {
+				svc, ok := resp.Node.(*client.GetServiceNodeService)
+				if !ok || svc.Account.Id != fakeserver.AccountID || len(svc.LogEvents) != 3 {
+					t.Errorf("service = %+v, want account and 3 log events", resp.Node)
+				}
+			}
+			return err
+		},
+		"GetServiceByName": func() error {
+			resp, err := c.GetServiceByName(ctx, "checkout-api")
+			if err == nil && resp.Services.Edges[0].Node.Id != fakeserver.ServiceID {
+				t.Errorf("service = %s, want %s", resp.Services.Edges[0].Node.Id, fakeserver.ServiceID)
+			}
+			return err
+		},
+		"EnableService": func() error {
+			resp, err := c.EnableService(ctx, "service-legacy-cron")
+			if err == nil && !resp.UpdateService.Enabled {
+				t.Error("service not enabled")
+			}
+			return err
+		},
+		"DisableService": func() error {
+			_, err := c.DisableService(ctx, "service-legacy-cron")
+			return err
+		},
+		"ListServiceVolumeStats": func() error {
+			_, err := c.ListServiceVolumeStats(ctx, fakeserver.AccountID, client.TimeWindowMonth, 10, nil)
+			return err
+		},
+		"GetServiceVolumeStats": func() error {
+			_, err := c.GetServiceVolumeStats(ctx, fakeserver.ServiceID, client.TimeWindowQuarter)
+			return err
+		},
+		"ListWorkspaces": func() error {
+			_, err := c.ListWorkspaces(ctx, fakeserver.AccountID, 10, nil)
+			return err
+		},
+	}
+
+	ops := queryOperations(t)
+	for _, op := range ops {
+		call, ok := calls[op]
+		if !ok {
+			t.Errorf("%s isn't covered: add it to calls", op)
+			continue
+		}
+		if err := call(); err != nil {
+			t.Errorf("%s: %v", op, err)
+		}
+	}
+	if got := srv.Operations(); !slices.Equal(got, ops) {
+		t.Errorf("operations = %v, want %v", got, ops)
+	}
+}
+
+func TestServerPaginates(t *testing.T) {
+	c := NewServer(t, fakeserver.Seed()).Client()
+	ctx := context.Background()
+
+	var names []string
+	var after *string
+	for {
+		resp, err := c.ListServices(ctx, 3, after)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range resp.Services.Edges {
+			names = append(names, e.Node.Name)
+		}
+		if !resp.Services.PageInfo.HasNextPage {
+			break
+		}
+		cursor := resp.Services.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	want := []string{"checkout-api", "payments-worker", "search-indexer", "legacy-cron"}
+	if !slices.Equal(names, want) {
+		t.Errorf("services = %v, want %v", names, want)
+	}
+}
+
+func TestServerInjectsFaults(t *testing.T) {
+	srv := NewServer(t, fakeserver.Seed())
+	c := srv.Client()
+	ctx := context.Background()
+
+	srv.Inject("ListOrganizations", fakeserver.Fault{StatusCode: 401, Times: 1})
+	if _, err := c.ListOrganizations(ctx, 1, nil); !errors.Is(err, client.ErrUnauthorized) {
+		t.Errorf("err = %v, want ErrUnauthorized", err)
+	}
+	if _, err := c.ListOrganizations(ctx, 1, nil); err != nil {
+		t.Errorf("after the fault is used up: %v", err)
+	}
+
+	srv.Inject("", fakeserver.Fault{Message: "boom", Extensions: map[string]any{"code": "INTERNAL"}, Times: 1})
+	if _, err := c.ListServices(ctx, 1, nil); err == nil || err.Error() != "boom" {
+		t.Errorf("err = %v, want boom", err)
+	}
+
+	srv.Inject("GetService", fakeserver.Fault{Latency: time.Second})
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.GetService(timeout, fakeserver.ServiceID); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want deadline exceeded", err)
+	}
+}
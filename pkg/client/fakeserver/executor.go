@@ -0,0 +1,557 @@
+package fakeserver
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// MutationFunc implements a root mutation field against the fixtures.
+// Returned objects are completed against the mutation's selection set.
+type MutationFunc func(f *Fixtures, args map[string]any) (any, error)
+
+// executor runs one operation against the fixtures. It implements the parts
+// of GraphQL execution the CLI's operations use: fields, aliases, fragments,
+// Relay connections with where/orderBy/first/after, and node(id).
+type executor struct {
+	schema    *ast.Schema
+	fixtures  *Fixtures
+	mutations map[string]MutationFunc
+	vars      map[string]any
+	errs      gqlerror.List
+}
+
+// execute runs op and returns its data. Field errors are collected in e.errs.
+func (e *executor) execute(op *ast.OperationDefinition) map[string]any {
+	root := e.schema.Query
+	if op.Operation == ast.Mutation {
+		root = e.schema.Mutation
+	}
+	return e.selectionSet(op.SelectionSet, root.Name, nil, ast.Path{})
+}
+
+// selectionSet completes an object value against a selection set
+func (e *executor) selectionSet(set ast.SelectionSet, typename string, value map[string]any, path ast.Path) map[string]any {
+	out := make(map[string]any)
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			key := sel.Alias
+			if key == "" {
+				key = sel.Name
+			}
+			if sel.Name == "__typename" {
+				out[key] = typename
+				continue
+			}
+			fieldPath := append(slices.Clone(path), ast.PathName(key))
+			raw, err := e.resolve(typename, value, sel)
+			if err != nil {
+				// Resolvers return a *gqlerror.Error to set extensions
+				var gqlErr *gqlerror.Error
+				if !errors.As(err, &gqlErr) {
+					gqlErr = &gqlerror.Error{Message: err.Error()}
+				}
+				gqlErr.Path = fieldPath
+				e.errs = append(e.errs, gqlErr)
+				out[key] = nil
+				continue
+			}
+			out[key] = e.complete(sel, sel.Definition.Type, raw, fieldPath)
+		case *ast.FragmentSpread:
+			if e.matchesType(typename, sel.Definition.TypeCondition) {
+				for k, v := range e.selectionSet(sel.Definition.SelectionSet, typename, value, path) {
+					out[k] = v
+				}
+			}
+		case *ast.InlineFragment:
+			if sel.TypeCondition == "" || e.matchesType(typename, sel.TypeCondition) {
+				for k, v := range e.selectionSet(sel.SelectionSet, typename, value, path) {
+					out[k] = v
+				}
+			}
+		}
+	}
+	return out
+}
+
+// complete shapes a resolved value to the field's type
+func (e *executor) complete(field *ast.Field, typ *ast.Type, raw any, path ast.Path) any {
+	if raw == nil {
+		return nil
+	}
+
+	if typ.Elem != nil {
+		items := toList(raw)
+		out := make([]any, len(items))
+		for i, item := range items {
+			out[i] = e.complete(field, typ.Elem, item, append(slices.Clone(path), ast.PathIndex(i)))
+		}
+		return out
+	}
+
+	def := e.schema.Types[typ.Name()]
+	switch def.Kind {
+	case ast.Object, ast.Interface, ast.Union:
+		obj := toObject(raw)
+		if obj == nil {
+			return nil
+		}
+		typename := def.Name
+		if concrete, ok := obj["__typename"].(string); ok {
+			typename = concrete
+		}
+		return e.selectionSet(field.SelectionSet, typename, obj, path)
+	default:
+		return raw
+	}
+}
+
+// resolve returns a field's raw value
+func (e *executor) resolve(parentType string, parent map[string]any, field *ast.Field) (any, error) {
+	args := field.ArgumentMap(e.vars)
+
+	switch parentType {
+	case e.schema.Query.Name:
+		return e.resolveQuery(field, args)
+	case mutationName(e.schema):
+		fn, ok := e.mutations[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("fakeserver: mutation %s isn't implemented", field.Name)
+		}
+		return fn(e.fixtures, args)
+	}
+
+	if v, ok := parent[field.Name]; ok {
+		if fn, ok := v.(FieldFunc); ok {
+			return fn(args), nil
+		}
+		return v, nil
+	}
+	return e.relation(parentType, parent, field.Name, field.Definition.Type), nil
+}
+
+// resolveQuery resolves a root query field: node(id) or a Relay connection
+func (e *executor) resolveQuery(field *ast.Field, args map[string]any) (any, error) {
+	if field.Name == "node" {
+		id, _ := args["id"].(string)
+		if obj := e.fixtures.Get(id); obj != nil {
+			return obj, nil
+		}
+		return nil, nil
+	}
+
+	connType := field.Definition.Type.Name()
+	nodeType := e.connectionNodeType(connType)
+	if nodeType == "" {
+		return nil, fmt.Errorf("fakeserver: query %s isn't implemented", field.Name)
+	}
+	return e.connection(nodeType, args)
+}
+
+// connectionNodeType returns the node type of a Relay connection type, or ""
+func (e *executor) connectionNodeType(connType string) string {
+	conn := e.schema.Types[connType]
+	if conn == nil || !strings.HasSuffix(connType, "Connection") {
+		return ""
+	}
+	edges := conn.Fields.ForName("edges")
+	if edges == nil {
+		return ""
+	}
+	edge := e.schema.Types[edges.Type.Name()]
+	node := edge.Fields.ForName("node")
+	if node == nil {
+		return ""
+	}
+	return node.Type.Name()
+}
+
+// connection lists fixtures of nodeType as a Relay connection
+func (e *executor) connection(nodeType string, args map[string]any) (any, error) {
+	var nodes []Object
+	for _, obj := range e.fixtures.All(nodeType) {
+		ok, err := e.matches(nodeType, obj, args["where"])
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			nodes = append(nodes, obj)
+		}
+	}
+	if err := sortNodes(nodes, args["orderBy"]); err != nil {
+		return nil, err
+	}
+	total := len(nodes)
+
+	start := 0
+	if after, ok := args["after"].(string); ok && after != "" {
+		i, err := decodeCursor(after)
+		if err != nil {
+			return nil, err
+		}
+		start = min(i+1, len(nodes))
+	}
+	end := len(nodes)
+	if first, ok := toInt(args["first"]); ok {
+		end = min(start+first, len(nodes))
+	}
+
+	edges := make([]any, 0, end-start)
+	for i := start; i < end; i++ {
+		edges = append(edges, map[string]any{"node": nodes[i], "cursor": encodeCursor(i)})
+	}
+	pageInfo := map[string]any{
+		"hasNextPage":     end < len(nodes),
+		"hasPreviousPage": start > 0,
+		"startCursor":     nil,
+		"endCursor":       nil,
+	}
+	if end > start {
+		pageInfo["startCursor"] = encodeCursor(start)
+		pageInfo["endCursor"] = encodeCursor(end - 1)
+	}
+	return map[string]any{"edges": edges, "pageInfo": pageInfo, "totalCount": total}, nil
+}
+
+// relation resolves an object field from ID fields: parent.<field>ID for a
+// single object, or the objects whose <parentType>ID is the parent's id
+func (e *executor) relation(parentType string, parent map[string]any, field string, typ *ast.Type) any {
+	id, _ := parent["id"].(string)
+	def := e.schema.Types[typ.Name()]
+	if id == "" || def == nil || def.Kind != ast.Object {
+		return nil
+	}
+	return e.related(parentType, parent, field, typ)
+}
+
+// related returns the objects related to parent through field of type typ
+func (e *executor) related(parentType string, parent map[string]any, field string, typ *ast.Type) any {
+	backRef := lowerFirst(parentType) + "ID"
+	id := parent["id"]
+
+	if typ.Elem == nil && field != "" {
+		if fk, ok := parent[field+"ID"].(string); ok {
+			if obj := e.fixtures.Get(fk); obj != nil {
+				return obj
+			}
+			return nil
+		}
+	}
+
+	var children []any
+	for _, obj := range e.fixtures.All(typ.Name()) {
+		if obj[backRef] == id {
+			if typ.Elem == nil {
+				return obj
+			}
+			children = append(children, obj)
+		}
+	}
+	if typ.Elem == nil {
+		return nil
+	}
+	return children
+}
+
+// matches applies a where filter, e.g. {accountID: "a", nameContainsFold: "x"}
+func (e *executor) matches(typename string, obj Object, where any) (bool, error) {
+	filter, _ := where.(map[string]any)
+	for key, want := range filter {
+		if want == nil {
+			continue
+		}
+		ok, err := e.matchesKey(typename, obj, key, want)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (e *executor) matchesKey(typename string, obj Object, key string, want any) (bool, error) {
+	switch key {
+	case "and":
+		for _, w := range toList(want) {
+			if ok, err := e.matches(typename, obj, w); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case "or":
+		for _, w := range toList(want) {
+			if ok, err := e.matches(typename, obj, w); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case "not":
+		ok, err := e.matches(typename, obj, want)
+		return !ok, err
+	}
+
+	// hasLogEventWith: [{serviceID: "s"}] filters on a related object
+	if strings.HasPrefix(key, "has") {
+		rel := lowerFirst(strings.TrimSuffix(strings.TrimPrefix(key, "has"), "With"))
+		def := e.schema.Types[typename].Fields.ForName(rel)
+		if def == nil {
+			return false, fmt.Errorf("fakeserver: filter %s on %s isn't supported", key, typename)
+		}
+		related := toList(e.related(typename, obj, rel, def.Type))
+		if !strings.HasSuffix(key, "With") {
+			exists, _ := want.(bool)
+			return (len(related) > 0) == exists, nil
+		}
+		for _, r := range related {
+			for _, w := range toList(want) {
+				if ok, err := e.matches(def.Type.Name(), toObject(r), w); err != nil || ok {
+					return ok, err
+				}
+			}
+		}
+		return false, nil
+	}
+
+	for _, op := range []string{"NEQ", "GTE", "LTE", "GT", "LT", "NotIn", "In", "ContainsFold", "Contains", "HasPrefix", "HasSuffix", "IsNil", "NotNil"} {
+		field, ok := strings.CutSuffix(key, op)
+		if !ok || field == "" {
+			continue
+		}
+		return compareOp(op, obj[field], want)
+	}
+
+	return equal(obj[key], want), nil
+}
+
+// matchesType reports whether typename satisfies a fragment's type condition
+func (e *executor) matchesType(typename, condition string) bool {
+	if typename == condition {
+		return true
+	}
+	def := e.schema.Types[condition]
+	if def == nil {
+		return false
+	}
+	for _, t := range e.schema.GetPossibleTypes(def) {
+		if t.Name == typename {
+			return true
+		}
+	}
+	return false
+}
+
+func mutationName(schema *ast.Schema) string {
+	if schema.Mutation == nil {
+		return ""
+	}
+	return schema.Mutation.Name
+}
+
+// sortNodes applies orderBy: {field: UPDATED_AT, direction: DESC}
+func sortNodes(nodes []Object, orderBy any) error {
+	for _, order := range slices.Backward(toList(orderBy)) {
+		o, _ := order.(map[string]any)
+		enum, _ := o["field"].(string)
+		if enum == "" {
+			continue
+		}
+		field := enumToField(enum)
+		desc := o["direction"] == "DESC"
+		slices.SortStableFunc(nodes, func(a, b Object) int {
+			c, _ := compare(a[field], b[field])
+			if desc {
+				return -c
+			}
+			return c
+		})
+	}
+	return nil
+}
+
+// enumToField turns an order field enum like UPDATED_AT into updatedAt
+func enumToField(enum string) string {
+	parts := strings.Split(strings.ToLower(enum), "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] == "id" {
+			parts[i] = "ID"
+		} else if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func compareOp(op string, got, want any) (bool, error) {
+	switch op {
+	case "NEQ":
+		return !equal(got, want), nil
+	case "In", "NotIn":
+		in := slices.ContainsFunc(toList(want), func(w any) bool { return equal(got, w) })
+		return in == (op == "In"), nil
+	case "IsNil", "NotNil":
+		isNil := got == nil
+		return isNil == (op == "IsNil"), nil
+	case "Contains", "ContainsFold", "HasPrefix", "HasSuffix":
+		g, _ := got.(string)
+		w, _ := want.(string)
+		switch op {
+		case "Contains":
+			return strings.Contains(g, w), nil
+		case "ContainsFold":
+			return strings.Contains(strings.ToLower(g), strings.ToLower(w)), nil
+		case "HasPrefix":
+			return strings.HasPrefix(g, w), nil
+		default:
+			return strings.HasSuffix(g, w), nil
+		}
+	}
+
+	c, ok := compare(got, want)
+	if !ok {
+		return false, nil
+	}
+	switch op {
+	case "GT":
+		return c > 0, nil
+	case "GTE":
+		return c >= 0, nil
+	case "LT":
+		return c < 0, nil
+	default:
+		return c <= 0, nil
+	}
+}
+
+// equal compares a fixture value to a filter value, which arrives as a string
+// for IDs, enums, and times
+func equal(got, want any) bool {
+	c, ok := compare(got, want)
+	return ok && c == 0
+}
+
+// compare orders times, numbers, strings, and bools. ok is false when the
+// values can't be compared.
+func compare(a, b any) (c int, ok bool) {
+	if at, ok := toTime(a); ok {
+		if bt, ok := toTime(b); ok {
+			return at.Compare(bt), true
+		}
+	}
+	if af, ok := toFloat(a); ok {
+		if bf, ok := toFloat(b); ok {
+			switch {
+			case af < bf:
+				return -1, true
+			case af > bf:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if as, ok := a.(string); ok {
+		if bs, ok := b.(string); ok {
+			return strings.Compare(as, bs), true
+		}
+	}
+	if ab, ok := a.(bool); ok {
+		if bb, ok := b.(bool); ok && ab == bb {
+			return 0, true
+		}
+		return 1, true
+	}
+	return 0, false
+}
+
+func toTime(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		return t, err == nil
+	}
+	return time.Time{}, false
+}
+
+func toFloat(v any) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func toInt(v any) (int, bool) {
+	f, ok := toFloat(v)
+	return int(f), ok
+}
+
+func toList(v any) []any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	case []Object:
+		out := make([]any, len(v))
+		for i, o := range v {
+			out[i] = o
+		}
+		return out
+	case []map[string]any:
+		out := make([]any, len(v))
+		for i, o := range v {
+			out[i] = o
+		}
+		return out
+	default:
+		return []any{v}
+	}
+}
+
+func toObject(v any) map[string]any {
+	switch v := v.(type) {
+	case Object:
+		return v
+	case map[string]any:
+		return v
+	}
+	return nil
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func encodeCursor(i int) string {
+	return base64.StdEncoding.EncodeToString([]byte("cursor:" + strconv.Itoa(i)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	data, err := base64.StdEncoding.DecodeString(cursor)
+	if err == nil {
+		if n, ok := strings.CutPrefix(string(data), "cursor:"); ok {
+			if i, err := strconv.Atoi(n); err == nil {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("invalid cursor %q", cursor)
+}
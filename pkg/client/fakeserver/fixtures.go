@@ -0,0 +1,131 @@
+package fakeserver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Object is a fixture record of a GraphQL object type, keyed by schema field
+// name. Relations are resolved from ID fields the way the schema declares them:
+// a Service's account comes from its accountID, and an Account's services are
+// the Services whose accountID matches. Store a FieldFunc for fields that take
+// arguments, such as volumeStats(lookback).
+type Object map[string]any
+
+// FieldFunc computes a field value from its arguments.
+type FieldFunc func(args map[string]any) any
+
+// Fixtures is the control plane's data. It's safe for concurrent use.
+type Fixtures struct {
+	mu      sync.RWMutex
+	objects map[string]Object
+	order   []string // IDs in insertion order, the default list order
+	nextID  map[string]int
+	now     func() time.Time
+}
+
+// NewFixtures creates an empty data set.
+func NewFixtures() *Fixtures {
+	return &Fixtures{
+		objects: make(map[string]Object),
+		nextID:  make(map[string]int),
+		now:     time.Now,
+	}
+}
+
+// Add stores an object of typename. A missing id is generated, and missing
+// createdAt and updatedAt default to now. Returns the stored object.
+func (f *Fixtures) Add(typename string, obj Object) Object {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.add(typename, obj)
+}
+
+func (f *Fixtures) add(typename string, obj Object) Object {
+	stored := make(Object, len(obj)+4)
+	for k, v := range obj {
+		stored[k] = v
+	}
+	stored["__typename"] = typename
+
+	id, _ := stored["id"].(string)
+	if id == "" {
+		f.nextID[typename]++
+		id = fmt.Sprintf("%s-%d", strings.ToLower(typename), f.nextID[typename])
+		stored["id"] = id
+	}
+	now := f.now().UTC()
+	if _, ok := stored["createdAt"]; !ok {
+		stored["createdAt"] = now
+	}
+	if _, ok := stored["updatedAt"]; !ok {
+		stored["updatedAt"] = now
+	}
+
+	if _, exists := f.objects[id]; !exists {
+		f.order = append(f.order, id)
+	}
+	f.objects[id] = stored
+	return stored
+}
+
+// Get returns the object with id, or nil.
+func (f *Fixtures) Get(id string) Object {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.objects[id]
+}
+
+// All returns every object of typename in insertion order.
+func (f *Fixtures) All(typename string) []Object {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.all(typename)
+}
+
+func (f *Fixtures) all(typename string) []Object {
+	var objs []Object
+	for _, id := range f.order {
+		if obj := f.objects[id]; obj["__typename"] == typename {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+// Update sets fields on the object with id and bumps its updatedAt.
+// Returns the updated object, or nil if there's none.
+func (f *Fixtures) Update(id string, fields Object) Object {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	obj, ok := f.objects[id]
+	if !ok {
+		return nil
+	}
+	updated := make(Object, len(obj)+len(fields))
+	for k, v := range obj {
+		updated[k] = v
+	}
+	for k, v := range fields {
+		updated[k] = v
+	}
+	updated["updatedAt"] = f.now().UTC()
+	f.objects[id] = updated
+	return updated
+}
+
+// Delete removes the object with id.
+func (f *Fixtures) Delete(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.objects, id)
+	f.order = slices.DeleteFunc(f.order, func(s string) bool { return s == id })
+}
@@ -0,0 +1,176 @@
+package fakeserver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/usetero/cli/pkg/client"
+)
+
+// defaultMutations implements the mutations the CLI sends, roughly as the
+// control plane does
+func defaultMutations() map[string]MutationFunc {
+	return map[string]MutationFunc{
+		"createOrganizationAndBootstrap": createOrganizationAndBootstrap,
+		"createAccount":                  createAccount,
+		"updateAccount":                  updateAccount,
+		"validateDatadogApiKey":          validateDatadogAPIKey,
+		"createDatadogAccount":           createDatadogAccount,
+		"updateService":                  updateService,
+	}
+}
+
+// createOrganizationAndBootstrap creates an organization with a first account
+// and observability workspace
+func createOrganizationAndBootstrap(f *Fixtures, args map[string]any) (any, error) {
+	input := inputArg(args)
+	name, _ := input["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("name must not be empty")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	org := f.add("Organization", Object{"name": name})
+	account := f.add("Account", Object{"name": name, "organizationID": org["id"]})
+	workspace := f.add("Workspace", Object{"name": name, "accountID": account["id"], "purpose": "observability"})
+	return map[string]any{"organization": org, "account": account, "workspace": workspace}, nil
+}
+
+// createAccount creates an account in an existing organization
+func createAccount(f *Fixtures, args map[string]any) (any, error) {
+	input := inputArg(args)
+	name, _ := input["name"].(string)
+	orgID, _ := input["organizationID"].(string)
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("name must not be empty")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if org := f.objects[orgID]; org["__typename"] != "Organization" {
+		return nil, fmt.Errorf("organization %s not found", orgID)
+	}
+	return f.add("Account", Object{"name": name, "organizationID": orgID}), nil
+}
+
+// updateAccount sets an account's fields, e.g. name
+func updateAccount(f *Fixtures, args map[string]any) (any, error) {
+	id, _ := args["id"].(string)
+	fields := Object{}
+	for k, v := range inputArg(args) {
+		if v != nil {
+			fields[k] = v
+		}
+	}
+	if obj := f.Get(id); obj["__typename"] != "Account" {
+		return nil, fmt.Errorf("account %s not found", id)
+	}
+	return f.Update(id, fields), nil
+}
+
+// validateDatadogAPIKey accepts any key but an empty one or one starting with
+// "invalid", so tests can exercise both outcomes
+func validateDatadogAPIKey(f *Fixtures, args map[string]any) (any, error) {
+	key, _ := inputArg(args)["apiKey"].(string)
+	if key == "" || strings.HasPrefix(key, "invalid") {
+		return map[string]any{"valid": false, "error": "Invalid API key"}, nil
+	}
+	return map[string]any{"valid": true, "error": nil}, nil
+}
+
+// createDatadogAccount connects a Datadog account whose discovery has already
+// finished, so polling ends on the first request. Like validateDatadogAPIKey,
+// it rejects keys starting with "invalid".
+func createDatadogAccount(f *Fixtures, args map[string]any) (any, error) {
+	input := inputArg(args)
+	attrs, _ := input["attributes"].(map[string]any)
+	accountID, _ := attrs["accountID"].(string)
+	creds, _ := input["credentials"].(map[string]any)
+	for _, field := range []string{"apiKey", "appKey"} {
+		if key, _ := creds[field].(string); strings.HasPrefix(key, "invalid") {
+			return nil, &gqlerror.Error{
+				Message:    "Invalid Datadog credentials. Please verify your API key and Application key have the required permissions",
+				Extensions: map[string]any{"code": client.CodeInvalidDatadogCredentials},
+			}
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if account := f.objects[accountID]; account["__typename"] != "Account" {
+		return nil, fmt.Errorf("account %s not found", accountID)
+	}
+	now := f.now().UTC()
+	return f.add("DatadogAccount", Object{
+		"name":                      attrs["name"],
+		"site":                      attrs["site"],
+		"accountID":                 accountID,
+		"serviceDiscoveryProgress":  CompletedServiceDiscovery(len(f.servicesOf(accountID)), now),
+		"logEventDiscoveryProgress": CompletedLogEventDiscovery(now),
+	}), nil
+}
+
+// updateService sets a service's fields, e.g. enabled
+func updateService(f *Fixtures, args map[string]any) (any, error) {
+	id, _ := args["id"].(string)
+	fields := Object{}
+	for k, v := range inputArg(args) {
+		if v != nil {
+			fields[k] = v
+		}
+	}
+	if obj := f.Get(id); obj["__typename"] != "Service" {
+		return nil, fmt.Errorf("service %s not found", id)
+	}
+	return f.Update(id, fields), nil
+}
+
+// CompletedServiceDiscovery is a Datadog account's service discovery progress
+// once it's found n services.
+func CompletedServiceDiscovery(n int, at time.Time) map[string]any {
+	return map[string]any{
+		"status":              "READY",
+		"servicesDiscovered":  n,
+		"lastError":           nil,
+		"startedAt":           at.Add(-time.Minute),
+		"completedAt":         at,
+		"consecutiveFailures": 0,
+	}
+}
+
+// CompletedLogEventDiscovery is a Datadog account's log event discovery
+// progress once it's finished.
+func CompletedLogEventDiscovery(at time.Time) map[string]any {
+	return map[string]any{
+		"status":                 "READY",
+		"percentComplete":        100.0,
+		"weeklyVolume":           0,
+		"weeklyDiscoveredVolume": 0.0,
+		"lastError":              nil,
+		"startedAt":              at.Add(-time.Minute),
+		"completedAt":            at,
+		"consecutiveFailures":    0,
+	}
+}
+
+func (f *Fixtures) servicesOf(accountID string) []Object {
+	var services []Object
+	for _, s := range f.all("Service") {
+		if s["accountID"] == accountID {
+			services = append(services, s)
+		}
+	}
+	return services
+}
+
+func inputArg(args map[string]any) map[string]any {
+	input, _ := args["input"].(map[string]any)
+	return input
+}
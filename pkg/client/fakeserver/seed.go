@@ -0,0 +1,247 @@
+package fakeserver
+
+import (
+	"time"
+)
+
+// IDs of well-known objects in Seed.
+const (
+	OrganizationID   = "org-acme"
+	AccountID        = "account-production"
+	WorkspaceID      = "workspace-observability"
+	DatadogAccountID = "datadog-production"
+	DatadogIndexID   = "datadog-index-main"
+	ServiceID        = "service-checkout-api"
+	LogEventID       = "log-event-checkout-health"
+	LogRuleID        = "log-rule-checkout-health"
+	ChatID           = "chat-latest"
+)
+
+// Volume is the breakdown of a day's log volume, in events.
+type Volume struct {
+	Total    float64
+	Unknown  float64
+	Valuable float64
+	Waste    float64
+	Saved    float64
+}
+
+// windowDays is how many days each TimeWindow covers
+var windowDays = map[string]float64{"DAY": 1, "WEEK": 7, "MONTH": 30, "QUARTER": 90}
+
+// VolumeStats returns a volumeStats(lookback) field for a daily volume, scaled
+// to the lookback window and ending at now.
+func VolumeStats(daily Volume, now time.Time) FieldFunc {
+	return func(args map[string]any) any {
+		window, _ := args["lookback"].(string)
+		days, ok := windowDays[window]
+		if !ok {
+			days = windowDays["WEEK"]
+		}
+		percent := func(v float64) float64 {
+			if daily.Total == 0 {
+				return 0
+			}
+			return v / daily.Total * 100
+		}
+		return map[string]any{
+			"totalVolume":     daily.Total * days,
+			"unknownVolume":   daily.Unknown * days,
+			"valuableVolume":  daily.Valuable * days,
+			"wasteVolume":     daily.Waste * days,
+			"savedVolume":     daily.Saved * days,
+			"unknownPercent":  percent(daily.Unknown),
+			"valuablePercent": percent(daily.Valuable),
+			"wastePercent":    percent(daily.Waste),
+			"savedPercent":    percent(daily.Saved),
+			"periodStart":     now.Add(-time.Duration(days*24) * time.Hour),
+			"periodEnd":       now,
+		}
+	}
+}
+
+// seedService is a service in Seed and the log events it emits
+type seedService struct {
+	id, name, description string
+	enabled               bool
+	events                []seedEvent
+}
+
+type seedEvent struct {
+	id, name, description string
+	daily                 Volume
+	rule                  *seedRule
+}
+
+type seedRule struct {
+	id, retention, confidence, rationale, vrl string
+}
+
+var seedServices = []seedService{
+	{
+		id: ServiceID, name: "checkout-api", description: "Handles cart checkout and order placement", enabled: true,
+		events: []seedEvent{
+			{
+				id: LogEventID, name: "Health check succeeded", description: "Load balancer health probe returned 200",
+				daily: Volume{Total: 1_440_000, Waste: 1_440_000},
+				rule: &seedRule{
+					id: LogRuleID, retention: "drop", confidence: "high",
+					rationale: "Successful health checks repeat every few seconds and are never used for debugging.",
+					vrl:       `.path == "/healthz" && .status == 200`,
+				},
+			},
+			{
+				id: "log-event-checkout-order-placed", name: "Order placed", description: "An order was submitted and charged",
+				daily: Volume{Total: 52_000, Valuable: 52_000},
+				rule: &seedRule{
+					id: "log-rule-checkout-order-placed", retention: "keep", confidence: "high",
+					rationale: "Order events are needed for support investigations and audits.",
+				},
+			},
+			{
+				id: "log-event-checkout-cart-debug", name: "Cart contents dumped", description: "Debug dump of the cart on every request",
+				daily: Volume{Total: 610_000, Waste: 430_000, Saved: 180_000},
+				rule: &seedRule{
+					id: "log-rule-checkout-cart-debug", retention: "drop", confidence: "medium",
+					rationale: "Debug-level cart dumps duplicate the order record.",
+					vrl:       `.level == "debug" && .logger == "cart"`,
+				},
+			},
+		},
+	},
+	{
+		id: "service-payments-worker", name: "payments-worker", description: "Settles payments with the processor", enabled: true,
+		events: []seedEvent{
+			{
+				id: "log-event-payments-retry", name: "Payment retry scheduled", description: "A failed charge was queued for retry",
+				daily: Volume{Total: 8_400, Valuable: 8_400},
+			},
+			{
+				id: "log-event-payments-heartbeat", name: "Worker heartbeat", description: "Periodic liveness message",
+				daily: Volume{Total: 288_000, Waste: 288_000},
+				rule: &seedRule{
+					id: "log-rule-payments-heartbeat", retention: "drop", confidence: "high",
+					rationale: "Heartbeats are covered by metrics.",
+					vrl:       `.msg == "heartbeat"`,
+				},
+			},
+		},
+	},
+	{
+		id: "service-search-indexer", name: "search-indexer", description: "Keeps the product search index current", enabled: true,
+		events: []seedEvent{
+			{
+				id: "log-event-search-batch", name: "Batch indexed", description: "A batch of documents was written to the index",
+				daily: Volume{Total: 96_000, Unknown: 96_000},
+			},
+		},
+	},
+	{
+		id: "service-legacy-cron", name: "legacy-cron", description: "Nightly reports from the old platform",
+		events: []seedEvent{
+			{
+				id: "log-event-legacy-report", name: "Report generated", description: "A nightly report finished",
+				daily: Volume{Total: 1_200, Unknown: 1_200},
+			},
+		},
+	},
+}
+
+// Seed returns a realistic data set: an organization with a production
+// account, its observability workspace and connected Datadog account, and a
+// few services with log events, volume stats, and rules, some of them deployed.
+func Seed() *Fixtures {
+	f := NewFixtures()
+	now := f.now().UTC().Truncate(time.Second)
+	week := now.Add(-7 * 24 * time.Hour)
+
+	var accountDaily Volume
+	for _, s := range seedServices {
+		accountDaily = accountDaily.add(s.daily())
+	}
+
+	f.Add("Organization", Object{"id": OrganizationID, "name": "Acme", "createdAt": week, "updatedAt": week})
+	f.Add("Account", Object{
+		"id": AccountID, "organizationID": OrganizationID, "name": "Production", "createdAt": week, "updatedAt": week,
+		"volumeStats": VolumeStats(accountDaily, now),
+	})
+	f.Add("Workspace", Object{"id": WorkspaceID, "accountID": AccountID, "name": "Observability", "purpose": "observability", "createdAt": week, "updatedAt": week})
+	f.Add("DatadogAccount", Object{
+		"id": DatadogAccountID, "accountID": AccountID, "name": "Production", "site": "US1",
+		"createdAt": week, "updatedAt": week,
+		"serviceDiscoveryProgress":  CompletedServiceDiscovery(len(seedServices), week.Add(time.Hour)),
+		"logEventDiscoveryProgress": CompletedLogEventDiscovery(week.Add(2 * time.Hour)),
+	})
+	f.Add("DatadogLogIndex", Object{"id": DatadogIndexID, "datadogAccountID": DatadogAccountID, "name": "main", "createdAt": week, "lastSeenAt": now})
+
+	for _, s := range seedServices {
+		serviceDaily := s.daily()
+		weekly := int(serviceDaily.Total * 7)
+		f.Add("Service", Object{
+			"id": s.id, "accountID": AccountID, "name": s.name, "description": s.description, "enabled": s.enabled,
+			"initialWeeklyLogCount": weekly, "createdAt": week, "updatedAt": week,
+			"volumeStats": VolumeStats(serviceDaily, now),
+		})
+
+		for _, e := range s.events {
+			f.Add("LogEvent", Object{
+				"id": e.id, "serviceID": s.id, "name": e.name, "description": e.description,
+				"createdAt": week, "updatedAt": week,
+				"volumeStats": VolumeStats(e.daily, now),
+			})
+			for day := 6; day >= 0; day-- {
+				f.Add("LogEventVolume", Object{
+					"logEventID": e.id, "datadogLogIndexID": DatadogIndexID,
+					"timestamp": now.Add(-time.Duration(day) * 24 * time.Hour), "count": e.daily.Total,
+				})
+			}
+			if e.rule == nil {
+				continue
+			}
+			f.Add("LogRule", Object{
+				"id": e.rule.id, "logEventID": e.id, "workspaceID": WorkspaceID,
+				"retention": e.rule.retention, "confidence": e.rule.confidence, "rationale": e.rule.rationale,
+				"vrlScript": nilIfEmpty(e.rule.vrl), "createdByType": "ai", "createdByID": "tero",
+				"createdAt": week.Add(3 * time.Hour), "updatedAt": week.Add(3 * time.Hour),
+			})
+			if e.rule.vrl != "" {
+				f.Add("LogRuleDeployment", Object{
+					"logRuleID": e.rule.id, "datadogLogIndexID": DatadogIndexID,
+					"externalID": "exclusion-" + e.rule.id, "createdAt": week.Add(4 * time.Hour), "updatedAt": week.Add(4 * time.Hour),
+				})
+			}
+		}
+	}
+
+	f.Add("Chat", Object{"id": ChatID, "userID": "user-demo", "workspaceID": WorkspaceID, "title": "Where is checkout-api's log waste?", "createdAt": now.Add(-time.Hour), "updatedAt": now.Add(-30 * time.Minute)})
+	f.Add("Message", Object{"chatID": ChatID, "role": "user", "createdAt": now.Add(-time.Hour)})
+	f.Add("Message", Object{"chatID": ChatID, "role": "assistant", "stopReason": "end_turn", "createdAt": now.Add(-time.Hour).Add(10 * time.Second)})
+
+	return f
+}
+
+// daily sums the service's log events
+func (s seedService) daily() Volume {
+	var v Volume
+	for _, e := range s.events {
+		v = v.add(e.daily)
+	}
+	return v
+}
+
+func (v Volume) add(o Volume) Volume {
+	return Volume{
+		Total:    v.Total + o.Total,
+		Unknown:  v.Unknown + o.Unknown,
+		Valuable: v.Valuable + o.Valuable,
+		Waste:    v.Waste + o.Waste,
+		Saved:    v.Saved + o.Saved,
+	}
+}
+
+func nilIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
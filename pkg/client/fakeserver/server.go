@@ -0,0 +1,192 @@
+// Package fakeserver is an in-memory stand-in for the control plane's GraphQL
+// API. It loads the real schema, executes the CLI's operations against fixture
+// data, and can inject errors and latency. Tests start one with
+// clienttest.NewServer, and demo mode serves one on a local port.
+//
+//	http.ListenAndServe(addr, fakeserver.NewHandler(fakeserver.Seed()))
+package fakeserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+
+	"github.com/usetero/cli/pkg/client"
+)
+
+// Fault makes matching requests fail or slow down.
+type Fault struct {
+	// Latency delays the response, or the request's context ending.
+	Latency time.Duration
+
+	// StatusCode fails the request with this HTTP status, e.g. 401 or 503.
+	StatusCode int
+
+	// Message fails the request with a GraphQL error.
+	Message string
+
+	// Extensions are added to the GraphQL error, e.g. {"code": "FORBIDDEN"}.
+	Extensions map[string]any
+
+	// Times is how many requests the fault applies to. Zero means every one.
+	Times int
+}
+
+// Handler serves GraphQL requests from fixtures. It handles one request at a
+// time, so fixtures change in the order requests arrive.
+type Handler struct {
+	Fixtures *Fixtures
+
+	schema *ast.Schema
+
+	mu         sync.Mutex
+	docs       map[string]*ast.QueryDocument
+	mutations  map[string]MutationFunc
+	faults     map[string][]*Fault
+	operations []string
+}
+
+// NewHandler creates a handler serving fixtures.
+func NewHandler(fixtures *Fixtures) *Handler {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: client.Schema})
+	return &Handler{
+		Fixtures:  fixtures,
+		schema:    schema,
+		docs:      make(map[string]*ast.QueryDocument),
+		mutations: defaultMutations(),
+		faults:    make(map[string][]*Fault),
+	}
+}
+
+// HandleMutation replaces the implementation of a root mutation field, e.g.
+// "validateDatadogApiKey".
+func (h *Handler) HandleMutation(field string, fn MutationFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.mutations[field] = fn
+}
+
+// Inject applies fault to requests for operation, e.g. "ListServices". An
+// empty operation matches every request. Faults apply in the order injected.
+func (h *Handler) Inject(operation string, fault Fault) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.faults[operation] = append(h.faults[operation], &fault)
+}
+
+// Operations returns the names of the operations received, in order.
+func (h *Handler) Operations() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string(nil), h.operations...)
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type response struct {
+	Data   any           `json:"data"`
+	Errors gqlerror.List `json:"errors,omitempty"`
+}
+
+// ServeHTTP executes a GraphQL request.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "use POST", http.StatusMethodNotAllowed)
+		return
+	}
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.mu.Lock()
+	h.operations = append(h.operations, req.OperationName)
+	fault := h.takeFault(req.OperationName)
+	h.mu.Unlock()
+
+	if fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			http.Error(w, http.StatusText(fault.StatusCode), fault.StatusCode)
+			return
+		}
+		if fault.Message != "" {
+			writeJSON(w, response{Errors: gqlerror.List{{Message: fault.Message, Extensions: fault.Extensions}}})
+			return
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	writeJSON(w, h.execute(req))
+}
+
+// takeFault returns the next fault for operation, if any
+func (h *Handler) takeFault(operation string) *Fault {
+	for _, key := range []string{operation, ""} {
+		faults := h.faults[key]
+		if len(faults) == 0 {
+			continue
+		}
+		fault := faults[0]
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				h.faults[key] = faults[1:]
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+// execute parses, validates, and runs a request
+func (h *Handler) execute(req request) response {
+	doc, ok := h.docs[req.Query]
+	if !ok {
+		var errs gqlerror.List
+		doc, errs = gqlparser.LoadQuery(h.schema, req.Query)
+		if len(errs) > 0 {
+			return response{Errors: errs}
+		}
+		h.docs[req.Query] = doc
+	}
+
+	op := doc.Operations.ForName(req.OperationName)
+	if op == nil {
+		return response{Errors: gqlerror.List{gqlerror.Errorf("operation %q not found", req.OperationName)}}
+	}
+	vars, err := validator.VariableValues(h.schema, op, req.Variables)
+	if err != nil {
+		return response{Errors: gqlerror.List{gqlerror.WrapIfUnwrapped(err)}}
+	}
+
+	e := &executor{schema: h.schema, fixtures: h.Fixtures, mutations: h.mutations, vars: vars}
+	data := e.execute(op)
+	return response{Data: data, Errors: e.errs}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
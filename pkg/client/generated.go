@@ -272,6 +272,35 @@ var AllDatadogAccountSite = []DatadogAccountSite{
 	DatadogAccountSiteAp2,
 }
 
+// DisableServiceResponse is returned by DisableService on success.
+type DisableServiceResponse struct {
+	UpdateService DisableServiceUpdateService `json:"updateService"`
+}
+
+// GetUpdateService returns DisableServiceResponse.UpdateService, and is useful for accessing the field via an interface.
+func (v *DisableServiceResponse) GetUpdateService() DisableServiceUpdateService {
+	return v.UpdateService
+}
+
+// DisableServiceUpdateService includes the requested fields of the GraphQL type Service.
+type DisableServiceUpdateService struct {
+	// Unique identifier of the service
+	Id string `json:"id"`
+	// Service identifier in telemetry (e.g., 'checkout-service')
+	Name string `json:"name"`
+	// Whether telemetry analysis is enabled
+	Enabled bool `json:"enabled"`
+}
+
+// GetId returns DisableServiceUpdateService.Id, and is useful for accessing the field via an interface.
+func (v *DisableServiceUpdateService) GetId() string { return v.Id }
+
+// GetName returns DisableServiceUpdateService.Name, and is useful for accessing the field via an interface.
+func (v *DisableServiceUpdateService) GetName() string { return v.Name }
+
+// GetEnabled returns DisableServiceUpdateService.Enabled, and is useful for accessing the field via an interface.
+func (v *DisableServiceUpdateService) GetEnabled() bool { return v.Enabled }
+
 // Current state of a discovery operation.
 type DiscoveryStatus string
 
@@ -398,1194 +427,5650 @@ type GetAccountResponse struct {
 // GetAccounts returns GetAccountResponse.Accounts, and is useful for accessing the field via an interface.
 func (v *GetAccountResponse) GetAccounts() GetAccountAccountsAccountConnection { return v.Accounts }
 
-// GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection includes the requested fields of the GraphQL type DatadogAccountConnection.
+// GetAccountSummaryAccountsAccountConnection includes the requested fields of the GraphQL type AccountConnection.
 // The GraphQL type's documentation follows.
 //
 // A connection to a list of items.
-type GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection struct {
+type GetAccountSummaryAccountsAccountConnection struct {
 	// A list of edges.
-	Edges []GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge `json:"edges"`
+	Edges []GetAccountSummaryAccountsAccountConnectionEdgesAccountEdge `json:"edges"`
 }
 
-// GetEdges returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection.Edges, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection) GetEdges() []GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge {
+// GetEdges returns GetAccountSummaryAccountsAccountConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnection) GetEdges() []GetAccountSummaryAccountsAccountConnectionEdgesAccountEdge {
 	return v.Edges
 }
 
-// GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge includes the requested fields of the GraphQL type DatadogAccountEdge.
+// GetAccountSummaryAccountsAccountConnectionEdgesAccountEdge includes the requested fields of the GraphQL type AccountEdge.
 // The GraphQL type's documentation follows.
 //
 // An edge in a connection.
-type GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge struct {
+type GetAccountSummaryAccountsAccountConnectionEdgesAccountEdge struct {
 	// The item at the end of the edge.
-	Node GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount `json:"node"`
+	Node GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount `json:"node"`
 }
 
-// GetNode returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge.Node, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge) GetNode() GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount {
+// GetNode returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdge) GetNode() GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount {
 	return v.Node
 }
 
-// GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount includes the requested fields of the GraphQL type DatadogAccount.
-type GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount struct {
-	// Unique identifier of the Datadog configuration
+// GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount includes the requested fields of the GraphQL type Account.
+type GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount struct {
+	// Unique identifier of the account
 	Id string `json:"id"`
-	// Aggregate log event discovery progress across all enabled services in this Datadog account.
-	// Shows overall discovery status and progress percentage.
-	LogEventDiscoveryProgress GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress `json:"logEventDiscoveryProgress"`
+	// Human-readable name within the organization
+	Name string `json:"name"`
+	// Organization this account belongs to
+	Organization GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountOrganization `json:"organization"`
+	// Services that produce telemetry
+	Services []GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService      `json:"services"`
+	Day      GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate  `json:"day"`
+	Week     GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate `json:"week"`
 }
 
-// GetId returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount.Id, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount) GetId() string {
+// GetId returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Id, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetId() string {
 	return v.Id
 }
 
-// GetLogEventDiscoveryProgress returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount.LogEventDiscoveryProgress, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount) GetLogEventDiscoveryProgress() GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress {
-	return v.LogEventDiscoveryProgress
+// GetName returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Name, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetName() string {
+	return v.Name
 }
 
-// GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress includes the requested fields of the GraphQL type LogEventDiscoveryProgress.
+// GetOrganization returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Organization, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetOrganization() GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountOrganization {
+	return v.Organization
+}
+
+// GetServices returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Services, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetServices() []GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService {
+	return v.Services
+}
+
+// GetDay returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Day, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetDay() GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate {
+	return v.Day
+}
+
+// GetWeek returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Week, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetWeek() GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate {
+	return v.Week
+}
+
+// GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate includes the requested fields of the GraphQL type LogVolumeAggregate.
 // The GraphQL type's documentation follows.
 //
-// Log event discovery progress for a service from an integration account.
-// Tracks discovery status and how much of the service's logs have been cataloged.
-type GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress struct {
-	// Current status of log event discovery
-	Status DiscoveryStatus `json:"status"`
-	// Discovery progress percentage (0-100), null if no volume data yet
-	PercentComplete float64 `json:"percentComplete"`
-	// Weekly log volume reported by the integration (last 7 days)
-	WeeklyVolume int `json:"weeklyVolume"`
-	// Weekly volume of logs discovered and cataloged (last 7 days)
-	WeeklyDiscoveredVolume float64 `json:"weeklyDiscoveredVolume"`
-	// Last error message if discovery failed
-	LastError string `json:"lastError"`
-	// When the current/most recent discovery run started
-	StartedAt time.Time `json:"startedAt"`
-	// When the most recent discovery run completed (successfully or with error)
-	CompletedAt time.Time `json:"completedAt"`
-	// Number of consecutive failures
-	ConsecutiveFailures int `json:"consecutiveFailures"`
+// Aggregated telemetry volume statistics over a time period.
+// This is a pie chart breakdown: unknown + valuable + waste + saved = total.
+type GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate struct {
+	LogVolumeStats `json:"-"`
 }
 
-// GetStatus returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.Status, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetStatus() DiscoveryStatus {
-	return v.Status
+// GetTotalVolume returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.TotalVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetTotalVolume() float64 {
+	return v.LogVolumeStats.TotalVolume
 }
 
-// GetPercentComplete returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.PercentComplete, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetPercentComplete() float64 {
-	return v.PercentComplete
+// GetUnknownVolume returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.UnknownVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetUnknownVolume() float64 {
+	return v.LogVolumeStats.UnknownVolume
 }
 
-// GetWeeklyVolume returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.WeeklyVolume, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetWeeklyVolume() int {
-	return v.WeeklyVolume
+// GetValuableVolume returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.ValuableVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetValuableVolume() float64 {
+	return v.LogVolumeStats.ValuableVolume
 }
 
-// GetWeeklyDiscoveredVolume returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.WeeklyDiscoveredVolume, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetWeeklyDiscoveredVolume() float64 {
-	return v.WeeklyDiscoveredVolume
+// GetWasteVolume returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.WasteVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetWasteVolume() float64 {
+	return v.LogVolumeStats.WasteVolume
 }
 
-// GetLastError returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.LastError, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetLastError() string {
-	return v.LastError
+// GetSavedVolume returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.SavedVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetSavedVolume() float64 {
+	return v.LogVolumeStats.SavedVolume
 }
 
-// GetStartedAt returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.StartedAt, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetStartedAt() time.Time {
-	return v.StartedAt
+// GetUnknownPercent returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.UnknownPercent, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetUnknownPercent() float64 {
+	return v.LogVolumeStats.UnknownPercent
 }
 
-// GetCompletedAt returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.CompletedAt, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetCompletedAt() time.Time {
-	return v.CompletedAt
+// GetValuablePercent returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.ValuablePercent, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetValuablePercent() float64 {
+	return v.LogVolumeStats.ValuablePercent
 }
 
-// GetConsecutiveFailures returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.ConsecutiveFailures, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetConsecutiveFailures() int {
-	return v.ConsecutiveFailures
+// GetWastePercent returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.WastePercent, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetWastePercent() float64 {
+	return v.LogVolumeStats.WastePercent
 }
 
-// GetDatadogAccountLogDiscoveryProgressResponse is returned by GetDatadogAccountLogDiscoveryProgress on success.
-type GetDatadogAccountLogDiscoveryProgressResponse struct {
-	// Query connected Datadog accounts.
-	DatadogAccounts GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection `json:"datadogAccounts"`
+// GetSavedPercent returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.SavedPercent, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetSavedPercent() float64 {
+	return v.LogVolumeStats.SavedPercent
 }
 
-// GetDatadogAccounts returns GetDatadogAccountLogDiscoveryProgressResponse.DatadogAccounts, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountLogDiscoveryProgressResponse) GetDatadogAccounts() GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection {
-	return v.DatadogAccounts
+// GetPeriodStart returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.PeriodStart, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetPeriodStart() time.Time {
+	return v.LogVolumeStats.PeriodStart
 }
 
-// GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection includes the requested fields of the GraphQL type DatadogAccountConnection.
-// The GraphQL type's documentation follows.
-//
-// A connection to a list of items.
-type GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection struct {
-	// A list of edges.
-	Edges []GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge `json:"edges"`
+// GetPeriodEnd returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate.PeriodEnd, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) GetPeriodEnd() time.Time {
+	return v.LogVolumeStats.PeriodEnd
 }
 
-// GetEdges returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection.Edges, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection) GetEdges() []GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge {
-	return v.Edges
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogVolumeStats)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge includes the requested fields of the GraphQL type DatadogAccountEdge.
-// The GraphQL type's documentation follows.
-//
-// An edge in a connection.
-type GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge struct {
-	// The item at the end of the edge.
-	Node GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount `json:"node"`
+type __premarshalGetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate struct {
+	TotalVolume float64 `json:"totalVolume"`
+
+	UnknownVolume float64 `json:"unknownVolume"`
+
+	ValuableVolume float64 `json:"valuableVolume"`
+
+	WasteVolume float64 `json:"wasteVolume"`
+
+	SavedVolume float64 `json:"savedVolume"`
+
+	UnknownPercent float64 `json:"unknownPercent"`
+
+	ValuablePercent float64 `json:"valuablePercent"`
+
+	WastePercent float64 `json:"wastePercent"`
+
+	SavedPercent float64 `json:"savedPercent"`
+
+	PeriodStart time.Time `json:"periodStart"`
+
+	PeriodEnd time.Time `json:"periodEnd"`
 }
 
-// GetNode returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge.Node, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge) GetNode() GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount {
-	return v.Node
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount includes the requested fields of the GraphQL type DatadogAccount.
-type GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount struct {
-	// Unique identifier of the Datadog configuration
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate) __premarshalJSON() (*__premarshalGetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate, error) {
+	var retval __premarshalGetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountDayLogVolumeAggregate
+
+	retval.TotalVolume = v.LogVolumeStats.TotalVolume
+	retval.UnknownVolume = v.LogVolumeStats.UnknownVolume
+	retval.ValuableVolume = v.LogVolumeStats.ValuableVolume
+	retval.WasteVolume = v.LogVolumeStats.WasteVolume
+	retval.SavedVolume = v.LogVolumeStats.SavedVolume
+	retval.UnknownPercent = v.LogVolumeStats.UnknownPercent
+	retval.ValuablePercent = v.LogVolumeStats.ValuablePercent
+	retval.WastePercent = v.LogVolumeStats.WastePercent
+	retval.SavedPercent = v.LogVolumeStats.SavedPercent
+	retval.PeriodStart = v.LogVolumeStats.PeriodStart
+	retval.PeriodEnd = v.LogVolumeStats.PeriodEnd
+	return &retval, nil
+}
+
+// GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountOrganization includes the requested fields of the GraphQL type Organization.
+type GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountOrganization struct {
+	// Unique identifier of the organization
 	Id string `json:"id"`
-	// Service discovery progress for this Datadog account.
-	// Shows whether service discovery is running, completed, or has errors.
-	ServiceDiscoveryProgress GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress `json:"serviceDiscoveryProgress"`
+	// Human-readable name, unique across the system
+	Name string `json:"name"`
 }
 
-// GetId returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount.Id, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount) GetId() string {
+// GetId returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountOrganization.Id, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountOrganization) GetId() string {
 	return v.Id
 }
 
-// GetServiceDiscoveryProgress returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount.ServiceDiscoveryProgress, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount) GetServiceDiscoveryProgress() GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress {
-	return v.ServiceDiscoveryProgress
+// GetName returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountOrganization.Name, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountOrganization) GetName() string {
+	return v.Name
 }
 
-// GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress includes the requested fields of the GraphQL type ServiceDiscoveryProgress.
-// The GraphQL type's documentation follows.
-//
-// Service discovery progress for an integration account.
-// Tracks whether services have been discovered from the integration.
-type GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress struct {
-	// Current status of service discovery
-	Status DiscoveryStatus `json:"status"`
-	// Number of services discovered from this integration
-	ServicesDiscovered int `json:"servicesDiscovered"`
-	// Last error message if discovery failed
-	LastError string `json:"lastError"`
-	// When the current/most recent discovery run started
-	StartedAt time.Time `json:"startedAt"`
-	// When the most recent discovery run completed (successfully or with error)
-	CompletedAt time.Time `json:"completedAt"`
-	// Number of consecutive failures
-	ConsecutiveFailures int `json:"consecutiveFailures"`
+// GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService includes the requested fields of the GraphQL type Service.
+type GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService struct {
+	// Unique identifier of the service
+	Id string `json:"id"`
 }
 
-// GetStatus returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.Status, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetStatus() DiscoveryStatus {
-	return v.Status
+// GetId returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService.Id, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService) GetId() string {
+	return v.Id
 }
 
-// GetServicesDiscovered returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.ServicesDiscovered, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetServicesDiscovered() int {
-	return v.ServicesDiscovered
+// GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate includes the requested fields of the GraphQL type LogVolumeAggregate.
+// The GraphQL type's documentation follows.
+//
+// Aggregated telemetry volume statistics over a time period.
+// This is a pie chart breakdown: unknown + valuable + waste + saved = total.
+type GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate struct {
+	LogVolumeStats `json:"-"`
 }
 
-// GetLastError returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.LastError, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetLastError() string {
-	return v.LastError
+// GetTotalVolume returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.TotalVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetTotalVolume() float64 {
+	return v.LogVolumeStats.TotalVolume
 }
 
-// GetStartedAt returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.StartedAt, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetStartedAt() time.Time {
-	return v.StartedAt
+// GetUnknownVolume returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.UnknownVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetUnknownVolume() float64 {
+	return v.LogVolumeStats.UnknownVolume
 }
 
-// GetCompletedAt returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.CompletedAt, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetCompletedAt() time.Time {
-	return v.CompletedAt
+// GetValuableVolume returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.ValuableVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetValuableVolume() float64 {
+	return v.LogVolumeStats.ValuableVolume
 }
 
-// GetConsecutiveFailures returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.ConsecutiveFailures, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetConsecutiveFailures() int {
-	return v.ConsecutiveFailures
+// GetWasteVolume returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.WasteVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetWasteVolume() float64 {
+	return v.LogVolumeStats.WasteVolume
 }
 
-// GetDatadogAccountServiceDiscoveryProgressResponse is returned by GetDatadogAccountServiceDiscoveryProgress on success.
-type GetDatadogAccountServiceDiscoveryProgressResponse struct {
-	// Query connected Datadog accounts.
-	DatadogAccounts GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection `json:"datadogAccounts"`
+// GetSavedVolume returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.SavedVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetSavedVolume() float64 {
+	return v.LogVolumeStats.SavedVolume
 }
 
-// GetDatadogAccounts returns GetDatadogAccountServiceDiscoveryProgressResponse.DatadogAccounts, and is useful for accessing the field via an interface.
-func (v *GetDatadogAccountServiceDiscoveryProgressResponse) GetDatadogAccounts() GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection {
-	return v.DatadogAccounts
+// GetUnknownPercent returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.UnknownPercent, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetUnknownPercent() float64 {
+	return v.LogVolumeStats.UnknownPercent
 }
 
-// GetServiceByNameResponse is returned by GetServiceByName on success.
-type GetServiceByNameResponse struct {
-	// Query services in your system.
-	Services GetServiceByNameServicesServiceConnection `json:"services"`
+// GetValuablePercent returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.ValuablePercent, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetValuablePercent() float64 {
+	return v.LogVolumeStats.ValuablePercent
 }
 
-// GetServices returns GetServiceByNameResponse.Services, and is useful for accessing the field via an interface.
-func (v *GetServiceByNameResponse) GetServices() GetServiceByNameServicesServiceConnection {
-	return v.Services
+// GetWastePercent returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.WastePercent, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetWastePercent() float64 {
+	return v.LogVolumeStats.WastePercent
 }
 
-// GetServiceByNameServicesServiceConnection includes the requested fields of the GraphQL type ServiceConnection.
-// The GraphQL type's documentation follows.
-//
-// A connection to a list of items.
-type GetServiceByNameServicesServiceConnection struct {
-	// A list of edges.
-	Edges []GetServiceByNameServicesServiceConnectionEdgesServiceEdge `json:"edges"`
+// GetSavedPercent returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.SavedPercent, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetSavedPercent() float64 {
+	return v.LogVolumeStats.SavedPercent
 }
 
-// GetEdges returns GetServiceByNameServicesServiceConnection.Edges, and is useful for accessing the field via an interface.
-func (v *GetServiceByNameServicesServiceConnection) GetEdges() []GetServiceByNameServicesServiceConnectionEdgesServiceEdge {
-	return v.Edges
+// GetPeriodStart returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.PeriodStart, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetPeriodStart() time.Time {
+	return v.LogVolumeStats.PeriodStart
 }
 
-// GetServiceByNameServicesServiceConnectionEdgesServiceEdge includes the requested fields of the GraphQL type ServiceEdge.
-// The GraphQL type's documentation follows.
-//
-// An edge in a connection.
-type GetServiceByNameServicesServiceConnectionEdgesServiceEdge struct {
-	// The item at the end of the edge.
-	Node GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService `json:"node"`
+// GetPeriodEnd returns GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate.PeriodEnd, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) GetPeriodEnd() time.Time {
+	return v.LogVolumeStats.PeriodEnd
 }
 
-// GetNode returns GetServiceByNameServicesServiceConnectionEdgesServiceEdge.Node, and is useful for accessing the field via an interface.
-func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdge) GetNode() GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService {
-	return v.Node
-}
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) UnmarshalJSON(b []byte) error {
 
-// GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService includes the requested fields of the GraphQL type Service.
-type GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService struct {
-	// Unique identifier of the service
-	Id string `json:"id"`
-	// Service identifier in telemetry (e.g., 'checkout-service')
-	Name string `json:"name"`
-	// Description of service and telemetry characteristics
-	Description string `json:"description"`
-	// Whether telemetry analysis is enabled
-	Enabled bool `json:"enabled"`
-	// When the service was created
-	CreatedAt time.Time `json:"createdAt"`
-	// When the service was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogVolumeStats)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetId returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.Id, and is useful for accessing the field via an interface.
-func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetId() string {
+type __premarshalGetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate struct {
+	TotalVolume float64 `json:"totalVolume"`
+
+	UnknownVolume float64 `json:"unknownVolume"`
+
+	ValuableVolume float64 `json:"valuableVolume"`
+
+	WasteVolume float64 `json:"wasteVolume"`
+
+	SavedVolume float64 `json:"savedVolume"`
+
+	UnknownPercent float64 `json:"unknownPercent"`
+
+	ValuablePercent float64 `json:"valuablePercent"`
+
+	WastePercent float64 `json:"wastePercent"`
+
+	SavedPercent float64 `json:"savedPercent"`
+
+	PeriodStart time.Time `json:"periodStart"`
+
+	PeriodEnd time.Time `json:"periodEnd"`
+}
+
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate) __premarshalJSON() (*__premarshalGetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate, error) {
+	var retval __premarshalGetAccountSummaryAccountsAccountConnectionEdgesAccountEdgeNodeAccountWeekLogVolumeAggregate
+
+	retval.TotalVolume = v.LogVolumeStats.TotalVolume
+	retval.UnknownVolume = v.LogVolumeStats.UnknownVolume
+	retval.ValuableVolume = v.LogVolumeStats.ValuableVolume
+	retval.WasteVolume = v.LogVolumeStats.WasteVolume
+	retval.SavedVolume = v.LogVolumeStats.SavedVolume
+	retval.UnknownPercent = v.LogVolumeStats.UnknownPercent
+	retval.ValuablePercent = v.LogVolumeStats.ValuablePercent
+	retval.WastePercent = v.LogVolumeStats.WastePercent
+	retval.SavedPercent = v.LogVolumeStats.SavedPercent
+	retval.PeriodStart = v.LogVolumeStats.PeriodStart
+	retval.PeriodEnd = v.LogVolumeStats.PeriodEnd
+	return &retval, nil
+}
+
+// GetAccountSummaryResponse is returned by GetAccountSummary on success.
+type GetAccountSummaryResponse struct {
+	// Query accounts. Accounts belong to an organization and contain services and workspaces.
+	Accounts GetAccountSummaryAccountsAccountConnection `json:"accounts"`
+}
+
+// GetAccounts returns GetAccountSummaryResponse.Accounts, and is useful for accessing the field via an interface.
+func (v *GetAccountSummaryResponse) GetAccounts() GetAccountSummaryAccountsAccountConnection {
+	return v.Accounts
+}
+
+// GetAccountVolumeStatsAccountsAccountConnection includes the requested fields of the GraphQL type AccountConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type GetAccountVolumeStatsAccountsAccountConnection struct {
+	// A list of edges.
+	Edges []GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdge `json:"edges"`
+}
+
+// GetEdges returns GetAccountVolumeStatsAccountsAccountConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnection) GetEdges() []GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdge {
+	return v.Edges
+}
+
+// GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdge includes the requested fields of the GraphQL type AccountEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdge struct {
+	// The item at the end of the edge.
+	Node GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount `json:"node"`
+}
+
+// GetNode returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdge) GetNode() GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount {
+	return v.Node
+}
+
+// GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount includes the requested fields of the GraphQL type Account.
+type GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount struct {
+	// Unique identifier of the account
+	Id string `json:"id"`
+	// Human-readable name within the organization
+	Name        string                                                                                                 `json:"name"`
+	VolumeStats GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate `json:"volumeStats"`
+	// Services that produce telemetry
+	Services []GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService `json:"services"`
+}
+
+// GetId returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Id, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetId() string {
 	return v.Id
 }
 
-// GetName returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.Name, and is useful for accessing the field via an interface.
-func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetName() string {
+// GetName returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Name, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetName() string {
 	return v.Name
 }
 
-// GetDescription returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.Description, and is useful for accessing the field via an interface.
-func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetDescription() string {
-	return v.Description
+// GetVolumeStats returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount.VolumeStats, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetVolumeStats() GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate {
+	return v.VolumeStats
 }
 
-// GetEnabled returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.Enabled, and is useful for accessing the field via an interface.
-func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetEnabled() bool {
-	return v.Enabled
+// GetServices returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Services, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetServices() []GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService {
+	return v.Services
 }
 
-// GetCreatedAt returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetCreatedAt() time.Time {
-	return v.CreatedAt
+// GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService includes the requested fields of the GraphQL type Service.
+type GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService struct {
+	// Unique identifier of the service
+	Id string `json:"id"`
+	// Service identifier in telemetry (e.g., 'checkout-service')
+	Name string `json:"name"`
+	// Whether telemetry analysis is enabled
+	Enabled bool `json:"enabled"`
+	// Get telemetry volume statistics for this service over a specified time window
+	VolumeStats GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate `json:"volumeStats"`
 }
 
-// GetUpdatedAt returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetUpdatedAt() time.Time {
-	return v.UpdatedAt
+// GetId returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService.Id, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService) GetId() string {
+	return v.Id
 }
 
-// GetServiceNode includes the requested fields of the GraphQL interface Node.
-//
-// GetServiceNode is implemented by the following types:
-// GetServiceNodeAccount
-// GetServiceNodeChat
-// GetServiceNodeDatadogAccount
-// GetServiceNodeDatadogLogIndex
-// GetServiceNodeLogEvent
-// GetServiceNodeLogEventVolume
-// GetServiceNodeLogRule
-// GetServiceNodeLogRuleDeployment
-// GetServiceNodeMessage
-// GetServiceNodeOrganization
-// GetServiceNodeService
-// GetServiceNodeServiceLogVolume
-// GetServiceNodeTeam
-// GetServiceNodeWorkspace
+// GetName returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService.Name, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService) GetName() string {
+	return v.Name
+}
+
+// GetEnabled returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService.Enabled, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService) GetEnabled() bool {
+	return v.Enabled
+}
+
+// GetVolumeStats returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService.VolumeStats, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesService) GetVolumeStats() GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate {
+	return v.VolumeStats
+}
+
+// GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate includes the requested fields of the GraphQL type LogVolumeAggregate.
 // The GraphQL type's documentation follows.
 //
-// An object with an ID.
-// Follows the [Relay Global Object Identification Specification](https://relay.dev/graphql/objectidentification.htm)
-type GetServiceNode interface {
-	implementsGraphQLInterfaceGetServiceNode()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
+// Aggregated telemetry volume statistics over a time period.
+// This is a pie chart breakdown: unknown + valuable + waste + saved = total.
+type GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate struct {
+	LogVolumeStats `json:"-"`
 }
 
-func (v *GetServiceNodeAccount) implementsGraphQLInterfaceGetServiceNode()           {}
-func (v *GetServiceNodeChat) implementsGraphQLInterfaceGetServiceNode()              {}
-func (v *GetServiceNodeDatadogAccount) implementsGraphQLInterfaceGetServiceNode()    {}
-func (v *GetServiceNodeDatadogLogIndex) implementsGraphQLInterfaceGetServiceNode()   {}
-func (v *GetServiceNodeLogEvent) implementsGraphQLInterfaceGetServiceNode()          {}
-func (v *GetServiceNodeLogEventVolume) implementsGraphQLInterfaceGetServiceNode()    {}
-func (v *GetServiceNodeLogRule) implementsGraphQLInterfaceGetServiceNode()           {}
-func (v *GetServiceNodeLogRuleDeployment) implementsGraphQLInterfaceGetServiceNode() {}
-func (v *GetServiceNodeMessage) implementsGraphQLInterfaceGetServiceNode()           {}
-func (v *GetServiceNodeOrganization) implementsGraphQLInterfaceGetServiceNode()      {}
-func (v *GetServiceNodeService) implementsGraphQLInterfaceGetServiceNode()           {}
-func (v *GetServiceNodeServiceLogVolume) implementsGraphQLInterfaceGetServiceNode()  {}
-func (v *GetServiceNodeTeam) implementsGraphQLInterfaceGetServiceNode()              {}
-func (v *GetServiceNodeWorkspace) implementsGraphQLInterfaceGetServiceNode()         {}
+// GetTotalVolume returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.TotalVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetTotalVolume() float64 {
+	return v.LogVolumeStats.TotalVolume
+}
+
+// GetUnknownVolume returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.UnknownVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetUnknownVolume() float64 {
+	return v.LogVolumeStats.UnknownVolume
+}
+
+// GetValuableVolume returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.ValuableVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetValuableVolume() float64 {
+	return v.LogVolumeStats.ValuableVolume
+}
+
+// GetWasteVolume returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.WasteVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetWasteVolume() float64 {
+	return v.LogVolumeStats.WasteVolume
+}
+
+// GetSavedVolume returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.SavedVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetSavedVolume() float64 {
+	return v.LogVolumeStats.SavedVolume
+}
+
+// GetUnknownPercent returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.UnknownPercent, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetUnknownPercent() float64 {
+	return v.LogVolumeStats.UnknownPercent
+}
+
+// GetValuablePercent returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.ValuablePercent, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetValuablePercent() float64 {
+	return v.LogVolumeStats.ValuablePercent
+}
+
+// GetWastePercent returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.WastePercent, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetWastePercent() float64 {
+	return v.LogVolumeStats.WastePercent
+}
+
+// GetSavedPercent returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.SavedPercent, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetSavedPercent() float64 {
+	return v.LogVolumeStats.SavedPercent
+}
+
+// GetPeriodStart returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.PeriodStart, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetPeriodStart() time.Time {
+	return v.LogVolumeStats.PeriodStart
+}
+
+// GetPeriodEnd returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate.PeriodEnd, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) GetPeriodEnd() time.Time {
+	return v.LogVolumeStats.PeriodEnd
+}
+
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) UnmarshalJSON(b []byte) error {
 
-func __unmarshalGetServiceNode(b []byte, v *GetServiceNode) error {
 	if string(b) == "null" {
 		return nil
 	}
 
-	var tn struct {
-		TypeName string `json:"__typename"`
+	var firstPass struct {
+		*GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate
+		graphql.NoUnmarshalJSON
 	}
-	err := json.Unmarshal(b, &tn)
+	firstPass.GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate = v
+
+	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	switch tn.TypeName {
-	case "Account":
-		*v = new(GetServiceNodeAccount)
-		return json.Unmarshal(b, *v)
-	case "Chat":
-		*v = new(GetServiceNodeChat)
-		return json.Unmarshal(b, *v)
-	case "DatadogAccount":
-		*v = new(GetServiceNodeDatadogAccount)
-		return json.Unmarshal(b, *v)
-	case "DatadogLogIndex":
-		*v = new(GetServiceNodeDatadogLogIndex)
-		return json.Unmarshal(b, *v)
-	case "LogEvent":
-		*v = new(GetServiceNodeLogEvent)
-		return json.Unmarshal(b, *v)
-	case "LogEventVolume":
-		*v = new(GetServiceNodeLogEventVolume)
-		return json.Unmarshal(b, *v)
-	case "LogRule":
-		*v = new(GetServiceNodeLogRule)
-		return json.Unmarshal(b, *v)
-	case "LogRuleDeployment":
-		*v = new(GetServiceNodeLogRuleDeployment)
-		return json.Unmarshal(b, *v)
-	case "Message":
-		*v = new(GetServiceNodeMessage)
-		return json.Unmarshal(b, *v)
-	case "Organization":
-		*v = new(GetServiceNodeOrganization)
-		return json.Unmarshal(b, *v)
-	case "Service":
-		*v = new(GetServiceNodeService)
-		return json.Unmarshal(b, *v)
-	case "ServiceLogVolume":
-		*v = new(GetServiceNodeServiceLogVolume)
-		return json.Unmarshal(b, *v)
-	case "Team":
-		*v = new(GetServiceNodeTeam)
-		return json.Unmarshal(b, *v)
-	case "Workspace":
-		*v = new(GetServiceNodeWorkspace)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing Node.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for GetServiceNode: "%v"`, tn.TypeName)
+	err = json.Unmarshal(
+		b, &v.LogVolumeStats)
+	if err != nil {
+		return err
 	}
+	return nil
 }
 
-func __marshalGetServiceNode(v *GetServiceNode) ([]byte, error) {
+type __premarshalGetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate struct {
+	TotalVolume float64 `json:"totalVolume"`
 
-	var typename string
-	switch v := (*v).(type) {
-	case *GetServiceNodeAccount:
-		typename = "Account"
+	UnknownVolume float64 `json:"unknownVolume"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeAccount
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeChat:
-		typename = "Chat"
+	ValuableVolume float64 `json:"valuableVolume"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeChat
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeDatadogAccount:
-		typename = "DatadogAccount"
+	WasteVolume float64 `json:"wasteVolume"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeDatadogAccount
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeDatadogLogIndex:
-		typename = "DatadogLogIndex"
+	SavedVolume float64 `json:"savedVolume"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeDatadogLogIndex
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeLogEvent:
-		typename = "LogEvent"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeLogEvent
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeLogEventVolume:
-		typename = "LogEventVolume"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeLogEventVolume
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeLogRule:
-		typename = "LogRule"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeLogRule
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeLogRuleDeployment:
-		typename = "LogRuleDeployment"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeLogRuleDeployment
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeMessage:
-		typename = "Message"
+	UnknownPercent float64 `json:"unknownPercent"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeMessage
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeOrganization:
-		typename = "Organization"
+	ValuablePercent float64 `json:"valuablePercent"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeOrganization
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeService:
-		typename = "Service"
+	WastePercent float64 `json:"wastePercent"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeService
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeServiceLogVolume:
-		typename = "ServiceLogVolume"
+	SavedPercent float64 `json:"savedPercent"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeServiceLogVolume
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeTeam:
-		typename = "Team"
+	PeriodStart time.Time `json:"periodStart"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeTeam
-		}{typename, v}
-		return json.Marshal(result)
-	case *GetServiceNodeWorkspace:
-		typename = "Workspace"
+	PeriodEnd time.Time `json:"periodEnd"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*GetServiceNodeWorkspace
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for GetServiceNode: "%T"`, v)
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
 	}
+	return json.Marshal(premarshaled)
 }
 
-// GetServiceNodeAccount includes the requested fields of the GraphQL type Account.
-type GetServiceNodeAccount struct {
-	Typename string `json:"__typename"`
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate) __premarshalJSON() (*__premarshalGetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate, error) {
+	var retval __premarshalGetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountServicesServiceVolumeStatsLogVolumeAggregate
+
+	retval.TotalVolume = v.LogVolumeStats.TotalVolume
+	retval.UnknownVolume = v.LogVolumeStats.UnknownVolume
+	retval.ValuableVolume = v.LogVolumeStats.ValuableVolume
+	retval.WasteVolume = v.LogVolumeStats.WasteVolume
+	retval.SavedVolume = v.LogVolumeStats.SavedVolume
+	retval.UnknownPercent = v.LogVolumeStats.UnknownPercent
+	retval.ValuablePercent = v.LogVolumeStats.ValuablePercent
+	retval.WastePercent = v.LogVolumeStats.WastePercent
+	retval.SavedPercent = v.LogVolumeStats.SavedPercent
+	retval.PeriodStart = v.LogVolumeStats.PeriodStart
+	retval.PeriodEnd = v.LogVolumeStats.PeriodEnd
+	return &retval, nil
 }
 
-// GetTypename returns GetServiceNodeAccount.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeAccount) GetTypename() string { return v.Typename }
-
-// GetServiceNodeChat includes the requested fields of the GraphQL type Chat.
-type GetServiceNodeChat struct {
-	Typename string `json:"__typename"`
+// GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate includes the requested fields of the GraphQL type LogVolumeAggregate.
+// The GraphQL type's documentation follows.
+//
+// Aggregated telemetry volume statistics over a time period.
+// This is a pie chart breakdown: unknown + valuable + waste + saved = total.
+type GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate struct {
+	LogVolumeStats `json:"-"`
 }
 
-// GetTypename returns GetServiceNodeChat.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeChat) GetTypename() string { return v.Typename }
-
-// GetServiceNodeDatadogAccount includes the requested fields of the GraphQL type DatadogAccount.
-type GetServiceNodeDatadogAccount struct {
-	Typename string `json:"__typename"`
+// GetTotalVolume returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.TotalVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetTotalVolume() float64 {
+	return v.LogVolumeStats.TotalVolume
 }
 
-// GetTypename returns GetServiceNodeDatadogAccount.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeDatadogAccount) GetTypename() string { return v.Typename }
-
-// GetServiceNodeDatadogLogIndex includes the requested fields of the GraphQL type DatadogLogIndex.
-type GetServiceNodeDatadogLogIndex struct {
-	Typename string `json:"__typename"`
+// GetUnknownVolume returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.UnknownVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetUnknownVolume() float64 {
+	return v.LogVolumeStats.UnknownVolume
 }
 
-// GetTypename returns GetServiceNodeDatadogLogIndex.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeDatadogLogIndex) GetTypename() string { return v.Typename }
-
-// GetServiceNodeLogEvent includes the requested fields of the GraphQL type LogEvent.
-type GetServiceNodeLogEvent struct {
-	Typename string `json:"__typename"`
+// GetValuableVolume returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.ValuableVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetValuableVolume() float64 {
+	return v.LogVolumeStats.ValuableVolume
 }
 
-// GetTypename returns GetServiceNodeLogEvent.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeLogEvent) GetTypename() string { return v.Typename }
-
-// GetServiceNodeLogEventVolume includes the requested fields of the GraphQL type LogEventVolume.
-type GetServiceNodeLogEventVolume struct {
-	Typename string `json:"__typename"`
+// GetWasteVolume returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.WasteVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetWasteVolume() float64 {
+	return v.LogVolumeStats.WasteVolume
 }
 
-// GetTypename returns GetServiceNodeLogEventVolume.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeLogEventVolume) GetTypename() string { return v.Typename }
-
-// GetServiceNodeLogRule includes the requested fields of the GraphQL type LogRule.
-type GetServiceNodeLogRule struct {
-	Typename string `json:"__typename"`
+// GetSavedVolume returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.SavedVolume, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetSavedVolume() float64 {
+	return v.LogVolumeStats.SavedVolume
 }
 
-// GetTypename returns GetServiceNodeLogRule.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeLogRule) GetTypename() string { return v.Typename }
-
-// GetServiceNodeLogRuleDeployment includes the requested fields of the GraphQL type LogRuleDeployment.
-type GetServiceNodeLogRuleDeployment struct {
-	Typename string `json:"__typename"`
+// GetUnknownPercent returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.UnknownPercent, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetUnknownPercent() float64 {
+	return v.LogVolumeStats.UnknownPercent
 }
 
-// GetTypename returns GetServiceNodeLogRuleDeployment.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeLogRuleDeployment) GetTypename() string { return v.Typename }
-
-// GetServiceNodeMessage includes the requested fields of the GraphQL type Message.
-type GetServiceNodeMessage struct {
-	Typename string `json:"__typename"`
+// GetValuablePercent returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.ValuablePercent, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetValuablePercent() float64 {
+	return v.LogVolumeStats.ValuablePercent
 }
 
-// GetTypename returns GetServiceNodeMessage.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeMessage) GetTypename() string { return v.Typename }
-
-// GetServiceNodeOrganization includes the requested fields of the GraphQL type Organization.
-type GetServiceNodeOrganization struct {
-	Typename string `json:"__typename"`
+// GetWastePercent returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.WastePercent, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetWastePercent() float64 {
+	return v.LogVolumeStats.WastePercent
 }
 
-// GetTypename returns GetServiceNodeOrganization.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeOrganization) GetTypename() string { return v.Typename }
-
-// GetServiceNodeService includes the requested fields of the GraphQL type Service.
-type GetServiceNodeService struct {
-	Typename string `json:"__typename"`
-	// Unique identifier of the service
-	Id string `json:"id"`
-	// Service identifier in telemetry (e.g., 'checkout-service')
-	Name string `json:"name"`
-	// Description of service and telemetry characteristics
-	Description string `json:"description"`
-	// Whether telemetry analysis is enabled
-	Enabled bool `json:"enabled"`
-	// When the service was created
-	CreatedAt time.Time `json:"createdAt"`
-	// When the service was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
-	// Account this service belongs to
-	Account GetServiceNodeServiceAccount `json:"account"`
-	// Log event types produced by this service
-	LogEvents []GetServiceNodeServiceLogEventsLogEvent `json:"logEvents"`
+// GetSavedPercent returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.SavedPercent, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetSavedPercent() float64 {
+	return v.LogVolumeStats.SavedPercent
 }
 
-// GetTypename returns GetServiceNodeService.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeService) GetTypename() string { return v.Typename }
+// GetPeriodStart returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.PeriodStart, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetPeriodStart() time.Time {
+	return v.LogVolumeStats.PeriodStart
+}
 
-// GetId returns GetServiceNodeService.Id, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeService) GetId() string { return v.Id }
+// GetPeriodEnd returns GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate.PeriodEnd, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) GetPeriodEnd() time.Time {
+	return v.LogVolumeStats.PeriodEnd
+}
 
-// GetName returns GetServiceNodeService.Name, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeService) GetName() string { return v.Name }
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) UnmarshalJSON(b []byte) error {
 
-// GetDescription returns GetServiceNodeService.Description, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeService) GetDescription() string { return v.Description }
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetEnabled returns GetServiceNodeService.Enabled, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeService) GetEnabled() bool { return v.Enabled }
+	var firstPass struct {
+		*GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate = v
 
-// GetCreatedAt returns GetServiceNodeService.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeService) GetCreatedAt() time.Time { return v.CreatedAt }
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-// GetUpdatedAt returns GetServiceNodeService.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeService) GetUpdatedAt() time.Time { return v.UpdatedAt }
+	err = json.Unmarshal(
+		b, &v.LogVolumeStats)
+	if err != nil {
+		return err
+	}
+	return nil
+}
 
-// GetAccount returns GetServiceNodeService.Account, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeService) GetAccount() GetServiceNodeServiceAccount { return v.Account }
+type __premarshalGetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate struct {
+	TotalVolume float64 `json:"totalVolume"`
 
-// GetLogEvents returns GetServiceNodeService.LogEvents, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeService) GetLogEvents() []GetServiceNodeServiceLogEventsLogEvent {
-	return v.LogEvents
-}
+	UnknownVolume float64 `json:"unknownVolume"`
 
-// GetServiceNodeServiceAccount includes the requested fields of the GraphQL type Account.
-type GetServiceNodeServiceAccount struct {
-	// Unique identifier of the account
-	Id string `json:"id"`
-	// Human-readable name within the organization
-	Name string `json:"name"`
-}
+	ValuableVolume float64 `json:"valuableVolume"`
 
-// GetId returns GetServiceNodeServiceAccount.Id, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeServiceAccount) GetId() string { return v.Id }
+	WasteVolume float64 `json:"wasteVolume"`
 
-// GetName returns GetServiceNodeServiceAccount.Name, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeServiceAccount) GetName() string { return v.Name }
+	SavedVolume float64 `json:"savedVolume"`
 
-// GetServiceNodeServiceLogEventsLogEvent includes the requested fields of the GraphQL type LogEvent.
-type GetServiceNodeServiceLogEventsLogEvent struct {
-	// Unique identifier of the log event
-	Id string `json:"id"`
-	// Snake_case identifier for event type
-	Name string `json:"name"`
-	// What this event pattern represents
-	Description string `json:"description"`
-	// When the log event was created
-	CreatedAt time.Time `json:"createdAt"`
-}
+	UnknownPercent float64 `json:"unknownPercent"`
 
-// GetId returns GetServiceNodeServiceLogEventsLogEvent.Id, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeServiceLogEventsLogEvent) GetId() string { return v.Id }
+	ValuablePercent float64 `json:"valuablePercent"`
 
-// GetName returns GetServiceNodeServiceLogEventsLogEvent.Name, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeServiceLogEventsLogEvent) GetName() string { return v.Name }
+	WastePercent float64 `json:"wastePercent"`
 
-// GetDescription returns GetServiceNodeServiceLogEventsLogEvent.Description, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeServiceLogEventsLogEvent) GetDescription() string { return v.Description }
+	SavedPercent float64 `json:"savedPercent"`
 
-// GetCreatedAt returns GetServiceNodeServiceLogEventsLogEvent.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeServiceLogEventsLogEvent) GetCreatedAt() time.Time { return v.CreatedAt }
+	PeriodStart time.Time `json:"periodStart"`
 
-// GetServiceNodeServiceLogVolume includes the requested fields of the GraphQL type ServiceLogVolume.
-type GetServiceNodeServiceLogVolume struct {
-	Typename string `json:"__typename"`
+	PeriodEnd time.Time `json:"periodEnd"`
 }
 
-// GetTypename returns GetServiceNodeServiceLogVolume.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeServiceLogVolume) GetTypename() string { return v.Typename }
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-// GetServiceNodeTeam includes the requested fields of the GraphQL type Team.
-type GetServiceNodeTeam struct {
-	Typename string `json:"__typename"`
+func (v *GetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate) __premarshalJSON() (*__premarshalGetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate, error) {
+	var retval __premarshalGetAccountVolumeStatsAccountsAccountConnectionEdgesAccountEdgeNodeAccountVolumeStatsLogVolumeAggregate
+
+	retval.TotalVolume = v.LogVolumeStats.TotalVolume
+	retval.UnknownVolume = v.LogVolumeStats.UnknownVolume
+	retval.ValuableVolume = v.LogVolumeStats.ValuableVolume
+	retval.WasteVolume = v.LogVolumeStats.WasteVolume
+	retval.SavedVolume = v.LogVolumeStats.SavedVolume
+	retval.UnknownPercent = v.LogVolumeStats.UnknownPercent
+	retval.ValuablePercent = v.LogVolumeStats.ValuablePercent
+	retval.WastePercent = v.LogVolumeStats.WastePercent
+	retval.SavedPercent = v.LogVolumeStats.SavedPercent
+	retval.PeriodStart = v.LogVolumeStats.PeriodStart
+	retval.PeriodEnd = v.LogVolumeStats.PeriodEnd
+	return &retval, nil
 }
 
-// GetTypename returns GetServiceNodeTeam.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeTeam) GetTypename() string { return v.Typename }
+// GetAccountVolumeStatsResponse is returned by GetAccountVolumeStats on success.
+type GetAccountVolumeStatsResponse struct {
+	// Query accounts. Accounts belong to an organization and contain services and workspaces.
+	Accounts GetAccountVolumeStatsAccountsAccountConnection `json:"accounts"`
+}
 
-// GetServiceNodeWorkspace includes the requested fields of the GraphQL type Workspace.
-type GetServiceNodeWorkspace struct {
-	Typename string `json:"__typename"`
+// GetAccounts returns GetAccountVolumeStatsResponse.Accounts, and is useful for accessing the field via an interface.
+func (v *GetAccountVolumeStatsResponse) GetAccounts() GetAccountVolumeStatsAccountsAccountConnection {
+	return v.Accounts
 }
 
-// GetTypename returns GetServiceNodeWorkspace.Typename, and is useful for accessing the field via an interface.
-func (v *GetServiceNodeWorkspace) GetTypename() string { return v.Typename }
-
-// GetServiceResponse is returned by GetService on success.
-type GetServiceResponse struct {
-	// Fetches an object given its ID.
-	Node GetServiceNode `json:"-"`
+// GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection includes the requested fields of the GraphQL type DatadogAccountConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection struct {
+	// A list of edges.
+	Edges []GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge `json:"edges"`
 }
 
-// GetNode returns GetServiceResponse.Node, and is useful for accessing the field via an interface.
-func (v *GetServiceResponse) GetNode() GetServiceNode { return v.Node }
-
-func (v *GetServiceResponse) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*GetServiceResponse
-		Node json.RawMessage `json:"node"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.GetServiceResponse = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	{
-		dst := &v.Node
-		src := firstPass.Node
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalGetServiceNode(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal GetServiceResponse.Node: %w", err)
-			}
-		}
-	}
-	return nil
+// GetEdges returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection) GetEdges() []GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge {
+	return v.Edges
 }
 
-type __premarshalGetServiceResponse struct {
-	Node json.RawMessage `json:"node"`
+// GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge includes the requested fields of the GraphQL type DatadogAccountEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge struct {
+	// The item at the end of the edge.
+	Node GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount `json:"node"`
 }
 
-func (v *GetServiceResponse) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetNode returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge) GetNode() GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount {
+	return v.Node
 }
 
-func (v *GetServiceResponse) __premarshalJSON() (*__premarshalGetServiceResponse, error) {
-	var retval __premarshalGetServiceResponse
+// GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount includes the requested fields of the GraphQL type DatadogAccount.
+type GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount struct {
+	// Unique identifier of the Datadog configuration
+	Id string `json:"id"`
+	// Aggregate log event discovery progress across all enabled services in this Datadog account.
+	// Shows overall discovery status and progress percentage.
+	LogEventDiscoveryProgress GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress `json:"logEventDiscoveryProgress"`
+}
 
-	{
+// GetId returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount.Id, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount) GetId() string {
+	return v.Id
+}
 
-		dst := &retval.Node
-		src := v.Node
-		var err error
-		*dst, err = __marshalGetServiceNode(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal GetServiceResponse.Node: %w", err)
-		}
-	}
-	return &retval, nil
+// GetLogEventDiscoveryProgress returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount.LogEventDiscoveryProgress, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount) GetLogEventDiscoveryProgress() GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress {
+	return v.LogEventDiscoveryProgress
 }
 
-// ListAccountsAccountsAccountConnection includes the requested fields of the GraphQL type AccountConnection.
+// GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress includes the requested fields of the GraphQL type LogEventDiscoveryProgress.
 // The GraphQL type's documentation follows.
 //
-// A connection to a list of items.
-type ListAccountsAccountsAccountConnection struct {
-	// A list of edges.
-	Edges []ListAccountsAccountsAccountConnectionEdgesAccountEdge `json:"edges"`
-	// Identifies the total count of items in the connection.
-	TotalCount int `json:"totalCount"`
+// Log event discovery progress for a service from an integration account.
+// Tracks discovery status and how much of the service's logs have been cataloged.
+type GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress struct {
+	// Current status of log event discovery
+	Status DiscoveryStatus `json:"status"`
+	// Discovery progress percentage (0-100), null if no volume data yet
+	PercentComplete float64 `json:"percentComplete"`
+	// Weekly log volume reported by the integration (last 7 days)
+	WeeklyVolume int `json:"weeklyVolume"`
+	// Weekly volume of logs discovered and cataloged (last 7 days)
+	WeeklyDiscoveredVolume float64 `json:"weeklyDiscoveredVolume"`
+	// Last error message if discovery failed
+	LastError string `json:"lastError"`
+	// When the current/most recent discovery run started
+	StartedAt time.Time `json:"startedAt"`
+	// When the most recent discovery run completed (successfully or with error)
+	CompletedAt time.Time `json:"completedAt"`
+	// Number of consecutive failures
+	ConsecutiveFailures int `json:"consecutiveFailures"`
 }
 
-// GetEdges returns ListAccountsAccountsAccountConnection.Edges, and is useful for accessing the field via an interface.
-func (v *ListAccountsAccountsAccountConnection) GetEdges() []ListAccountsAccountsAccountConnectionEdgesAccountEdge {
-	return v.Edges
+// GetStatus returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.Status, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetStatus() DiscoveryStatus {
+	return v.Status
 }
 
-// GetTotalCount returns ListAccountsAccountsAccountConnection.TotalCount, and is useful for accessing the field via an interface.
-func (v *ListAccountsAccountsAccountConnection) GetTotalCount() int { return v.TotalCount }
+// GetPercentComplete returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.PercentComplete, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetPercentComplete() float64 {
+	return v.PercentComplete
+}
 
-// ListAccountsAccountsAccountConnectionEdgesAccountEdge includes the requested fields of the GraphQL type AccountEdge.
-// The GraphQL type's documentation follows.
-//
-// An edge in a connection.
-type ListAccountsAccountsAccountConnectionEdgesAccountEdge struct {
-	// The item at the end of the edge.
-	Node ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount `json:"node"`
+// GetWeeklyVolume returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.WeeklyVolume, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetWeeklyVolume() int {
+	return v.WeeklyVolume
 }
 
-// GetNode returns ListAccountsAccountsAccountConnectionEdgesAccountEdge.Node, and is useful for accessing the field via an interface.
-func (v *ListAccountsAccountsAccountConnectionEdgesAccountEdge) GetNode() ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount {
-	return v.Node
+// GetWeeklyDiscoveredVolume returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.WeeklyDiscoveredVolume, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetWeeklyDiscoveredVolume() float64 {
+	return v.WeeklyDiscoveredVolume
 }
 
-// ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount includes the requested fields of the GraphQL type Account.
-type ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount struct {
-	// Unique identifier of the account
-	Id string `json:"id"`
-	// Human-readable name within the organization
-	Name string `json:"name"`
-	// When the account was created
-	CreatedAt time.Time `json:"createdAt"`
+// GetLastError returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.LastError, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetLastError() string {
+	return v.LastError
 }
 
-// GetId returns ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Id, and is useful for accessing the field via an interface.
-func (v *ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetId() string {
-	return v.Id
+// GetStartedAt returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.StartedAt, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetStartedAt() time.Time {
+	return v.StartedAt
 }
 
-// GetName returns ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Name, and is useful for accessing the field via an interface.
-func (v *ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetName() string {
-	return v.Name
+// GetCompletedAt returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.CompletedAt, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetCompletedAt() time.Time {
+	return v.CompletedAt
 }
 
-// GetCreatedAt returns ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount.CreatedAt, and is useful for accessing the field via an interface.
-func (v *ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetCreatedAt() time.Time {
-	return v.CreatedAt
+// GetConsecutiveFailures returns GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress.ConsecutiveFailures, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountLogEventDiscoveryProgress) GetConsecutiveFailures() int {
+	return v.ConsecutiveFailures
 }
 
-// ListAccountsResponse is returned by ListAccounts on success.
-type ListAccountsResponse struct {
-	// Query accounts. Accounts belong to an organization and contain services and workspaces.
-	Accounts ListAccountsAccountsAccountConnection `json:"accounts"`
+// GetDatadogAccountLogDiscoveryProgressResponse is returned by GetDatadogAccountLogDiscoveryProgress on success.
+type GetDatadogAccountLogDiscoveryProgressResponse struct {
+	// Query connected Datadog accounts.
+	DatadogAccounts GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection `json:"datadogAccounts"`
 }
 
-// GetAccounts returns ListAccountsResponse.Accounts, and is useful for accessing the field via an interface.
-func (v *ListAccountsResponse) GetAccounts() ListAccountsAccountsAccountConnection { return v.Accounts }
+// GetDatadogAccounts returns GetDatadogAccountLogDiscoveryProgressResponse.DatadogAccounts, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountLogDiscoveryProgressResponse) GetDatadogAccounts() GetDatadogAccountLogDiscoveryProgressDatadogAccountsDatadogAccountConnection {
+	return v.DatadogAccounts
+}
 
-// ListOrganizationsOrganizationsOrganizationConnection includes the requested fields of the GraphQL type OrganizationConnection.
+// GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection includes the requested fields of the GraphQL type DatadogAccountConnection.
 // The GraphQL type's documentation follows.
 //
 // A connection to a list of items.
-type ListOrganizationsOrganizationsOrganizationConnection struct {
+type GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection struct {
 	// A list of edges.
-	Edges []ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge `json:"edges"`
-	// Identifies the total count of items in the connection.
-	TotalCount int `json:"totalCount"`
+	Edges []GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge `json:"edges"`
 }
 
-// GetEdges returns ListOrganizationsOrganizationsOrganizationConnection.Edges, and is useful for accessing the field via an interface.
-func (v *ListOrganizationsOrganizationsOrganizationConnection) GetEdges() []ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge {
+// GetEdges returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection) GetEdges() []GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge {
 	return v.Edges
 }
 
-// GetTotalCount returns ListOrganizationsOrganizationsOrganizationConnection.TotalCount, and is useful for accessing the field via an interface.
-func (v *ListOrganizationsOrganizationsOrganizationConnection) GetTotalCount() int {
-	return v.TotalCount
-}
-
-// ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge includes the requested fields of the GraphQL type OrganizationEdge.
+// GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge includes the requested fields of the GraphQL type DatadogAccountEdge.
 // The GraphQL type's documentation follows.
 //
 // An edge in a connection.
-type ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge struct {
+type GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge struct {
 	// The item at the end of the edge.
-	Node ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization `json:"node"`
+	Node GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount `json:"node"`
 }
 
-// GetNode returns ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge.Node, and is useful for accessing the field via an interface.
-func (v *ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge) GetNode() ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization {
+// GetNode returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdge) GetNode() GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount {
 	return v.Node
 }
 
-// ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization includes the requested fields of the GraphQL type Organization.
-type ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization struct {
-	// Unique identifier of the organization
+// GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount includes the requested fields of the GraphQL type DatadogAccount.
+type GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount struct {
+	// Unique identifier of the Datadog configuration
 	Id string `json:"id"`
-	// Human-readable name, unique across the system
-	Name string `json:"name"`
-	// When the organization was created
-	CreatedAt time.Time `json:"createdAt"`
+	// Service discovery progress for this Datadog account.
+	// Shows whether service discovery is running, completed, or has errors.
+	ServiceDiscoveryProgress GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress `json:"serviceDiscoveryProgress"`
 }
 
-// GetId returns ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization.Id, and is useful for accessing the field via an interface.
-func (v *ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization) GetId() string {
+// GetId returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount.Id, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount) GetId() string {
 	return v.Id
 }
 
-// GetName returns ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization.Name, and is useful for accessing the field via an interface.
-func (v *ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization) GetName() string {
-	return v.Name
-}
-
-// GetCreatedAt returns ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization.CreatedAt, and is useful for accessing the field via an interface.
-func (v *ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization) GetCreatedAt() time.Time {
-	return v.CreatedAt
-}
-
-// ListOrganizationsResponse is returned by ListOrganizations on success.
-type ListOrganizationsResponse struct {
-	// Query organizations. An organization is the top-level container that holds accounts.
-	Organizations ListOrganizationsOrganizationsOrganizationConnection `json:"organizations"`
+// GetServiceDiscoveryProgress returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount.ServiceDiscoveryProgress, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccount) GetServiceDiscoveryProgress() GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress {
+	return v.ServiceDiscoveryProgress
 }
 
-// GetOrganizations returns ListOrganizationsResponse.Organizations, and is useful for accessing the field via an interface.
-func (v *ListOrganizationsResponse) GetOrganizations() ListOrganizationsOrganizationsOrganizationConnection {
-	return v.Organizations
+// GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress includes the requested fields of the GraphQL type ServiceDiscoveryProgress.
+// The GraphQL type's documentation follows.
+//
+// Service discovery progress for an integration account.
+// Tracks whether services have been discovered from the integration.
+type GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress struct {
+	// Current status of service discovery
+	Status DiscoveryStatus `json:"status"`
+	// Number of services discovered from this integration
+	ServicesDiscovered int `json:"servicesDiscovered"`
+	// Last error message if discovery failed
+	LastError string `json:"lastError"`
+	// When the current/most recent discovery run started
+	StartedAt time.Time `json:"startedAt"`
+	// When the most recent discovery run completed (successfully or with error)
+	CompletedAt time.Time `json:"completedAt"`
+	// Number of consecutive failures
+	ConsecutiveFailures int `json:"consecutiveFailures"`
 }
 
-// ListServicesResponse is returned by ListServices on success.
-type ListServicesResponse struct {
-	// Query services in your system.
-	Services ListServicesServicesServiceConnection `json:"services"`
+// GetStatus returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.Status, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetStatus() DiscoveryStatus {
+	return v.Status
 }
 
-// GetServices returns ListServicesResponse.Services, and is useful for accessing the field via an interface.
-func (v *ListServicesResponse) GetServices() ListServicesServicesServiceConnection { return v.Services }
+// GetServicesDiscovered returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.ServicesDiscovered, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetServicesDiscovered() int {
+	return v.ServicesDiscovered
+}
 
-// ListServicesServicesServiceConnection includes the requested fields of the GraphQL type ServiceConnection.
+// GetLastError returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.LastError, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetLastError() string {
+	return v.LastError
+}
+
+// GetStartedAt returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.StartedAt, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetStartedAt() time.Time {
+	return v.StartedAt
+}
+
+// GetCompletedAt returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.CompletedAt, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetCompletedAt() time.Time {
+	return v.CompletedAt
+}
+
+// GetConsecutiveFailures returns GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress.ConsecutiveFailures, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnectionEdgesDatadogAccountEdgeNodeDatadogAccountServiceDiscoveryProgress) GetConsecutiveFailures() int {
+	return v.ConsecutiveFailures
+}
+
+// GetDatadogAccountServiceDiscoveryProgressResponse is returned by GetDatadogAccountServiceDiscoveryProgress on success.
+type GetDatadogAccountServiceDiscoveryProgressResponse struct {
+	// Query connected Datadog accounts.
+	DatadogAccounts GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection `json:"datadogAccounts"`
+}
+
+// GetDatadogAccounts returns GetDatadogAccountServiceDiscoveryProgressResponse.DatadogAccounts, and is useful for accessing the field via an interface.
+func (v *GetDatadogAccountServiceDiscoveryProgressResponse) GetDatadogAccounts() GetDatadogAccountServiceDiscoveryProgressDatadogAccountsDatadogAccountConnection {
+	return v.DatadogAccounts
+}
+
+// GetLatestChatChatsChatConnection includes the requested fields of the GraphQL type ChatConnection.
 // The GraphQL type's documentation follows.
 //
 // A connection to a list of items.
-type ListServicesServicesServiceConnection struct {
+type GetLatestChatChatsChatConnection struct {
 	// A list of edges.
-	Edges []ListServicesServicesServiceConnectionEdgesServiceEdge `json:"edges"`
-	// Identifies the total count of items in the connection.
-	TotalCount int `json:"totalCount"`
+	Edges []GetLatestChatChatsChatConnectionEdgesChatEdge `json:"edges"`
 }
 
-// GetEdges returns ListServicesServicesServiceConnection.Edges, and is useful for accessing the field via an interface.
-func (v *ListServicesServicesServiceConnection) GetEdges() []ListServicesServicesServiceConnectionEdgesServiceEdge {
+// GetEdges returns GetLatestChatChatsChatConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnection) GetEdges() []GetLatestChatChatsChatConnectionEdgesChatEdge {
 	return v.Edges
 }
 
-// GetTotalCount returns ListServicesServicesServiceConnection.TotalCount, and is useful for accessing the field via an interface.
-func (v *ListServicesServicesServiceConnection) GetTotalCount() int { return v.TotalCount }
+// GetLatestChatChatsChatConnectionEdgesChatEdge includes the requested fields of the GraphQL type ChatEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type GetLatestChatChatsChatConnectionEdgesChatEdge struct {
+	// The item at the end of the edge.
+	Node GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat `json:"node"`
+}
 
-// ListServicesServicesServiceConnectionEdgesServiceEdge includes the requested fields of the GraphQL type ServiceEdge.
+// GetNode returns GetLatestChatChatsChatConnectionEdgesChatEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnectionEdgesChatEdge) GetNode() GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat {
+	return v.Node
+}
+
+// GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat includes the requested fields of the GraphQL type Chat.
+type GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat struct {
+	// Unique identifier of the chat
+	Id string `json:"id"`
+	// Auto-generated title from first message
+	Title string `json:"title"`
+	// When the chat was created
+	CreatedAt time.Time `json:"createdAt"`
+	// When the chat was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Messages in this conversation
+	Messages []GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage `json:"messages"`
+}
+
+// GetId returns GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat.Id, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat) GetId() string { return v.Id }
+
+// GetTitle returns GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat.Title, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat) GetTitle() string { return v.Title }
+
+// GetCreatedAt returns GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetMessages returns GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat.Messages, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChat) GetMessages() []GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage {
+	return v.Messages
+}
+
+// GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage includes the requested fields of the GraphQL type Message.
+type GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage struct {
+	// Unique identifier of the message
+	Id string `json:"id"`
+	// Who sent this message
+	Role MessageRole `json:"role"`
+	// Why the assistant stopped (end_turn, tool_use, etc)
+	StopReason string `json:"stopReason"`
+	// When the message was created
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetId returns GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage.Id, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage) GetId() string {
+	return v.Id
+}
+
+// GetRole returns GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage.Role, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage) GetRole() MessageRole {
+	return v.Role
+}
+
+// GetStopReason returns GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage.StopReason, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage) GetStopReason() string {
+	return v.StopReason
+}
+
+// GetCreatedAt returns GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetLatestChatChatsChatConnectionEdgesChatEdgeNodeChatMessagesMessage) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetLatestChatResponse is returned by GetLatestChat on success.
+type GetLatestChatResponse struct {
+	// Query chat conversations in a workspace.
+	Chats GetLatestChatChatsChatConnection `json:"chats"`
+}
+
+// GetChats returns GetLatestChatResponse.Chats, and is useful for accessing the field via an interface.
+func (v *GetLatestChatResponse) GetChats() GetLatestChatChatsChatConnection { return v.Chats }
+
+// GetLogEventDetailLogEventsLogEventConnection includes the requested fields of the GraphQL type LogEventConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type GetLogEventDetailLogEventsLogEventConnection struct {
+	// A list of edges.
+	Edges []GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdge `json:"edges"`
+}
+
+// GetEdges returns GetLogEventDetailLogEventsLogEventConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnection) GetEdges() []GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdge {
+	return v.Edges
+}
+
+// GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdge includes the requested fields of the GraphQL type LogEventEdge.
 // The GraphQL type's documentation follows.
 //
 // An edge in a connection.
-type ListServicesServicesServiceConnectionEdgesServiceEdge struct {
+type GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdge struct {
 	// The item at the end of the edge.
-	Node ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService `json:"node"`
+	Node GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent `json:"node"`
 }
 
-// GetNode returns ListServicesServicesServiceConnectionEdgesServiceEdge.Node, and is useful for accessing the field via an interface.
-func (v *ListServicesServicesServiceConnectionEdgesServiceEdge) GetNode() ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService {
+// GetNode returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdge) GetNode() GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent {
 	return v.Node
 }
 
-// ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService includes the requested fields of the GraphQL type Service.
-type ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService struct {
-	// Unique identifier of the service
+// GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent includes the requested fields of the GraphQL type LogEvent.
+type GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent struct {
+	// Unique identifier of the log event
 	Id string `json:"id"`
-	// Service identifier in telemetry (e.g., 'checkout-service')
+	// Snake_case identifier for event type
 	Name string `json:"name"`
-	// Description of service and telemetry characteristics
+	// What this event pattern represents
 	Description string `json:"description"`
-	// Whether telemetry analysis is enabled
-	Enabled bool `json:"enabled"`
-	// When the service was created
-	CreatedAt time.Time `json:"createdAt"`
-	// When the service was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
+	// Service that produces this event
+	Service GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventService `json:"service"`
+	// Get telemetry volume statistics for this log event over a specified time window
+	VolumeStats GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate `json:"volumeStats"`
+	// Processing rules across workspaces
+	LogRules []GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule `json:"logRules"`
 }
 
-// GetId returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.Id, and is useful for accessing the field via an interface.
-func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetId() string {
+// GetId returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent.Id, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent) GetId() string {
 	return v.Id
 }
 
-// GetName returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.Name, and is useful for accessing the field via an interface.
-func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetName() string {
+// GetName returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent.Name, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent) GetName() string {
 	return v.Name
 }
 
-// GetDescription returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.Description, and is useful for accessing the field via an interface.
-func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetDescription() string {
+// GetDescription returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent.Description, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent) GetDescription() string {
 	return v.Description
 }
 
-// GetEnabled returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.Enabled, and is useful for accessing the field via an interface.
-func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetEnabled() bool {
-	return v.Enabled
+// GetService returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent.Service, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent) GetService() GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventService {
+	return v.Service
 }
 
-// GetCreatedAt returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.CreatedAt, and is useful for accessing the field via an interface.
-func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetCreatedAt() time.Time {
-	return v.CreatedAt
+// GetVolumeStats returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent.VolumeStats, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent) GetVolumeStats() GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate {
+	return v.VolumeStats
 }
 
-// GetUpdatedAt returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetUpdatedAt() time.Time {
-	return v.UpdatedAt
+// GetLogRules returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent.LogRules, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent) GetLogRules() []GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule {
+	return v.LogRules
 }
 
-type ValidateDatadogApiKeyInput struct {
-	ApiKey string             `json:"apiKey"`
-	Site   DatadogAccountSite `json:"site"`
+// GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule includes the requested fields of the GraphQL type LogRule.
+type GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule struct {
+	LogRuleDetails `json:"-"`
+	// The workspace that owns this rule
+	Workspace GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleWorkspace `json:"workspace"`
+	// Where this rule is deployed
+	Deployments []GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment `json:"deployments"`
 }
 
-// GetApiKey returns ValidateDatadogApiKeyInput.ApiKey, and is useful for accessing the field via an interface.
-func (v *ValidateDatadogApiKeyInput) GetApiKey() string { return v.ApiKey }
+// GetWorkspace returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.Workspace, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetWorkspace() GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleWorkspace {
+	return v.Workspace
+}
 
-// GetSite returns ValidateDatadogApiKeyInput.Site, and is useful for accessing the field via an interface.
-func (v *ValidateDatadogApiKeyInput) GetSite() DatadogAccountSite { return v.Site }
+// GetDeployments returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.Deployments, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetDeployments() []GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment {
+	return v.Deployments
+}
 
-// ValidateDatadogApiKeyResponse is returned by ValidateDatadogApiKey on success.
-type ValidateDatadogApiKeyResponse struct {
-	ValidateDatadogApiKey ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult `json:"validateDatadogApiKey"`
+// GetId returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.Id, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetId() string {
+	return v.LogRuleDetails.Id
 }
 
-// GetValidateDatadogApiKey returns ValidateDatadogApiKeyResponse.ValidateDatadogApiKey, and is useful for accessing the field via an interface.
-func (v *ValidateDatadogApiKeyResponse) GetValidateDatadogApiKey() ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult {
-	return v.ValidateDatadogApiKey
+// GetLogEventID returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.LogEventID, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetLogEventID() string {
+	return v.LogRuleDetails.LogEventID
 }
 
-// ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult includes the requested fields of the GraphQL type ValidateDatadogApiKeyResult.
-type ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult struct {
-	Valid bool   `json:"valid"`
-	Error string `json:"error"`
+// GetWorkspaceID returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.WorkspaceID, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetWorkspaceID() string {
+	return v.LogRuleDetails.WorkspaceID
 }
 
-// GetValid returns ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult.Valid, and is useful for accessing the field via an interface.
-func (v *ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult) GetValid() bool {
-	return v.Valid
+// GetRetention returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.Retention, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetRetention() LogRuleRetention {
+	return v.LogRuleDetails.Retention
 }
 
-// GetError returns ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult.Error, and is useful for accessing the field via an interface.
-func (v *ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult) GetError() string {
-	return v.Error
+// GetConfidence returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.Confidence, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetConfidence() LogRuleConfidence {
+	return v.LogRuleDetails.Confidence
 }
 
-// __CreateAccountInput is used internally by genqlient
-type __CreateAccountInput struct {
-	Input CreateAccountInput `json:"input"`
+// GetIgnoredAt returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.IgnoredAt, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetIgnoredAt() time.Time {
+	return v.LogRuleDetails.IgnoredAt
 }
 
-// GetInput returns __CreateAccountInput.Input, and is useful for accessing the field via an interface.
-func (v *__CreateAccountInput) GetInput() CreateAccountInput { return v.Input }
+// GetVrlScript returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.VrlScript, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetVrlScript() string {
+	return v.LogRuleDetails.VrlScript
+}
 
-// __CreateDatadogAccountWithCredentialsInput is used internally by genqlient
-type __CreateDatadogAccountWithCredentialsInput struct {
-	Input CreateDatadogAccountWithCredentialsInput `json:"input"`
+// GetRationale returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.Rationale, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetRationale() string {
+	return v.LogRuleDetails.Rationale
+}
+
+// GetCreatedByType returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.CreatedByType, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetCreatedByType() LogRuleCreatedByType {
+	return v.LogRuleDetails.CreatedByType
+}
+
+// GetCreatedByID returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.CreatedByID, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetCreatedByID() string {
+	return v.LogRuleDetails.CreatedByID
+}
+
+// GetCreatedAt returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) GetCreatedAt() time.Time {
+	return v.LogRuleDetails.CreatedAt
+}
+
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogRuleDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule struct {
+	Workspace GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleWorkspace `json:"workspace"`
+
+	Deployments []GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment `json:"deployments"`
+
+	Id string `json:"id"`
+
+	LogEventID string `json:"logEventID"`
+
+	WorkspaceID string `json:"workspaceID"`
+
+	Retention LogRuleRetention `json:"retention"`
+
+	Confidence LogRuleConfidence `json:"confidence"`
+
+	IgnoredAt time.Time `json:"ignoredAt"`
+
+	VrlScript string `json:"vrlScript"`
+
+	Rationale string `json:"rationale"`
+
+	CreatedByType LogRuleCreatedByType `json:"createdByType"`
+
+	CreatedByID string `json:"createdByID"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule) __premarshalJSON() (*__premarshalGetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule, error) {
+	var retval __premarshalGetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRule
+
+	retval.Workspace = v.Workspace
+	retval.Deployments = v.Deployments
+	retval.Id = v.LogRuleDetails.Id
+	retval.LogEventID = v.LogRuleDetails.LogEventID
+	retval.WorkspaceID = v.LogRuleDetails.WorkspaceID
+	retval.Retention = v.LogRuleDetails.Retention
+	retval.Confidence = v.LogRuleDetails.Confidence
+	retval.IgnoredAt = v.LogRuleDetails.IgnoredAt
+	retval.VrlScript = v.LogRuleDetails.VrlScript
+	retval.Rationale = v.LogRuleDetails.Rationale
+	retval.CreatedByType = v.LogRuleDetails.CreatedByType
+	retval.CreatedByID = v.LogRuleDetails.CreatedByID
+	retval.CreatedAt = v.LogRuleDetails.CreatedAt
+	return &retval, nil
+}
+
+// GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment includes the requested fields of the GraphQL type LogRuleDeployment.
+type GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment struct {
+	LogRuleDeploymentDetails `json:"-"`
+}
+
+// GetId returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment.Id, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment) GetId() string {
+	return v.LogRuleDeploymentDetails.Id
+}
+
+// GetExternalID returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment.ExternalID, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment) GetExternalID() string {
+	return v.LogRuleDeploymentDetails.ExternalID
+}
+
+// GetLastError returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment.LastError, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment) GetLastError() string {
+	return v.LogRuleDeploymentDetails.LastError
+}
+
+// GetUpdatedAt returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment) GetUpdatedAt() time.Time {
+	return v.LogRuleDeploymentDetails.UpdatedAt
+}
+
+// GetDatadogLogIndex returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment.DatadogLogIndex, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment) GetDatadogLogIndex() LogRuleDeploymentDetailsDatadogLogIndex {
+	return v.LogRuleDeploymentDetails.DatadogLogIndex
+}
+
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogRuleDeploymentDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment struct {
+	Id string `json:"id"`
+
+	ExternalID string `json:"externalID"`
+
+	LastError string `json:"lastError"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	DatadogLogIndex LogRuleDeploymentDetailsDatadogLogIndex `json:"datadogLogIndex"`
+}
+
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment) __premarshalJSON() (*__premarshalGetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment, error) {
+	var retval __premarshalGetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleDeploymentsLogRuleDeployment
+
+	retval.Id = v.LogRuleDeploymentDetails.Id
+	retval.ExternalID = v.LogRuleDeploymentDetails.ExternalID
+	retval.LastError = v.LogRuleDeploymentDetails.LastError
+	retval.UpdatedAt = v.LogRuleDeploymentDetails.UpdatedAt
+	retval.DatadogLogIndex = v.LogRuleDeploymentDetails.DatadogLogIndex
+	return &retval, nil
+}
+
+// GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleWorkspace includes the requested fields of the GraphQL type Workspace.
+type GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleWorkspace struct {
+	// Unique identifier of the workspace
+	Id string `json:"id"`
+	// Human-readable name within the account
+	Name string `json:"name"`
+}
+
+// GetId returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleWorkspace.Id, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleWorkspace) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleWorkspace.Name, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventLogRulesLogRuleWorkspace) GetName() string {
+	return v.Name
+}
+
+// GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventService includes the requested fields of the GraphQL type Service.
+type GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventService struct {
+	// Unique identifier of the service
+	Id string `json:"id"`
+	// Service identifier in telemetry (e.g., 'checkout-service')
+	Name string `json:"name"`
+}
+
+// GetId returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventService.Id, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventService) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventService.Name, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventService) GetName() string {
+	return v.Name
+}
+
+// GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate includes the requested fields of the GraphQL type LogVolumeAggregate.
+// The GraphQL type's documentation follows.
+//
+// Aggregated telemetry volume statistics over a time period.
+// This is a pie chart breakdown: unknown + valuable + waste + saved = total.
+type GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate struct {
+	LogVolumeStats `json:"-"`
+}
+
+// GetTotalVolume returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.TotalVolume, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetTotalVolume() float64 {
+	return v.LogVolumeStats.TotalVolume
+}
+
+// GetUnknownVolume returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.UnknownVolume, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetUnknownVolume() float64 {
+	return v.LogVolumeStats.UnknownVolume
+}
+
+// GetValuableVolume returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.ValuableVolume, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetValuableVolume() float64 {
+	return v.LogVolumeStats.ValuableVolume
+}
+
+// GetWasteVolume returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.WasteVolume, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetWasteVolume() float64 {
+	return v.LogVolumeStats.WasteVolume
+}
+
+// GetSavedVolume returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.SavedVolume, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetSavedVolume() float64 {
+	return v.LogVolumeStats.SavedVolume
+}
+
+// GetUnknownPercent returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.UnknownPercent, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetUnknownPercent() float64 {
+	return v.LogVolumeStats.UnknownPercent
+}
+
+// GetValuablePercent returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.ValuablePercent, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetValuablePercent() float64 {
+	return v.LogVolumeStats.ValuablePercent
+}
+
+// GetWastePercent returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.WastePercent, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetWastePercent() float64 {
+	return v.LogVolumeStats.WastePercent
+}
+
+// GetSavedPercent returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.SavedPercent, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetSavedPercent() float64 {
+	return v.LogVolumeStats.SavedPercent
+}
+
+// GetPeriodStart returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.PeriodStart, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetPeriodStart() time.Time {
+	return v.LogVolumeStats.PeriodStart
+}
+
+// GetPeriodEnd returns GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.PeriodEnd, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetPeriodEnd() time.Time {
+	return v.LogVolumeStats.PeriodEnd
+}
+
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogVolumeStats)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate struct {
+	TotalVolume float64 `json:"totalVolume"`
+
+	UnknownVolume float64 `json:"unknownVolume"`
+
+	ValuableVolume float64 `json:"valuableVolume"`
+
+	WasteVolume float64 `json:"wasteVolume"`
+
+	SavedVolume float64 `json:"savedVolume"`
+
+	UnknownPercent float64 `json:"unknownPercent"`
+
+	ValuablePercent float64 `json:"valuablePercent"`
+
+	WastePercent float64 `json:"wastePercent"`
+
+	SavedPercent float64 `json:"savedPercent"`
+
+	PeriodStart time.Time `json:"periodStart"`
+
+	PeriodEnd time.Time `json:"periodEnd"`
+}
+
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) __premarshalJSON() (*__premarshalGetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate, error) {
+	var retval __premarshalGetLogEventDetailLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate
+
+	retval.TotalVolume = v.LogVolumeStats.TotalVolume
+	retval.UnknownVolume = v.LogVolumeStats.UnknownVolume
+	retval.ValuableVolume = v.LogVolumeStats.ValuableVolume
+	retval.WasteVolume = v.LogVolumeStats.WasteVolume
+	retval.SavedVolume = v.LogVolumeStats.SavedVolume
+	retval.UnknownPercent = v.LogVolumeStats.UnknownPercent
+	retval.ValuablePercent = v.LogVolumeStats.ValuablePercent
+	retval.WastePercent = v.LogVolumeStats.WastePercent
+	retval.SavedPercent = v.LogVolumeStats.SavedPercent
+	retval.PeriodStart = v.LogVolumeStats.PeriodStart
+	retval.PeriodEnd = v.LogVolumeStats.PeriodEnd
+	return &retval, nil
+}
+
+// GetLogEventDetailResponse is returned by GetLogEventDetail on success.
+type GetLogEventDetailResponse struct {
+	// Query log events discovered in your services. Each log event is a distinct message pattern.
+	LogEvents GetLogEventDetailLogEventsLogEventConnection `json:"logEvents"`
+}
+
+// GetLogEvents returns GetLogEventDetailResponse.LogEvents, and is useful for accessing the field via an interface.
+func (v *GetLogEventDetailResponse) GetLogEvents() GetLogEventDetailLogEventsLogEventConnection {
+	return v.LogEvents
+}
+
+// GetServiceByNameResponse is returned by GetServiceByName on success.
+type GetServiceByNameResponse struct {
+	// Query services in your system.
+	Services GetServiceByNameServicesServiceConnection `json:"services"`
+}
+
+// GetServices returns GetServiceByNameResponse.Services, and is useful for accessing the field via an interface.
+func (v *GetServiceByNameResponse) GetServices() GetServiceByNameServicesServiceConnection {
+	return v.Services
+}
+
+// GetServiceByNameServicesServiceConnection includes the requested fields of the GraphQL type ServiceConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type GetServiceByNameServicesServiceConnection struct {
+	// A list of edges.
+	Edges []GetServiceByNameServicesServiceConnectionEdgesServiceEdge `json:"edges"`
+}
+
+// GetEdges returns GetServiceByNameServicesServiceConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetServiceByNameServicesServiceConnection) GetEdges() []GetServiceByNameServicesServiceConnectionEdgesServiceEdge {
+	return v.Edges
+}
+
+// GetServiceByNameServicesServiceConnectionEdgesServiceEdge includes the requested fields of the GraphQL type ServiceEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type GetServiceByNameServicesServiceConnectionEdgesServiceEdge struct {
+	// The item at the end of the edge.
+	Node GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService `json:"node"`
+}
+
+// GetNode returns GetServiceByNameServicesServiceConnectionEdgesServiceEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdge) GetNode() GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService {
+	return v.Node
+}
+
+// GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService includes the requested fields of the GraphQL type Service.
+type GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService struct {
+	// Unique identifier of the service
+	Id string `json:"id"`
+	// Service identifier in telemetry (e.g., 'checkout-service')
+	Name string `json:"name"`
+	// Description of service and telemetry characteristics
+	Description string `json:"description"`
+	// Whether telemetry analysis is enabled
+	Enabled bool `json:"enabled"`
+	// When the service was created
+	CreatedAt time.Time `json:"createdAt"`
+	// When the service was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.Id, and is useful for accessing the field via an interface.
+func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.Name, and is useful for accessing the field via an interface.
+func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.Description, and is useful for accessing the field via an interface.
+func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetDescription() string {
+	return v.Description
+}
+
+// GetEnabled returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.Enabled, and is useful for accessing the field via an interface.
+func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetEnabled() bool {
+	return v.Enabled
+}
+
+// GetCreatedAt returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetServiceByNameServicesServiceConnectionEdgesServiceEdgeNodeService) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetServiceNode includes the requested fields of the GraphQL interface Node.
+//
+// GetServiceNode is implemented by the following types:
+// GetServiceNodeAccount
+// GetServiceNodeChat
+// GetServiceNodeDatadogAccount
+// GetServiceNodeDatadogLogIndex
+// GetServiceNodeLogEvent
+// GetServiceNodeLogEventVolume
+// GetServiceNodeLogRule
+// GetServiceNodeLogRuleDeployment
+// GetServiceNodeMessage
+// GetServiceNodeOrganization
+// GetServiceNodeService
+// GetServiceNodeServiceLogVolume
+// GetServiceNodeTeam
+// GetServiceNodeWorkspace
+// The GraphQL type's documentation follows.
+//
+// An object with an ID.
+// Follows the [Relay Global Object Identification Specification](https://relay.dev/graphql/objectidentification.htm)
+type GetServiceNode interface {
+	implementsGraphQLInterfaceGetServiceNode()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *GetServiceNodeAccount) implementsGraphQLInterfaceGetServiceNode()           {}
+func (v *GetServiceNodeChat) implementsGraphQLInterfaceGetServiceNode()              {}
+func (v *GetServiceNodeDatadogAccount) implementsGraphQLInterfaceGetServiceNode()    {}
+func (v *GetServiceNodeDatadogLogIndex) implementsGraphQLInterfaceGetServiceNode()   {}
+func (v *GetServiceNodeLogEvent) implementsGraphQLInterfaceGetServiceNode()          {}
+func (v *GetServiceNodeLogEventVolume) implementsGraphQLInterfaceGetServiceNode()    {}
+func (v *GetServiceNodeLogRule) implementsGraphQLInterfaceGetServiceNode()           {}
+func (v *GetServiceNodeLogRuleDeployment) implementsGraphQLInterfaceGetServiceNode() {}
+func (v *GetServiceNodeMessage) implementsGraphQLInterfaceGetServiceNode()           {}
+func (v *GetServiceNodeOrganization) implementsGraphQLInterfaceGetServiceNode()      {}
+func (v *GetServiceNodeService) implementsGraphQLInterfaceGetServiceNode()           {}
+func (v *GetServiceNodeServiceLogVolume) implementsGraphQLInterfaceGetServiceNode()  {}
+func (v *GetServiceNodeTeam) implementsGraphQLInterfaceGetServiceNode()              {}
+func (v *GetServiceNodeWorkspace) implementsGraphQLInterfaceGetServiceNode()         {}
+
+func __unmarshalGetServiceNode(b []byte, v *GetServiceNode) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Account":
+		*v = new(GetServiceNodeAccount)
+		return json.Unmarshal(b, *v)
+	case "Chat":
+		*v = new(GetServiceNodeChat)
+		return json.Unmarshal(b, *v)
+	case "DatadogAccount":
+		*v = new(GetServiceNodeDatadogAccount)
+		return json.Unmarshal(b, *v)
+	case "DatadogLogIndex":
+		*v = new(GetServiceNodeDatadogLogIndex)
+		return json.Unmarshal(b, *v)
+	case "LogEvent":
+		*v = new(GetServiceNodeLogEvent)
+		return json.Unmarshal(b, *v)
+	case "LogEventVolume":
+		*v = new(GetServiceNodeLogEventVolume)
+		return json.Unmarshal(b, *v)
+	case "LogRule":
+		*v = new(GetServiceNodeLogRule)
+		return json.Unmarshal(b, *v)
+	case "LogRuleDeployment":
+		*v = new(GetServiceNodeLogRuleDeployment)
+		return json.Unmarshal(b, *v)
+	case "Message":
+		*v = new(GetServiceNodeMessage)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(GetServiceNodeOrganization)
+		return json.Unmarshal(b, *v)
+	case "Service":
+		*v = new(GetServiceNodeService)
+		return json.Unmarshal(b, *v)
+	case "ServiceLogVolume":
+		*v = new(GetServiceNodeServiceLogVolume)
+		return json.Unmarshal(b, *v)
+	case "Team":
+		*v = new(GetServiceNodeTeam)
+		return json.Unmarshal(b, *v)
+	case "Workspace":
+		*v = new(GetServiceNodeWorkspace)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Node.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetServiceNode: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetServiceNode(v *GetServiceNode) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetServiceNodeAccount:
+		typename = "Account"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeChat:
+		typename = "Chat"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeChat
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeDatadogAccount:
+		typename = "DatadogAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeDatadogAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeDatadogLogIndex:
+		typename = "DatadogLogIndex"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeDatadogLogIndex
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeLogEvent:
+		typename = "LogEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeLogEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeLogEventVolume:
+		typename = "LogEventVolume"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeLogEventVolume
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeLogRule:
+		typename = "LogRule"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeLogRule
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeLogRuleDeployment:
+		typename = "LogRuleDeployment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeLogRuleDeployment
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeMessage:
+		typename = "Message"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeMessage
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeService:
+		typename = "Service"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeService
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeServiceLogVolume:
+		typename = "ServiceLogVolume"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeServiceLogVolume
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeTeam:
+		typename = "Team"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeTeam
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetServiceNodeWorkspace:
+		typename = "Workspace"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetServiceNodeWorkspace
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetServiceNode: "%T"`, v)
+	}
+}
+
+// GetServiceNodeAccount includes the requested fields of the GraphQL type Account.
+type GetServiceNodeAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeAccount.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeAccount) GetTypename() string { return v.Typename }
+
+// GetServiceNodeChat includes the requested fields of the GraphQL type Chat.
+type GetServiceNodeChat struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeChat.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeChat) GetTypename() string { return v.Typename }
+
+// GetServiceNodeDatadogAccount includes the requested fields of the GraphQL type DatadogAccount.
+type GetServiceNodeDatadogAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeDatadogAccount.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeDatadogAccount) GetTypename() string { return v.Typename }
+
+// GetServiceNodeDatadogLogIndex includes the requested fields of the GraphQL type DatadogLogIndex.
+type GetServiceNodeDatadogLogIndex struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeDatadogLogIndex.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeDatadogLogIndex) GetTypename() string { return v.Typename }
+
+// GetServiceNodeLogEvent includes the requested fields of the GraphQL type LogEvent.
+type GetServiceNodeLogEvent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeLogEvent.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeLogEvent) GetTypename() string { return v.Typename }
+
+// GetServiceNodeLogEventVolume includes the requested fields of the GraphQL type LogEventVolume.
+type GetServiceNodeLogEventVolume struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeLogEventVolume.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeLogEventVolume) GetTypename() string { return v.Typename }
+
+// GetServiceNodeLogRule includes the requested fields of the GraphQL type LogRule.
+type GetServiceNodeLogRule struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeLogRule.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeLogRule) GetTypename() string { return v.Typename }
+
+// GetServiceNodeLogRuleDeployment includes the requested fields of the GraphQL type LogRuleDeployment.
+type GetServiceNodeLogRuleDeployment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeLogRuleDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeLogRuleDeployment) GetTypename() string { return v.Typename }
+
+// GetServiceNodeMessage includes the requested fields of the GraphQL type Message.
+type GetServiceNodeMessage struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeMessage.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeMessage) GetTypename() string { return v.Typename }
+
+// GetServiceNodeOrganization includes the requested fields of the GraphQL type Organization.
+type GetServiceNodeOrganization struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeOrganization) GetTypename() string { return v.Typename }
+
+// GetServiceNodeService includes the requested fields of the GraphQL type Service.
+type GetServiceNodeService struct {
+	Typename string `json:"__typename"`
+	// Unique identifier of the service
+	Id string `json:"id"`
+	// Service identifier in telemetry (e.g., 'checkout-service')
+	Name string `json:"name"`
+	// Description of service and telemetry characteristics
+	Description string `json:"description"`
+	// Whether telemetry analysis is enabled
+	Enabled bool `json:"enabled"`
+	// When the service was created
+	CreatedAt time.Time `json:"createdAt"`
+	// When the service was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Account this service belongs to
+	Account GetServiceNodeServiceAccount `json:"account"`
+	// Log event types produced by this service
+	LogEvents []GetServiceNodeServiceLogEventsLogEvent `json:"logEvents"`
+}
+
+// GetTypename returns GetServiceNodeService.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeService) GetTypename() string { return v.Typename }
+
+// GetId returns GetServiceNodeService.Id, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeService) GetId() string { return v.Id }
+
+// GetName returns GetServiceNodeService.Name, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeService) GetName() string { return v.Name }
+
+// GetDescription returns GetServiceNodeService.Description, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeService) GetDescription() string { return v.Description }
+
+// GetEnabled returns GetServiceNodeService.Enabled, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeService) GetEnabled() bool { return v.Enabled }
+
+// GetCreatedAt returns GetServiceNodeService.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeService) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetServiceNodeService.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeService) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetAccount returns GetServiceNodeService.Account, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeService) GetAccount() GetServiceNodeServiceAccount { return v.Account }
+
+// GetLogEvents returns GetServiceNodeService.LogEvents, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeService) GetLogEvents() []GetServiceNodeServiceLogEventsLogEvent {
+	return v.LogEvents
+}
+
+// GetServiceNodeServiceAccount includes the requested fields of the GraphQL type Account.
+type GetServiceNodeServiceAccount struct {
+	// Unique identifier of the account
+	Id string `json:"id"`
+	// Human-readable name within the organization
+	Name string `json:"name"`
+}
+
+// GetId returns GetServiceNodeServiceAccount.Id, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeServiceAccount) GetId() string { return v.Id }
+
+// GetName returns GetServiceNodeServiceAccount.Name, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeServiceAccount) GetName() string { return v.Name }
+
+// GetServiceNodeServiceLogEventsLogEvent includes the requested fields of the GraphQL type LogEvent.
+type GetServiceNodeServiceLogEventsLogEvent struct {
+	// Unique identifier of the log event
+	Id string `json:"id"`
+	// Snake_case identifier for event type
+	Name string `json:"name"`
+	// What this event pattern represents
+	Description string `json:"description"`
+	// When the log event was created
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetId returns GetServiceNodeServiceLogEventsLogEvent.Id, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeServiceLogEventsLogEvent) GetId() string { return v.Id }
+
+// GetName returns GetServiceNodeServiceLogEventsLogEvent.Name, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeServiceLogEventsLogEvent) GetName() string { return v.Name }
+
+// GetDescription returns GetServiceNodeServiceLogEventsLogEvent.Description, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeServiceLogEventsLogEvent) GetDescription() string { return v.Description }
+
+// GetCreatedAt returns GetServiceNodeServiceLogEventsLogEvent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeServiceLogEventsLogEvent) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetServiceNodeServiceLogVolume includes the requested fields of the GraphQL type ServiceLogVolume.
+type GetServiceNodeServiceLogVolume struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeServiceLogVolume.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeServiceLogVolume) GetTypename() string { return v.Typename }
+
+// GetServiceNodeTeam includes the requested fields of the GraphQL type Team.
+type GetServiceNodeTeam struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeTeam.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeTeam) GetTypename() string { return v.Typename }
+
+// GetServiceNodeWorkspace includes the requested fields of the GraphQL type Workspace.
+type GetServiceNodeWorkspace struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetServiceNodeWorkspace.Typename, and is useful for accessing the field via an interface.
+func (v *GetServiceNodeWorkspace) GetTypename() string { return v.Typename }
+
+// GetServiceResponse is returned by GetService on success.
+type GetServiceResponse struct {
+	// Fetches an object given its ID.
+	Node GetServiceNode `json:"-"`
+}
+
+// GetNode returns GetServiceResponse.Node, and is useful for accessing the field via an interface.
+func (v *GetServiceResponse) GetNode() GetServiceNode { return v.Node }
+
+func (v *GetServiceResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetServiceResponse
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetServiceResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetServiceNode(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetServiceResponse.Node: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetServiceResponse struct {
+	Node json.RawMessage `json:"node"`
+}
+
+func (v *GetServiceResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetServiceResponse) __premarshalJSON() (*__premarshalGetServiceResponse, error) {
+	var retval __premarshalGetServiceResponse
+
+	{
+
+		dst := &retval.Node
+		src := v.Node
+		var err error
+		*dst, err = __marshalGetServiceNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetServiceResponse.Node: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetServiceVolumeStatsResponse is returned by GetServiceVolumeStats on success.
+type GetServiceVolumeStatsResponse struct {
+	// Query services in your system.
+	Services GetServiceVolumeStatsServicesServiceConnection `json:"services"`
+}
+
+// GetServices returns GetServiceVolumeStatsResponse.Services, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsResponse) GetServices() GetServiceVolumeStatsServicesServiceConnection {
+	return v.Services
+}
+
+// GetServiceVolumeStatsServicesServiceConnection includes the requested fields of the GraphQL type ServiceConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type GetServiceVolumeStatsServicesServiceConnection struct {
+	// A list of edges.
+	Edges []GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge `json:"edges"`
+}
+
+// GetEdges returns GetServiceVolumeStatsServicesServiceConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnection) GetEdges() []GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge {
+	return v.Edges
+}
+
+// GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge includes the requested fields of the GraphQL type ServiceEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge struct {
+	// The item at the end of the edge.
+	Node GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService `json:"node"`
+}
+
+// GetNode returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge) GetNode() GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService {
+	return v.Node
+}
+
+// GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService includes the requested fields of the GraphQL type Service.
+type GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService struct {
+	// Unique identifier of the service
+	Id string `json:"id"`
+	// Service identifier in telemetry (e.g., 'checkout-service')
+	Name string `json:"name"`
+	// Whether telemetry analysis is enabled
+	Enabled bool `json:"enabled"`
+	// Get telemetry volume statistics for this service over a specified time window
+	VolumeStats GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate `json:"volumeStats"`
+}
+
+// GetId returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService.Id, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService.Name, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService) GetName() string {
+	return v.Name
+}
+
+// GetEnabled returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService.Enabled, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService) GetEnabled() bool {
+	return v.Enabled
+}
+
+// GetVolumeStats returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService.VolumeStats, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService) GetVolumeStats() GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate {
+	return v.VolumeStats
+}
+
+// GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate includes the requested fields of the GraphQL type LogVolumeAggregate.
+// The GraphQL type's documentation follows.
+//
+// Aggregated telemetry volume statistics over a time period.
+// This is a pie chart breakdown: unknown + valuable + waste + saved = total.
+type GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate struct {
+	LogVolumeStats `json:"-"`
+}
+
+// GetTotalVolume returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.TotalVolume, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetTotalVolume() float64 {
+	return v.LogVolumeStats.TotalVolume
+}
+
+// GetUnknownVolume returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.UnknownVolume, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetUnknownVolume() float64 {
+	return v.LogVolumeStats.UnknownVolume
+}
+
+// GetValuableVolume returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.ValuableVolume, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetValuableVolume() float64 {
+	return v.LogVolumeStats.ValuableVolume
+}
+
+// GetWasteVolume returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.WasteVolume, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetWasteVolume() float64 {
+	return v.LogVolumeStats.WasteVolume
+}
+
+// GetSavedVolume returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.SavedVolume, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetSavedVolume() float64 {
+	return v.LogVolumeStats.SavedVolume
+}
+
+// GetUnknownPercent returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.UnknownPercent, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetUnknownPercent() float64 {
+	return v.LogVolumeStats.UnknownPercent
+}
+
+// GetValuablePercent returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.ValuablePercent, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetValuablePercent() float64 {
+	return v.LogVolumeStats.ValuablePercent
+}
+
+// GetWastePercent returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.WastePercent, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetWastePercent() float64 {
+	return v.LogVolumeStats.WastePercent
+}
+
+// GetSavedPercent returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.SavedPercent, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetSavedPercent() float64 {
+	return v.LogVolumeStats.SavedPercent
+}
+
+// GetPeriodStart returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.PeriodStart, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetPeriodStart() time.Time {
+	return v.LogVolumeStats.PeriodStart
+}
+
+// GetPeriodEnd returns GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.PeriodEnd, and is useful for accessing the field via an interface.
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetPeriodEnd() time.Time {
+	return v.LogVolumeStats.PeriodEnd
+}
+
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogVolumeStats)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate struct {
+	TotalVolume float64 `json:"totalVolume"`
+
+	UnknownVolume float64 `json:"unknownVolume"`
+
+	ValuableVolume float64 `json:"valuableVolume"`
+
+	WasteVolume float64 `json:"wasteVolume"`
+
+	SavedVolume float64 `json:"savedVolume"`
+
+	UnknownPercent float64 `json:"unknownPercent"`
+
+	ValuablePercent float64 `json:"valuablePercent"`
+
+	WastePercent float64 `json:"wastePercent"`
+
+	SavedPercent float64 `json:"savedPercent"`
+
+	PeriodStart time.Time `json:"periodStart"`
+
+	PeriodEnd time.Time `json:"periodEnd"`
+}
+
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) __premarshalJSON() (*__premarshalGetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate, error) {
+	var retval __premarshalGetServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate
+
+	retval.TotalVolume = v.LogVolumeStats.TotalVolume
+	retval.UnknownVolume = v.LogVolumeStats.UnknownVolume
+	retval.ValuableVolume = v.LogVolumeStats.ValuableVolume
+	retval.WasteVolume = v.LogVolumeStats.WasteVolume
+	retval.SavedVolume = v.LogVolumeStats.SavedVolume
+	retval.UnknownPercent = v.LogVolumeStats.UnknownPercent
+	retval.ValuablePercent = v.LogVolumeStats.ValuablePercent
+	retval.WastePercent = v.LogVolumeStats.WastePercent
+	retval.SavedPercent = v.LogVolumeStats.SavedPercent
+	retval.PeriodStart = v.LogVolumeStats.PeriodStart
+	retval.PeriodEnd = v.LogVolumeStats.PeriodEnd
+	return &retval, nil
+}
+
+// ListAccountsAccountsAccountConnection includes the requested fields of the GraphQL type AccountConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type ListAccountsAccountsAccountConnection struct {
+	// A list of edges.
+	Edges []ListAccountsAccountsAccountConnectionEdgesAccountEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo ListAccountsAccountsAccountConnectionPageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int `json:"totalCount"`
+}
+
+// GetEdges returns ListAccountsAccountsAccountConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnection) GetEdges() []ListAccountsAccountsAccountConnectionEdgesAccountEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ListAccountsAccountsAccountConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnection) GetPageInfo() ListAccountsAccountsAccountConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns ListAccountsAccountsAccountConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnection) GetTotalCount() int { return v.TotalCount }
+
+// ListAccountsAccountsAccountConnectionEdgesAccountEdge includes the requested fields of the GraphQL type AccountEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type ListAccountsAccountsAccountConnectionEdgesAccountEdge struct {
+	// The item at the end of the edge.
+	Node ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount `json:"node"`
+}
+
+// GetNode returns ListAccountsAccountsAccountConnectionEdgesAccountEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionEdgesAccountEdge) GetNode() ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount {
+	return v.Node
+}
+
+// ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount includes the requested fields of the GraphQL type Account.
+type ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount struct {
+	// Unique identifier of the account
+	Id string `json:"id"`
+	// Human-readable name within the organization
+	Name string `json:"name"`
+	// When the account was created
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetId returns ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Id, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount.Name, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetName() string {
+	return v.Name
+}
+
+// GetCreatedAt returns ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionEdgesAccountEdgeNodeAccount) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// ListAccountsAccountsAccountConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+// https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+type ListAccountsAccountsAccountConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetHasNextPage returns ListAccountsAccountsAccountConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+// GetEndCursor returns ListAccountsAccountsAccountConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionPageInfo) GetEndCursor() string {
+	return v.PageInfoFields.EndCursor
+}
+
+func (v *ListAccountsAccountsAccountConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListAccountsAccountsAccountConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListAccountsAccountsAccountConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListAccountsAccountsAccountConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor string `json:"endCursor"`
+}
+
+func (v *ListAccountsAccountsAccountConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListAccountsAccountsAccountConnectionPageInfo) __premarshalJSON() (*__premarshalListAccountsAccountsAccountConnectionPageInfo, error) {
+	var retval __premarshalListAccountsAccountsAccountConnectionPageInfo
+
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	return &retval, nil
+}
+
+// ListAccountsResponse is returned by ListAccounts on success.
+type ListAccountsResponse struct {
+	// Query accounts. Accounts belong to an organization and contain services and workspaces.
+	Accounts ListAccountsAccountsAccountConnection `json:"accounts"`
+}
+
+// GetAccounts returns ListAccountsResponse.Accounts, and is useful for accessing the field via an interface.
+func (v *ListAccountsResponse) GetAccounts() ListAccountsAccountsAccountConnection { return v.Accounts }
+
+// ListLogEventVolumesLogEventVolumesLogEventVolumeConnection includes the requested fields of the GraphQL type LogEventVolumeConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type ListLogEventVolumesLogEventVolumesLogEventVolumeConnection struct {
+	// A list of edges.
+	Edges []ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns ListLogEventVolumesLogEventVolumesLogEventVolumeConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListLogEventVolumesLogEventVolumesLogEventVolumeConnection) GetEdges() []ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ListLogEventVolumesLogEventVolumesLogEventVolumeConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListLogEventVolumesLogEventVolumesLogEventVolumeConnection) GetPageInfo() ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdge includes the requested fields of the GraphQL type LogEventVolumeEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdge struct {
+	// The item at the end of the edge.
+	Node ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdgeNodeLogEventVolume `json:"node"`
+}
+
+// GetNode returns ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdge) GetNode() ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdgeNodeLogEventVolume {
+	return v.Node
+}
+
+// ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdgeNodeLogEventVolume includes the requested fields of the GraphQL type LogEventVolume.
+type ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdgeNodeLogEventVolume struct {
+	// Hour bucket timestamp (truncated to beginning of hour)
+	Timestamp time.Time `json:"timestamp"`
+	// Number of logs observed during this hour
+	Count float64 `json:"count"`
+}
+
+// GetTimestamp returns ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdgeNodeLogEventVolume.Timestamp, and is useful for accessing the field via an interface.
+func (v *ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdgeNodeLogEventVolume) GetTimestamp() time.Time {
+	return v.Timestamp
+}
+
+// GetCount returns ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdgeNodeLogEventVolume.Count, and is useful for accessing the field via an interface.
+func (v *ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionEdgesLogEventVolumeEdgeNodeLogEventVolume) GetCount() float64 {
+	return v.Count
+}
+
+// ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+// https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+type ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetHasNextPage returns ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+// GetEndCursor returns ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo) GetEndCursor() string {
+	return v.PageInfoFields.EndCursor
+}
+
+func (v *ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor string `json:"endCursor"`
+}
+
+func (v *ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo) __premarshalJSON() (*__premarshalListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo, error) {
+	var retval __premarshalListLogEventVolumesLogEventVolumesLogEventVolumeConnectionPageInfo
+
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	return &retval, nil
+}
+
+// ListLogEventVolumesResponse is returned by ListLogEventVolumes on success.
+type ListLogEventVolumesResponse struct {
+	// Query log event volumes from integration sources.
+	LogEventVolumes ListLogEventVolumesLogEventVolumesLogEventVolumeConnection `json:"logEventVolumes"`
+}
+
+// GetLogEventVolumes returns ListLogEventVolumesResponse.LogEventVolumes, and is useful for accessing the field via an interface.
+func (v *ListLogEventVolumesResponse) GetLogEventVolumes() ListLogEventVolumesLogEventVolumesLogEventVolumeConnection {
+	return v.LogEventVolumes
+}
+
+// ListLogEventsForServiceLogEventsLogEventConnection includes the requested fields of the GraphQL type LogEventConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type ListLogEventsForServiceLogEventsLogEventConnection struct {
+	// A list of edges.
+	Edges []ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo ListLogEventsForServiceLogEventsLogEventConnectionPageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int `json:"totalCount"`
+}
+
+// GetEdges returns ListLogEventsForServiceLogEventsLogEventConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnection) GetEdges() []ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ListLogEventsForServiceLogEventsLogEventConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnection) GetPageInfo() ListLogEventsForServiceLogEventsLogEventConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns ListLogEventsForServiceLogEventsLogEventConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnection) GetTotalCount() int { return v.TotalCount }
+
+// ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdge includes the requested fields of the GraphQL type LogEventEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdge struct {
+	// The item at the end of the edge.
+	Node ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent `json:"node"`
+}
+
+// GetNode returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdge) GetNode() ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent {
+	return v.Node
+}
+
+// ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent includes the requested fields of the GraphQL type LogEvent.
+type ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent struct {
+	// Unique identifier of the log event
+	Id string `json:"id"`
+	// Snake_case identifier for event type
+	Name string `json:"name"`
+	// What this event pattern represents
+	Description string `json:"description"`
+	// Get telemetry volume statistics for this log event over a specified time window
+	VolumeStats ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate `json:"volumeStats"`
+}
+
+// GetId returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent.Id, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent.Name, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent.Description, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent) GetDescription() string {
+	return v.Description
+}
+
+// GetVolumeStats returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent.VolumeStats, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEvent) GetVolumeStats() ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate {
+	return v.VolumeStats
+}
+
+// ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate includes the requested fields of the GraphQL type LogVolumeAggregate.
+// The GraphQL type's documentation follows.
+//
+// Aggregated telemetry volume statistics over a time period.
+// This is a pie chart breakdown: unknown + valuable + waste + saved = total.
+type ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate struct {
+	LogVolumeStats `json:"-"`
+}
+
+// GetTotalVolume returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.TotalVolume, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetTotalVolume() float64 {
+	return v.LogVolumeStats.TotalVolume
+}
+
+// GetUnknownVolume returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.UnknownVolume, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetUnknownVolume() float64 {
+	return v.LogVolumeStats.UnknownVolume
+}
+
+// GetValuableVolume returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.ValuableVolume, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetValuableVolume() float64 {
+	return v.LogVolumeStats.ValuableVolume
+}
+
+// GetWasteVolume returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.WasteVolume, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetWasteVolume() float64 {
+	return v.LogVolumeStats.WasteVolume
+}
+
+// GetSavedVolume returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.SavedVolume, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetSavedVolume() float64 {
+	return v.LogVolumeStats.SavedVolume
+}
+
+// GetUnknownPercent returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.UnknownPercent, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetUnknownPercent() float64 {
+	return v.LogVolumeStats.UnknownPercent
+}
+
+// GetValuablePercent returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.ValuablePercent, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetValuablePercent() float64 {
+	return v.LogVolumeStats.ValuablePercent
+}
+
+// GetWastePercent returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.WastePercent, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetWastePercent() float64 {
+	return v.LogVolumeStats.WastePercent
+}
+
+// GetSavedPercent returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.SavedPercent, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetSavedPercent() float64 {
+	return v.LogVolumeStats.SavedPercent
+}
+
+// GetPeriodStart returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.PeriodStart, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetPeriodStart() time.Time {
+	return v.LogVolumeStats.PeriodStart
+}
+
+// GetPeriodEnd returns ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate.PeriodEnd, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) GetPeriodEnd() time.Time {
+	return v.LogVolumeStats.PeriodEnd
+}
+
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogVolumeStats)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate struct {
+	TotalVolume float64 `json:"totalVolume"`
+
+	UnknownVolume float64 `json:"unknownVolume"`
+
+	ValuableVolume float64 `json:"valuableVolume"`
+
+	WasteVolume float64 `json:"wasteVolume"`
+
+	SavedVolume float64 `json:"savedVolume"`
+
+	UnknownPercent float64 `json:"unknownPercent"`
+
+	ValuablePercent float64 `json:"valuablePercent"`
+
+	WastePercent float64 `json:"wastePercent"`
+
+	SavedPercent float64 `json:"savedPercent"`
+
+	PeriodStart time.Time `json:"periodStart"`
+
+	PeriodEnd time.Time `json:"periodEnd"`
+}
+
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate) __premarshalJSON() (*__premarshalListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate, error) {
+	var retval __premarshalListLogEventsForServiceLogEventsLogEventConnectionEdgesLogEventEdgeNodeLogEventVolumeStatsLogVolumeAggregate
+
+	retval.TotalVolume = v.LogVolumeStats.TotalVolume
+	retval.UnknownVolume = v.LogVolumeStats.UnknownVolume
+	retval.ValuableVolume = v.LogVolumeStats.ValuableVolume
+	retval.WasteVolume = v.LogVolumeStats.WasteVolume
+	retval.SavedVolume = v.LogVolumeStats.SavedVolume
+	retval.UnknownPercent = v.LogVolumeStats.UnknownPercent
+	retval.ValuablePercent = v.LogVolumeStats.ValuablePercent
+	retval.WastePercent = v.LogVolumeStats.WastePercent
+	retval.SavedPercent = v.LogVolumeStats.SavedPercent
+	retval.PeriodStart = v.LogVolumeStats.PeriodStart
+	retval.PeriodEnd = v.LogVolumeStats.PeriodEnd
+	return &retval, nil
+}
+
+// ListLogEventsForServiceLogEventsLogEventConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+// https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+type ListLogEventsForServiceLogEventsLogEventConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetHasNextPage returns ListLogEventsForServiceLogEventsLogEventConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+// GetEndCursor returns ListLogEventsForServiceLogEventsLogEventConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionPageInfo) GetEndCursor() string {
+	return v.PageInfoFields.EndCursor
+}
+
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListLogEventsForServiceLogEventsLogEventConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListLogEventsForServiceLogEventsLogEventConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListLogEventsForServiceLogEventsLogEventConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor string `json:"endCursor"`
+}
+
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListLogEventsForServiceLogEventsLogEventConnectionPageInfo) __premarshalJSON() (*__premarshalListLogEventsForServiceLogEventsLogEventConnectionPageInfo, error) {
+	var retval __premarshalListLogEventsForServiceLogEventsLogEventConnectionPageInfo
+
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	return &retval, nil
+}
+
+// ListLogEventsForServiceResponse is returned by ListLogEventsForService on success.
+type ListLogEventsForServiceResponse struct {
+	// Query log events discovered in your services. Each log event is a distinct message pattern.
+	LogEvents ListLogEventsForServiceLogEventsLogEventConnection `json:"logEvents"`
+}
+
+// GetLogEvents returns ListLogEventsForServiceResponse.LogEvents, and is useful for accessing the field via an interface.
+func (v *ListLogEventsForServiceResponse) GetLogEvents() ListLogEventsForServiceLogEventsLogEventConnection {
+	return v.LogEvents
+}
+
+// ListLogRulesForServiceLogRulesLogRuleConnection includes the requested fields of the GraphQL type LogRuleConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type ListLogRulesForServiceLogRulesLogRuleConnection struct {
+	// A list of edges.
+	Edges []ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int `json:"totalCount"`
+}
+
+// GetEdges returns ListLogRulesForServiceLogRulesLogRuleConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnection) GetEdges() []ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ListLogRulesForServiceLogRulesLogRuleConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnection) GetPageInfo() ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns ListLogRulesForServiceLogRulesLogRuleConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnection) GetTotalCount() int { return v.TotalCount }
+
+// ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdge includes the requested fields of the GraphQL type LogRuleEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdge struct {
+	// The item at the end of the edge.
+	Node ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule `json:"node"`
+}
+
+// GetNode returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdge) GetNode() ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule {
+	return v.Node
+}
+
+// ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule includes the requested fields of the GraphQL type LogRule.
+type ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule struct {
+	LogRuleDetails `json:"-"`
+	// The log event this rule applies to
+	LogEvent ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent `json:"logEvent"`
+}
+
+// GetLogEvent returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.LogEvent, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetLogEvent() ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent {
+	return v.LogEvent
+}
+
+// GetId returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.Id, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetId() string {
+	return v.LogRuleDetails.Id
+}
+
+// GetLogEventID returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.LogEventID, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetLogEventID() string {
+	return v.LogRuleDetails.LogEventID
+}
+
+// GetWorkspaceID returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.WorkspaceID, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetWorkspaceID() string {
+	return v.LogRuleDetails.WorkspaceID
+}
+
+// GetRetention returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.Retention, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetRetention() LogRuleRetention {
+	return v.LogRuleDetails.Retention
+}
+
+// GetConfidence returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.Confidence, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetConfidence() LogRuleConfidence {
+	return v.LogRuleDetails.Confidence
+}
+
+// GetIgnoredAt returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.IgnoredAt, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetIgnoredAt() time.Time {
+	return v.LogRuleDetails.IgnoredAt
+}
+
+// GetVrlScript returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.VrlScript, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetVrlScript() string {
+	return v.LogRuleDetails.VrlScript
+}
+
+// GetRationale returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.Rationale, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetRationale() string {
+	return v.LogRuleDetails.Rationale
+}
+
+// GetCreatedByType returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.CreatedByType, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetCreatedByType() LogRuleCreatedByType {
+	return v.LogRuleDetails.CreatedByType
+}
+
+// GetCreatedByID returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.CreatedByID, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetCreatedByID() string {
+	return v.LogRuleDetails.CreatedByID
+}
+
+// GetCreatedAt returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetCreatedAt() time.Time {
+	return v.LogRuleDetails.CreatedAt
+}
+
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogRuleDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule struct {
+	LogEvent ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent `json:"logEvent"`
+
+	Id string `json:"id"`
+
+	LogEventID string `json:"logEventID"`
+
+	WorkspaceID string `json:"workspaceID"`
+
+	Retention LogRuleRetention `json:"retention"`
+
+	Confidence LogRuleConfidence `json:"confidence"`
+
+	IgnoredAt time.Time `json:"ignoredAt"`
+
+	VrlScript string `json:"vrlScript"`
+
+	Rationale string `json:"rationale"`
+
+	CreatedByType LogRuleCreatedByType `json:"createdByType"`
+
+	CreatedByID string `json:"createdByID"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) __premarshalJSON() (*__premarshalListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule, error) {
+	var retval __premarshalListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule
+
+	retval.LogEvent = v.LogEvent
+	retval.Id = v.LogRuleDetails.Id
+	retval.LogEventID = v.LogRuleDetails.LogEventID
+	retval.WorkspaceID = v.LogRuleDetails.WorkspaceID
+	retval.Retention = v.LogRuleDetails.Retention
+	retval.Confidence = v.LogRuleDetails.Confidence
+	retval.IgnoredAt = v.LogRuleDetails.IgnoredAt
+	retval.VrlScript = v.LogRuleDetails.VrlScript
+	retval.Rationale = v.LogRuleDetails.Rationale
+	retval.CreatedByType = v.LogRuleDetails.CreatedByType
+	retval.CreatedByID = v.LogRuleDetails.CreatedByID
+	retval.CreatedAt = v.LogRuleDetails.CreatedAt
+	return &retval, nil
+}
+
+// ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent includes the requested fields of the GraphQL type LogEvent.
+type ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent struct {
+	// Unique identifier of the log event
+	Id string `json:"id"`
+	// Snake_case identifier for event type
+	Name string `json:"name"`
+}
+
+// GetId returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent.Id, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent.Name, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent) GetName() string {
+	return v.Name
+}
+
+// ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+// https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+type ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetHasNextPage returns ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+// GetEndCursor returns ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo) GetEndCursor() string {
+	return v.PageInfoFields.EndCursor
+}
+
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListLogRulesForServiceLogRulesLogRuleConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor string `json:"endCursor"`
+}
+
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListLogRulesForServiceLogRulesLogRuleConnectionPageInfo) __premarshalJSON() (*__premarshalListLogRulesForServiceLogRulesLogRuleConnectionPageInfo, error) {
+	var retval __premarshalListLogRulesForServiceLogRulesLogRuleConnectionPageInfo
+
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	return &retval, nil
+}
+
+// ListLogRulesForServiceResponse is returned by ListLogRulesForService on success.
+type ListLogRulesForServiceResponse struct {
+	// Query log retention rules. Rules determine which logs to keep or drop.
+	LogRules ListLogRulesForServiceLogRulesLogRuleConnection `json:"logRules"`
+}
+
+// GetLogRules returns ListLogRulesForServiceResponse.LogRules, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForServiceResponse) GetLogRules() ListLogRulesForServiceLogRulesLogRuleConnection {
+	return v.LogRules
+}
+
+// ListLogRulesForWorkspaceLogRulesLogRuleConnection includes the requested fields of the GraphQL type LogRuleConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type ListLogRulesForWorkspaceLogRulesLogRuleConnection struct {
+	// A list of edges.
+	Edges []ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int `json:"totalCount"`
+}
+
+// GetEdges returns ListLogRulesForWorkspaceLogRulesLogRuleConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnection) GetEdges() []ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ListLogRulesForWorkspaceLogRulesLogRuleConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnection) GetPageInfo() ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns ListLogRulesForWorkspaceLogRulesLogRuleConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnection) GetTotalCount() int { return v.TotalCount }
+
+// ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdge includes the requested fields of the GraphQL type LogRuleEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdge struct {
+	// The item at the end of the edge.
+	Node ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule `json:"node"`
+}
+
+// GetNode returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdge) GetNode() ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule {
+	return v.Node
+}
+
+// ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule includes the requested fields of the GraphQL type LogRule.
+type ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule struct {
+	LogRuleDetails `json:"-"`
+	// The log event this rule applies to
+	LogEvent ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent `json:"logEvent"`
+	// Where this rule is deployed
+	Deployments []ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment `json:"deployments"`
+}
+
+// GetLogEvent returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.LogEvent, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetLogEvent() ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent {
+	return v.LogEvent
+}
+
+// GetDeployments returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.Deployments, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetDeployments() []ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment {
+	return v.Deployments
+}
+
+// GetId returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.Id, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetId() string {
+	return v.LogRuleDetails.Id
+}
+
+// GetLogEventID returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.LogEventID, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetLogEventID() string {
+	return v.LogRuleDetails.LogEventID
+}
+
+// GetWorkspaceID returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.WorkspaceID, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetWorkspaceID() string {
+	return v.LogRuleDetails.WorkspaceID
+}
+
+// GetRetention returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.Retention, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetRetention() LogRuleRetention {
+	return v.LogRuleDetails.Retention
+}
+
+// GetConfidence returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.Confidence, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetConfidence() LogRuleConfidence {
+	return v.LogRuleDetails.Confidence
+}
+
+// GetIgnoredAt returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.IgnoredAt, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetIgnoredAt() time.Time {
+	return v.LogRuleDetails.IgnoredAt
+}
+
+// GetVrlScript returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.VrlScript, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetVrlScript() string {
+	return v.LogRuleDetails.VrlScript
+}
+
+// GetRationale returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.Rationale, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetRationale() string {
+	return v.LogRuleDetails.Rationale
+}
+
+// GetCreatedByType returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.CreatedByType, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetCreatedByType() LogRuleCreatedByType {
+	return v.LogRuleDetails.CreatedByType
+}
+
+// GetCreatedByID returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.CreatedByID, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetCreatedByID() string {
+	return v.LogRuleDetails.CreatedByID
+}
+
+// GetCreatedAt returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) GetCreatedAt() time.Time {
+	return v.LogRuleDetails.CreatedAt
+}
+
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogRuleDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule struct {
+	LogEvent ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent `json:"logEvent"`
+
+	Deployments []ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment `json:"deployments"`
+
+	Id string `json:"id"`
+
+	LogEventID string `json:"logEventID"`
+
+	WorkspaceID string `json:"workspaceID"`
+
+	Retention LogRuleRetention `json:"retention"`
+
+	Confidence LogRuleConfidence `json:"confidence"`
+
+	IgnoredAt time.Time `json:"ignoredAt"`
+
+	VrlScript string `json:"vrlScript"`
+
+	Rationale string `json:"rationale"`
+
+	CreatedByType LogRuleCreatedByType `json:"createdByType"`
+
+	CreatedByID string `json:"createdByID"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule) __premarshalJSON() (*__premarshalListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule, error) {
+	var retval __premarshalListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRule
+
+	retval.LogEvent = v.LogEvent
+	retval.Deployments = v.Deployments
+	retval.Id = v.LogRuleDetails.Id
+	retval.LogEventID = v.LogRuleDetails.LogEventID
+	retval.WorkspaceID = v.LogRuleDetails.WorkspaceID
+	retval.Retention = v.LogRuleDetails.Retention
+	retval.Confidence = v.LogRuleDetails.Confidence
+	retval.IgnoredAt = v.LogRuleDetails.IgnoredAt
+	retval.VrlScript = v.LogRuleDetails.VrlScript
+	retval.Rationale = v.LogRuleDetails.Rationale
+	retval.CreatedByType = v.LogRuleDetails.CreatedByType
+	retval.CreatedByID = v.LogRuleDetails.CreatedByID
+	retval.CreatedAt = v.LogRuleDetails.CreatedAt
+	return &retval, nil
+}
+
+// ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment includes the requested fields of the GraphQL type LogRuleDeployment.
+type ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment struct {
+	LogRuleDeploymentDetails `json:"-"`
+}
+
+// GetId returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment.Id, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment) GetId() string {
+	return v.LogRuleDeploymentDetails.Id
+}
+
+// GetExternalID returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment.ExternalID, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment) GetExternalID() string {
+	return v.LogRuleDeploymentDetails.ExternalID
+}
+
+// GetLastError returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment.LastError, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment) GetLastError() string {
+	return v.LogRuleDeploymentDetails.LastError
+}
+
+// GetUpdatedAt returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment) GetUpdatedAt() time.Time {
+	return v.LogRuleDeploymentDetails.UpdatedAt
+}
+
+// GetDatadogLogIndex returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment.DatadogLogIndex, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment) GetDatadogLogIndex() LogRuleDeploymentDetailsDatadogLogIndex {
+	return v.LogRuleDeploymentDetails.DatadogLogIndex
+}
+
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogRuleDeploymentDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment struct {
+	Id string `json:"id"`
+
+	ExternalID string `json:"externalID"`
+
+	LastError string `json:"lastError"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	DatadogLogIndex LogRuleDeploymentDetailsDatadogLogIndex `json:"datadogLogIndex"`
+}
+
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment) __premarshalJSON() (*__premarshalListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment, error) {
+	var retval __premarshalListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleDeploymentsLogRuleDeployment
+
+	retval.Id = v.LogRuleDeploymentDetails.Id
+	retval.ExternalID = v.LogRuleDeploymentDetails.ExternalID
+	retval.LastError = v.LogRuleDeploymentDetails.LastError
+	retval.UpdatedAt = v.LogRuleDeploymentDetails.UpdatedAt
+	retval.DatadogLogIndex = v.LogRuleDeploymentDetails.DatadogLogIndex
+	return &retval, nil
+}
+
+// ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent includes the requested fields of the GraphQL type LogEvent.
+type ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent struct {
+	// Unique identifier of the log event
+	Id string `json:"id"`
+	// Snake_case identifier for event type
+	Name string `json:"name"`
+	// Service that produces this event
+	Service ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEventService `json:"service"`
+}
+
+// GetId returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent.Id, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent.Name, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent) GetName() string {
+	return v.Name
+}
+
+// GetService returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent.Service, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEvent) GetService() ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEventService {
+	return v.Service
+}
+
+// ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEventService includes the requested fields of the GraphQL type Service.
+type ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEventService struct {
+	// Unique identifier of the service
+	Id string `json:"id"`
+	// Service identifier in telemetry (e.g., 'checkout-service')
+	Name string `json:"name"`
+}
+
+// GetId returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEventService.Id, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEventService) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEventService.Name, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionEdgesLogRuleEdgeNodeLogRuleLogEventService) GetName() string {
+	return v.Name
+}
+
+// ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+// https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+type ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetHasNextPage returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+// GetEndCursor returns ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo) GetEndCursor() string {
+	return v.PageInfoFields.EndCursor
+}
+
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor string `json:"endCursor"`
+}
+
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo) __premarshalJSON() (*__premarshalListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo, error) {
+	var retval __premarshalListLogRulesForWorkspaceLogRulesLogRuleConnectionPageInfo
+
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	return &retval, nil
+}
+
+// ListLogRulesForWorkspaceResponse is returned by ListLogRulesForWorkspace on success.
+type ListLogRulesForWorkspaceResponse struct {
+	// Query log retention rules. Rules determine which logs to keep or drop.
+	LogRules ListLogRulesForWorkspaceLogRulesLogRuleConnection `json:"logRules"`
+}
+
+// GetLogRules returns ListLogRulesForWorkspaceResponse.LogRules, and is useful for accessing the field via an interface.
+func (v *ListLogRulesForWorkspaceResponse) GetLogRules() ListLogRulesForWorkspaceLogRulesLogRuleConnection {
+	return v.LogRules
+}
+
+// ListOrganizationsOrganizationsOrganizationConnection includes the requested fields of the GraphQL type OrganizationConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type ListOrganizationsOrganizationsOrganizationConnection struct {
+	// A list of edges.
+	Edges []ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo ListOrganizationsOrganizationsOrganizationConnectionPageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int `json:"totalCount"`
+}
+
+// GetEdges returns ListOrganizationsOrganizationsOrganizationConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListOrganizationsOrganizationsOrganizationConnection) GetEdges() []ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ListOrganizationsOrganizationsOrganizationConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListOrganizationsOrganizationsOrganizationConnection) GetPageInfo() ListOrganizationsOrganizationsOrganizationConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns ListOrganizationsOrganizationsOrganizationConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *ListOrganizationsOrganizationsOrganizationConnection) GetTotalCount() int {
+	return v.TotalCount
+}
+
+// ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge includes the requested fields of the GraphQL type OrganizationEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge struct {
+	// The item at the end of the edge.
+	Node ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization `json:"node"`
+}
+
+// GetNode returns ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdge) GetNode() ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization {
+	return v.Node
+}
+
+// ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization includes the requested fields of the GraphQL type Organization.
+type ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization struct {
+	// Unique identifier of the organization
+	Id string `json:"id"`
+	// Human-readable name, unique across the system
+	Name string `json:"name"`
+	// When the organization was created
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetId returns ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization.Id, and is useful for accessing the field via an interface.
+func (v *ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization.Name, and is useful for accessing the field via an interface.
+func (v *ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization) GetName() string {
+	return v.Name
+}
+
+// GetCreatedAt returns ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListOrganizationsOrganizationsOrganizationConnectionEdgesOrganizationEdgeNodeOrganization) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// ListOrganizationsOrganizationsOrganizationConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+// https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+type ListOrganizationsOrganizationsOrganizationConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetHasNextPage returns ListOrganizationsOrganizationsOrganizationConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListOrganizationsOrganizationsOrganizationConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+// GetEndCursor returns ListOrganizationsOrganizationsOrganizationConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListOrganizationsOrganizationsOrganizationConnectionPageInfo) GetEndCursor() string {
+	return v.PageInfoFields.EndCursor
+}
+
+func (v *ListOrganizationsOrganizationsOrganizationConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListOrganizationsOrganizationsOrganizationConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListOrganizationsOrganizationsOrganizationConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListOrganizationsOrganizationsOrganizationConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor string `json:"endCursor"`
+}
+
+func (v *ListOrganizationsOrganizationsOrganizationConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListOrganizationsOrganizationsOrganizationConnectionPageInfo) __premarshalJSON() (*__premarshalListOrganizationsOrganizationsOrganizationConnectionPageInfo, error) {
+	var retval __premarshalListOrganizationsOrganizationsOrganizationConnectionPageInfo
+
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	return &retval, nil
+}
+
+// ListOrganizationsResponse is returned by ListOrganizations on success.
+type ListOrganizationsResponse struct {
+	// Query organizations. An organization is the top-level container that holds accounts.
+	Organizations ListOrganizationsOrganizationsOrganizationConnection `json:"organizations"`
+}
+
+// GetOrganizations returns ListOrganizationsResponse.Organizations, and is useful for accessing the field via an interface.
+func (v *ListOrganizationsResponse) GetOrganizations() ListOrganizationsOrganizationsOrganizationConnection {
+	return v.Organizations
+}
+
+// ListServiceVolumeStatsResponse is returned by ListServiceVolumeStats on success.
+type ListServiceVolumeStatsResponse struct {
+	// Query services in your system.
+	Services ListServiceVolumeStatsServicesServiceConnection `json:"services"`
+}
+
+// GetServices returns ListServiceVolumeStatsResponse.Services, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsResponse) GetServices() ListServiceVolumeStatsServicesServiceConnection {
+	return v.Services
+}
+
+// ListServiceVolumeStatsServicesServiceConnection includes the requested fields of the GraphQL type ServiceConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type ListServiceVolumeStatsServicesServiceConnection struct {
+	// A list of edges.
+	Edges []ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo ListServiceVolumeStatsServicesServiceConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns ListServiceVolumeStatsServicesServiceConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnection) GetEdges() []ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ListServiceVolumeStatsServicesServiceConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnection) GetPageInfo() ListServiceVolumeStatsServicesServiceConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge includes the requested fields of the GraphQL type ServiceEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge struct {
+	// The item at the end of the edge.
+	Node ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService `json:"node"`
+}
+
+// GetNode returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdge) GetNode() ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService {
+	return v.Node
+}
+
+// ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService includes the requested fields of the GraphQL type Service.
+type ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService struct {
+	// Unique identifier of the service
+	Id string `json:"id"`
+	// Service identifier in telemetry (e.g., 'checkout-service')
+	Name string `json:"name"`
+	// Whether telemetry analysis is enabled
+	Enabled bool `json:"enabled"`
+	// Approximate weekly log count from initial discovery (7-day period from Datadog)
+	InitialWeeklyLogCount *int `json:"initialWeeklyLogCount"`
+	// Get telemetry volume statistics for this service over a specified time window
+	VolumeStats ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate `json:"volumeStats"`
+}
+
+// GetId returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService.Id, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService.Name, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService) GetName() string {
+	return v.Name
+}
+
+// GetEnabled returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService.Enabled, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService) GetEnabled() bool {
+	return v.Enabled
+}
+
+// GetInitialWeeklyLogCount returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService.InitialWeeklyLogCount, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService) GetInitialWeeklyLogCount() *int {
+	return v.InitialWeeklyLogCount
+}
+
+// GetVolumeStats returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService.VolumeStats, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeService) GetVolumeStats() ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate {
+	return v.VolumeStats
+}
+
+// ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate includes the requested fields of the GraphQL type LogVolumeAggregate.
+// The GraphQL type's documentation follows.
+//
+// Aggregated telemetry volume statistics over a time period.
+// This is a pie chart breakdown: unknown + valuable + waste + saved = total.
+type ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate struct {
+	LogVolumeStats `json:"-"`
+}
+
+// GetTotalVolume returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.TotalVolume, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetTotalVolume() float64 {
+	return v.LogVolumeStats.TotalVolume
+}
+
+// GetUnknownVolume returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.UnknownVolume, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetUnknownVolume() float64 {
+	return v.LogVolumeStats.UnknownVolume
+}
+
+// GetValuableVolume returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.ValuableVolume, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetValuableVolume() float64 {
+	return v.LogVolumeStats.ValuableVolume
+}
+
+// GetWasteVolume returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.WasteVolume, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetWasteVolume() float64 {
+	return v.LogVolumeStats.WasteVolume
+}
+
+// GetSavedVolume returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.SavedVolume, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetSavedVolume() float64 {
+	return v.LogVolumeStats.SavedVolume
+}
+
+// GetUnknownPercent returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.UnknownPercent, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetUnknownPercent() float64 {
+	return v.LogVolumeStats.UnknownPercent
+}
+
+// GetValuablePercent returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.ValuablePercent, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetValuablePercent() float64 {
+	return v.LogVolumeStats.ValuablePercent
+}
+
+// GetWastePercent returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.WastePercent, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetWastePercent() float64 {
+	return v.LogVolumeStats.WastePercent
+}
+
+// GetSavedPercent returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.SavedPercent, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetSavedPercent() float64 {
+	return v.LogVolumeStats.SavedPercent
+}
+
+// GetPeriodStart returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.PeriodStart, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetPeriodStart() time.Time {
+	return v.LogVolumeStats.PeriodStart
+}
+
+// GetPeriodEnd returns ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate.PeriodEnd, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) GetPeriodEnd() time.Time {
+	return v.LogVolumeStats.PeriodEnd
+}
+
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LogVolumeStats)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate struct {
+	TotalVolume float64 `json:"totalVolume"`
+
+	UnknownVolume float64 `json:"unknownVolume"`
+
+	ValuableVolume float64 `json:"valuableVolume"`
+
+	WasteVolume float64 `json:"wasteVolume"`
+
+	SavedVolume float64 `json:"savedVolume"`
+
+	UnknownPercent float64 `json:"unknownPercent"`
+
+	ValuablePercent float64 `json:"valuablePercent"`
+
+	WastePercent float64 `json:"wastePercent"`
+
+	SavedPercent float64 `json:"savedPercent"`
+
+	PeriodStart time.Time `json:"periodStart"`
+
+	PeriodEnd time.Time `json:"periodEnd"`
+}
+
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate) __premarshalJSON() (*__premarshalListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate, error) {
+	var retval __premarshalListServiceVolumeStatsServicesServiceConnectionEdgesServiceEdgeNodeServiceVolumeStatsLogVolumeAggregate
+
+	retval.TotalVolume = v.LogVolumeStats.TotalVolume
+	retval.UnknownVolume = v.LogVolumeStats.UnknownVolume
+	retval.ValuableVolume = v.LogVolumeStats.ValuableVolume
+	retval.WasteVolume = v.LogVolumeStats.WasteVolume
+	retval.SavedVolume = v.LogVolumeStats.SavedVolume
+	retval.UnknownPercent = v.LogVolumeStats.UnknownPercent
+	retval.ValuablePercent = v.LogVolumeStats.ValuablePercent
+	retval.WastePercent = v.LogVolumeStats.WastePercent
+	retval.SavedPercent = v.LogVolumeStats.SavedPercent
+	retval.PeriodStart = v.LogVolumeStats.PeriodStart
+	retval.PeriodEnd = v.LogVolumeStats.PeriodEnd
+	return &retval, nil
+}
+
+// ListServiceVolumeStatsServicesServiceConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+// https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+type ListServiceVolumeStatsServicesServiceConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetHasNextPage returns ListServiceVolumeStatsServicesServiceConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+// GetEndCursor returns ListServiceVolumeStatsServicesServiceConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListServiceVolumeStatsServicesServiceConnectionPageInfo) GetEndCursor() string {
+	return v.PageInfoFields.EndCursor
+}
+
+func (v *ListServiceVolumeStatsServicesServiceConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListServiceVolumeStatsServicesServiceConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListServiceVolumeStatsServicesServiceConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListServiceVolumeStatsServicesServiceConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor string `json:"endCursor"`
+}
+
+func (v *ListServiceVolumeStatsServicesServiceConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListServiceVolumeStatsServicesServiceConnectionPageInfo) __premarshalJSON() (*__premarshalListServiceVolumeStatsServicesServiceConnectionPageInfo, error) {
+	var retval __premarshalListServiceVolumeStatsServicesServiceConnectionPageInfo
+
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	return &retval, nil
+}
+
+// ListServicesResponse is returned by ListServices on success.
+type ListServicesResponse struct {
+	// Query services in your system.
+	Services ListServicesServicesServiceConnection `json:"services"`
+}
+
+// GetServices returns ListServicesResponse.Services, and is useful for accessing the field via an interface.
+func (v *ListServicesResponse) GetServices() ListServicesServicesServiceConnection { return v.Services }
+
+// ListServicesServicesServiceConnection includes the requested fields of the GraphQL type ServiceConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type ListServicesServicesServiceConnection struct {
+	// A list of edges.
+	Edges []ListServicesServicesServiceConnectionEdgesServiceEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo ListServicesServicesServiceConnectionPageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int `json:"totalCount"`
+}
+
+// GetEdges returns ListServicesServicesServiceConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnection) GetEdges() []ListServicesServicesServiceConnectionEdgesServiceEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ListServicesServicesServiceConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnection) GetPageInfo() ListServicesServicesServiceConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns ListServicesServicesServiceConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnection) GetTotalCount() int { return v.TotalCount }
+
+// ListServicesServicesServiceConnectionEdgesServiceEdge includes the requested fields of the GraphQL type ServiceEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type ListServicesServicesServiceConnectionEdgesServiceEdge struct {
+	// The item at the end of the edge.
+	Node ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService `json:"node"`
+}
+
+// GetNode returns ListServicesServicesServiceConnectionEdgesServiceEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnectionEdgesServiceEdge) GetNode() ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService {
+	return v.Node
+}
+
+// ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService includes the requested fields of the GraphQL type Service.
+type ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService struct {
+	// Unique identifier of the service
+	Id string `json:"id"`
+	// Service identifier in telemetry (e.g., 'checkout-service')
+	Name string `json:"name"`
+	// Description of service and telemetry characteristics
+	Description string `json:"description"`
+	// Whether telemetry analysis is enabled
+	Enabled bool `json:"enabled"`
+	// When the service was created
+	CreatedAt time.Time `json:"createdAt"`
+	// When the service was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.Id, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.Name, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.Description, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetDescription() string {
+	return v.Description
+}
+
+// GetEnabled returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.Enabled, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetEnabled() bool {
+	return v.Enabled
+}
+
+// GetCreatedAt returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnectionEdgesServiceEdgeNodeService) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// ListServicesServicesServiceConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+// https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+type ListServicesServicesServiceConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetHasNextPage returns ListServicesServicesServiceConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+// GetEndCursor returns ListServicesServicesServiceConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListServicesServicesServiceConnectionPageInfo) GetEndCursor() string {
+	return v.PageInfoFields.EndCursor
+}
+
+func (v *ListServicesServicesServiceConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListServicesServicesServiceConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListServicesServicesServiceConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListServicesServicesServiceConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor string `json:"endCursor"`
+}
+
+func (v *ListServicesServicesServiceConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListServicesServicesServiceConnectionPageInfo) __premarshalJSON() (*__premarshalListServicesServicesServiceConnectionPageInfo, error) {
+	var retval __premarshalListServicesServicesServiceConnectionPageInfo
+
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	return &retval, nil
+}
+
+// ListWorkspacesResponse is returned by ListWorkspaces on success.
+type ListWorkspacesResponse struct {
+	// Query workspaces. Workspaces are used to analyze and classify telemetry.
+	Workspaces ListWorkspacesWorkspacesWorkspaceConnection `json:"workspaces"`
+}
+
+// GetWorkspaces returns ListWorkspacesResponse.Workspaces, and is useful for accessing the field via an interface.
+func (v *ListWorkspacesResponse) GetWorkspaces() ListWorkspacesWorkspacesWorkspaceConnection {
+	return v.Workspaces
+}
+
+// ListWorkspacesWorkspacesWorkspaceConnection includes the requested fields of the GraphQL type WorkspaceConnection.
+// The GraphQL type's documentation follows.
+//
+// A connection to a list of items.
+type ListWorkspacesWorkspacesWorkspaceConnection struct {
+	// A list of edges.
+	Edges []ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo ListWorkspacesWorkspacesWorkspaceConnectionPageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int `json:"totalCount"`
+}
+
+// GetEdges returns ListWorkspacesWorkspacesWorkspaceConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListWorkspacesWorkspacesWorkspaceConnection) GetEdges() []ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ListWorkspacesWorkspacesWorkspaceConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListWorkspacesWorkspacesWorkspaceConnection) GetPageInfo() ListWorkspacesWorkspacesWorkspaceConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetTotalCount returns ListWorkspacesWorkspacesWorkspaceConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *ListWorkspacesWorkspacesWorkspaceConnection) GetTotalCount() int { return v.TotalCount }
+
+// ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdge includes the requested fields of the GraphQL type WorkspaceEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge in a connection.
+type ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdge struct {
+	// The item at the end of the edge.
+	Node ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdgeNodeWorkspace `json:"node"`
+}
+
+// GetNode returns ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdge) GetNode() ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdgeNodeWorkspace {
+	return v.Node
+}
+
+// ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdgeNodeWorkspace includes the requested fields of the GraphQL type Workspace.
+type ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdgeNodeWorkspace struct {
+	// Unique identifier of the workspace
+	Id string `json:"id"`
+	// Human-readable name within the account
+	Name string `json:"name"`
+	// Primary purpose determining evaluation strategy
+	Purpose WorkspacePurpose `json:"purpose"`
+}
+
+// GetId returns ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdgeNodeWorkspace.Id, and is useful for accessing the field via an interface.
+func (v *ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdgeNodeWorkspace) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdgeNodeWorkspace.Name, and is useful for accessing the field via an interface.
+func (v *ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdgeNodeWorkspace) GetName() string {
+	return v.Name
+}
+
+// GetPurpose returns ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdgeNodeWorkspace.Purpose, and is useful for accessing the field via an interface.
+func (v *ListWorkspacesWorkspacesWorkspaceConnectionEdgesWorkspaceEdgeNodeWorkspace) GetPurpose() WorkspacePurpose {
+	return v.Purpose
+}
+
+// ListWorkspacesWorkspacesWorkspaceConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+// https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+type ListWorkspacesWorkspacesWorkspaceConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetHasNextPage returns ListWorkspacesWorkspacesWorkspaceConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListWorkspacesWorkspacesWorkspaceConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+// GetEndCursor returns ListWorkspacesWorkspacesWorkspaceConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListWorkspacesWorkspacesWorkspaceConnectionPageInfo) GetEndCursor() string {
+	return v.PageInfoFields.EndCursor
+}
+
+func (v *ListWorkspacesWorkspacesWorkspaceConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListWorkspacesWorkspacesWorkspaceConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListWorkspacesWorkspacesWorkspaceConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListWorkspacesWorkspacesWorkspaceConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor string `json:"endCursor"`
+}
+
+func (v *ListWorkspacesWorkspacesWorkspaceConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListWorkspacesWorkspacesWorkspaceConnectionPageInfo) __premarshalJSON() (*__premarshalListWorkspacesWorkspacesWorkspaceConnectionPageInfo, error) {
+	var retval __premarshalListWorkspacesWorkspacesWorkspaceConnectionPageInfo
+
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	return &retval, nil
+}
+
+// LogRuleConfidence is enum for the field confidence
+type LogRuleConfidence string
+
+const (
+	LogRuleConfidenceVeryHigh LogRuleConfidence = "very_high"
+	LogRuleConfidenceHigh     LogRuleConfidence = "high"
+	LogRuleConfidenceMedium   LogRuleConfidence = "medium"
+	LogRuleConfidenceLow      LogRuleConfidence = "low"
+	LogRuleConfidenceVeryLow  LogRuleConfidence = "very_low"
+)
+
+var AllLogRuleConfidence = []LogRuleConfidence{
+	LogRuleConfidenceVeryHigh,
+	LogRuleConfidenceHigh,
+	LogRuleConfidenceMedium,
+	LogRuleConfidenceLow,
+	LogRuleConfidenceVeryLow,
+}
+
+// LogRuleCreatedByType is enum for the field created_by_type
+type LogRuleCreatedByType string
+
+const (
+	LogRuleCreatedByTypeAi   LogRuleCreatedByType = "ai"
+	LogRuleCreatedByTypeUser LogRuleCreatedByType = "user"
+)
+
+var AllLogRuleCreatedByType = []LogRuleCreatedByType{
+	LogRuleCreatedByTypeAi,
+	LogRuleCreatedByTypeUser,
+}
+
+// Where a log rule is deployed, shared across rule queries
+type LogRuleDeploymentDetails struct {
+	// Unique identifier for this deployment
+	Id string `json:"id"`
+	// ID of the rule in the external system (e.g., Datadog exclusion filter ID). Null if deployment drifted or failed.
+	ExternalID string `json:"externalID"`
+	// Most recent error message if deployment failed
+	LastError string `json:"lastError"`
+	// When deployment was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Datadog log index where this rule is deployed
+	DatadogLogIndex LogRuleDeploymentDetailsDatadogLogIndex `json:"datadogLogIndex"`
+}
+
+// GetId returns LogRuleDeploymentDetails.Id, and is useful for accessing the field via an interface.
+func (v *LogRuleDeploymentDetails) GetId() string { return v.Id }
+
+// GetExternalID returns LogRuleDeploymentDetails.ExternalID, and is useful for accessing the field via an interface.
+func (v *LogRuleDeploymentDetails) GetExternalID() string { return v.ExternalID }
+
+// GetLastError returns LogRuleDeploymentDetails.LastError, and is useful for accessing the field via an interface.
+func (v *LogRuleDeploymentDetails) GetLastError() string { return v.LastError }
+
+// GetUpdatedAt returns LogRuleDeploymentDetails.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *LogRuleDeploymentDetails) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetDatadogLogIndex returns LogRuleDeploymentDetails.DatadogLogIndex, and is useful for accessing the field via an interface.
+func (v *LogRuleDeploymentDetails) GetDatadogLogIndex() LogRuleDeploymentDetailsDatadogLogIndex {
+	return v.DatadogLogIndex
+}
+
+// LogRuleDeploymentDetailsDatadogLogIndex includes the requested fields of the GraphQL type DatadogLogIndex.
+type LogRuleDeploymentDetailsDatadogLogIndex struct {
+	// Unique identifier for this index record
+	Id string `json:"id"`
+	// Index name from Datadog (e.g., 'main', 'security', 'compliance') - this is the stable identifier
+	Name string `json:"name"`
+}
+
+// GetId returns LogRuleDeploymentDetailsDatadogLogIndex.Id, and is useful for accessing the field via an interface.
+func (v *LogRuleDeploymentDetailsDatadogLogIndex) GetId() string { return v.Id }
+
+// GetName returns LogRuleDeploymentDetailsDatadogLogIndex.Name, and is useful for accessing the field via an interface.
+func (v *LogRuleDeploymentDetailsDatadogLogIndex) GetName() string { return v.Name }
+
+// Core log rule fields shared across rule queries
+type LogRuleDetails struct {
+	// Unique identifier for this rule version
+	Id string `json:"id"`
+	// The log event this rule applies to
+	LogEventID string `json:"logEventID"`
+	// The workspace that owns this rule
+	WorkspaceID string `json:"workspaceID"`
+	// Whether to keep or drop matching logs
+	Retention LogRuleRetention `json:"retention"`
+	// Confidence level of the retention decision
+	Confidence LogRuleConfidence `json:"confidence"`
+	// When this rule was dismissed by a user (null if still active)
+	IgnoredAt time.Time `json:"ignoredAt"`
+	// Optional Vector Remap Language script to transform logs before sending
+	VrlScript string `json:"vrlScript"`
+	// Explanation of why this retention decision was made
+	Rationale string `json:"rationale"`
+	// Whether this rule was created by AI or a human user
+	CreatedByType LogRuleCreatedByType `json:"createdByType"`
+	// Identifier of creator: user UUID or AI model version (e.g., 'gpt-4-v2')
+	CreatedByID string `json:"createdByID"`
+	// When this rule version was created
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetId returns LogRuleDetails.Id, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetId() string { return v.Id }
+
+// GetLogEventID returns LogRuleDetails.LogEventID, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetLogEventID() string { return v.LogEventID }
+
+// GetWorkspaceID returns LogRuleDetails.WorkspaceID, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetWorkspaceID() string { return v.WorkspaceID }
+
+// GetRetention returns LogRuleDetails.Retention, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetRetention() LogRuleRetention { return v.Retention }
+
+// GetConfidence returns LogRuleDetails.Confidence, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetConfidence() LogRuleConfidence { return v.Confidence }
+
+// GetIgnoredAt returns LogRuleDetails.IgnoredAt, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetIgnoredAt() time.Time { return v.IgnoredAt }
+
+// GetVrlScript returns LogRuleDetails.VrlScript, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetVrlScript() string { return v.VrlScript }
+
+// GetRationale returns LogRuleDetails.Rationale, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetRationale() string { return v.Rationale }
+
+// GetCreatedByType returns LogRuleDetails.CreatedByType, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetCreatedByType() LogRuleCreatedByType { return v.CreatedByType }
+
+// GetCreatedByID returns LogRuleDetails.CreatedByID, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetCreatedByID() string { return v.CreatedByID }
+
+// GetCreatedAt returns LogRuleDetails.CreatedAt, and is useful for accessing the field via an interface.
+func (v *LogRuleDetails) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// LogRuleRetention is enum for the field retention
+type LogRuleRetention string
+
+const (
+	LogRuleRetentionKeep LogRuleRetention = "keep"
+	LogRuleRetentionDrop LogRuleRetention = "drop"
+)
+
+var AllLogRuleRetention = []LogRuleRetention{
+	LogRuleRetentionKeep,
+	LogRuleRetentionDrop,
+}
+
+// Log volume breakdown shared by every entity that exposes volumeStats
+type LogVolumeStats struct {
+	// Total volume across all categories
+	TotalVolume float64 `json:"totalVolume"`
+	// Volume not yet classified (no rules created)
+	UnknownVolume float64 `json:"unknownVolume"`
+	// Volume of valuable logs (keep rules or ignored drop rules)
+	ValuableVolume float64 `json:"valuableVolume"`
+	// Volume identified as waste (drop rules not yet enforced)
+	WasteVolume float64 `json:"wasteVolume"`
+	// Volume already being filtered (impact realized)
+	SavedVolume float64 `json:"savedVolume"`
+	// Percentage of total that is unknown (0-100)
+	UnknownPercent float64 `json:"unknownPercent"`
+	// Percentage of total that is valuable (0-100)
+	ValuablePercent float64 `json:"valuablePercent"`
+	// Percentage of total that is waste (0-100)
+	WastePercent float64 `json:"wastePercent"`
+	// Percentage of total that is saved (0-100)
+	SavedPercent float64 `json:"savedPercent"`
+	// Start of the metrics period (inclusive)
+	PeriodStart time.Time `json:"periodStart"`
+	// End of the metrics period (exclusive)
+	PeriodEnd time.Time `json:"periodEnd"`
+}
+
+// GetTotalVolume returns LogVolumeStats.TotalVolume, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetTotalVolume() float64 { return v.TotalVolume }
+
+// GetUnknownVolume returns LogVolumeStats.UnknownVolume, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetUnknownVolume() float64 { return v.UnknownVolume }
+
+// GetValuableVolume returns LogVolumeStats.ValuableVolume, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetValuableVolume() float64 { return v.ValuableVolume }
+
+// GetWasteVolume returns LogVolumeStats.WasteVolume, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetWasteVolume() float64 { return v.WasteVolume }
+
+// GetSavedVolume returns LogVolumeStats.SavedVolume, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetSavedVolume() float64 { return v.SavedVolume }
+
+// GetUnknownPercent returns LogVolumeStats.UnknownPercent, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetUnknownPercent() float64 { return v.UnknownPercent }
+
+// GetValuablePercent returns LogVolumeStats.ValuablePercent, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetValuablePercent() float64 { return v.ValuablePercent }
+
+// GetWastePercent returns LogVolumeStats.WastePercent, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetWastePercent() float64 { return v.WastePercent }
+
+// GetSavedPercent returns LogVolumeStats.SavedPercent, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetSavedPercent() float64 { return v.SavedPercent }
+
+// GetPeriodStart returns LogVolumeStats.PeriodStart, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetPeriodStart() time.Time { return v.PeriodStart }
+
+// GetPeriodEnd returns LogVolumeStats.PeriodEnd, and is useful for accessing the field via an interface.
+func (v *LogVolumeStats) GetPeriodEnd() time.Time { return v.PeriodEnd }
+
+// MessageRole is enum for the field role
+type MessageRole string
+
+const (
+	MessageRoleSystem    MessageRole = "system"
+	MessageRoleUser      MessageRole = "user"
+	MessageRoleAssistant MessageRole = "assistant"
+)
+
+var AllMessageRole = []MessageRole{
+	MessageRoleSystem,
+	MessageRoleUser,
+	MessageRoleAssistant,
+}
+
+// Relay cursor state shared by every paginated list query
+type PageInfoFields struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns PageInfoFields.HasNextPage, and is useful for accessing the field via an interface.
+func (v *PageInfoFields) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns PageInfoFields.EndCursor, and is useful for accessing the field via an interface.
+func (v *PageInfoFields) GetEndCursor() string { return v.EndCursor }
+
+// RenameAccountResponse is returned by RenameAccount on success.
+type RenameAccountResponse struct {
+	UpdateAccount RenameAccountUpdateAccount `json:"updateAccount"`
+}
+
+// GetUpdateAccount returns RenameAccountResponse.UpdateAccount, and is useful for accessing the field via an interface.
+func (v *RenameAccountResponse) GetUpdateAccount() RenameAccountUpdateAccount { return v.UpdateAccount }
+
+// RenameAccountUpdateAccount includes the requested fields of the GraphQL type Account.
+type RenameAccountUpdateAccount struct {
+	// Unique identifier of the account
+	Id string `json:"id"`
+	// Human-readable name within the organization
+	Name string `json:"name"`
+	// When the account was created
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetId returns RenameAccountUpdateAccount.Id, and is useful for accessing the field via an interface.
+func (v *RenameAccountUpdateAccount) GetId() string { return v.Id }
+
+// GetName returns RenameAccountUpdateAccount.Name, and is useful for accessing the field via an interface.
+func (v *RenameAccountUpdateAccount) GetName() string { return v.Name }
+
+// GetCreatedAt returns RenameAccountUpdateAccount.CreatedAt, and is useful for accessing the field via an interface.
+func (v *RenameAccountUpdateAccount) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// Time windows for metrics aggregation
+type TimeWindow string
+
+const (
+	// Last 24 hours
+	TimeWindowDay TimeWindow = "DAY"
+	// Last 7 days
+	TimeWindowWeek TimeWindow = "WEEK"
+	// Last 30 days
+	TimeWindowMonth TimeWindow = "MONTH"
+	// Last 90 days
+	TimeWindowQuarter TimeWindow = "QUARTER"
+)
+
+var AllTimeWindow = []TimeWindow{
+	TimeWindowDay,
+	TimeWindowWeek,
+	TimeWindowMonth,
+	TimeWindowQuarter,
+}
+
+type ValidateDatadogApiKeyInput struct {
+	ApiKey string             `json:"apiKey"`
+	Site   DatadogAccountSite `json:"site"`
+}
+
+// GetApiKey returns ValidateDatadogApiKeyInput.ApiKey, and is useful for accessing the field via an interface.
+func (v *ValidateDatadogApiKeyInput) GetApiKey() string { return v.ApiKey }
+
+// GetSite returns ValidateDatadogApiKeyInput.Site, and is useful for accessing the field via an interface.
+func (v *ValidateDatadogApiKeyInput) GetSite() DatadogAccountSite { return v.Site }
+
+// ValidateDatadogApiKeyResponse is returned by ValidateDatadogApiKey on success.
+type ValidateDatadogApiKeyResponse struct {
+	ValidateDatadogApiKey ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult `json:"validateDatadogApiKey"`
+}
+
+// GetValidateDatadogApiKey returns ValidateDatadogApiKeyResponse.ValidateDatadogApiKey, and is useful for accessing the field via an interface.
+func (v *ValidateDatadogApiKeyResponse) GetValidateDatadogApiKey() ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult {
+	return v.ValidateDatadogApiKey
+}
+
+// ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult includes the requested fields of the GraphQL type ValidateDatadogApiKeyResult.
+type ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult struct {
+	Valid bool   `json:"valid"`
+	Error string `json:"error"`
+}
+
+// GetValid returns ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult.Valid, and is useful for accessing the field via an interface.
+func (v *ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult) GetValid() bool {
+	return v.Valid
+}
+
+// GetError returns ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult.Error, and is useful for accessing the field via an interface.
+func (v *ValidateDatadogApiKeyValidateDatadogApiKeyValidateDatadogApiKeyResult) GetError() string {
+	return v.Error
+}
+
+// WorkspacePurpose is enum for the field purpose
+type WorkspacePurpose string
+
+const (
+	WorkspacePurposeObservability WorkspacePurpose = "observability"
+	WorkspacePurposeSecurity      WorkspacePurpose = "security"
+	WorkspacePurposeCompliance    WorkspacePurpose = "compliance"
+)
+
+var AllWorkspacePurpose = []WorkspacePurpose{
+	WorkspacePurposeObservability,
+	WorkspacePurposeSecurity,
+	WorkspacePurposeCompliance,
+}
+
+// __CreateAccountInput is used internally by genqlient
+type __CreateAccountInput struct {
+	Input CreateAccountInput `json:"input"`
+}
+
+// GetInput returns __CreateAccountInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateAccountInput) GetInput() CreateAccountInput { return v.Input }
+
+// __CreateDatadogAccountWithCredentialsInput is used internally by genqlient
+type __CreateDatadogAccountWithCredentialsInput struct {
+	Input CreateDatadogAccountWithCredentialsInput `json:"input"`
+}
+
+// GetInput returns __CreateDatadogAccountWithCredentialsInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateDatadogAccountWithCredentialsInput) GetInput() CreateDatadogAccountWithCredentialsInput {
+	return v.Input
+}
+
+// __CreateOrganizationAndBootstrapInput is used internally by genqlient
+type __CreateOrganizationAndBootstrapInput struct {
+	Input CreateOrganizationInput `json:"input"`
+}
+
+// GetInput returns __CreateOrganizationAndBootstrapInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateOrganizationAndBootstrapInput) GetInput() CreateOrganizationInput { return v.Input }
+
+// __DisableServiceInput is used internally by genqlient
+type __DisableServiceInput struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// GetServiceId returns __DisableServiceInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__DisableServiceInput) GetServiceId() string { return v.ServiceId }
+
+// __EnableServiceInput is used internally by genqlient
+type __EnableServiceInput struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// GetServiceId returns __EnableServiceInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__EnableServiceInput) GetServiceId() string { return v.ServiceId }
+
+// __GetAccountInput is used internally by genqlient
+type __GetAccountInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetAccountInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetAccountInput) GetId() string { return v.Id }
+
+// __GetAccountSummaryInput is used internally by genqlient
+type __GetAccountSummaryInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetAccountSummaryInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetAccountSummaryInput) GetId() string { return v.Id }
+
+// __GetAccountVolumeStatsInput is used internally by genqlient
+type __GetAccountVolumeStatsInput struct {
+	Id       string     `json:"id"`
+	Lookback TimeWindow `json:"lookback"`
+}
+
+// GetId returns __GetAccountVolumeStatsInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetAccountVolumeStatsInput) GetId() string { return v.Id }
+
+// GetLookback returns __GetAccountVolumeStatsInput.Lookback, and is useful for accessing the field via an interface.
+func (v *__GetAccountVolumeStatsInput) GetLookback() TimeWindow { return v.Lookback }
+
+// __GetDatadogAccountLogDiscoveryProgressInput is used internally by genqlient
+type __GetDatadogAccountLogDiscoveryProgressInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetDatadogAccountLogDiscoveryProgressInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetDatadogAccountLogDiscoveryProgressInput) GetId() string { return v.Id }
+
+// __GetDatadogAccountServiceDiscoveryProgressInput is used internally by genqlient
+type __GetDatadogAccountServiceDiscoveryProgressInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetDatadogAccountServiceDiscoveryProgressInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetDatadogAccountServiceDiscoveryProgressInput) GetId() string { return v.Id }
+
+// __GetLatestChatInput is used internally by genqlient
+type __GetLatestChatInput struct {
+	WorkspaceID string `json:"workspaceID"`
+}
+
+// GetWorkspaceID returns __GetLatestChatInput.WorkspaceID, and is useful for accessing the field via an interface.
+func (v *__GetLatestChatInput) GetWorkspaceID() string { return v.WorkspaceID }
+
+// __GetLogEventDetailInput is used internally by genqlient
+type __GetLogEventDetailInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetLogEventDetailInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetLogEventDetailInput) GetId() string { return v.Id }
+
+// __GetServiceByNameInput is used internally by genqlient
+type __GetServiceByNameInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __GetServiceByNameInput.Name, and is useful for accessing the field via an interface.
+func (v *__GetServiceByNameInput) GetName() string { return v.Name }
+
+// __GetServiceInput is used internally by genqlient
+type __GetServiceInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetServiceInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetServiceInput) GetId() string { return v.Id }
+
+// __GetServiceVolumeStatsInput is used internally by genqlient
+type __GetServiceVolumeStatsInput struct {
+	Id       string     `json:"id"`
+	Lookback TimeWindow `json:"lookback"`
+}
+
+// GetId returns __GetServiceVolumeStatsInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetServiceVolumeStatsInput) GetId() string { return v.Id }
+
+// GetLookback returns __GetServiceVolumeStatsInput.Lookback, and is useful for accessing the field via an interface.
+func (v *__GetServiceVolumeStatsInput) GetLookback() TimeWindow { return v.Lookback }
+
+// __ListAccountsInput is used internally by genqlient
+type __ListAccountsInput struct {
+	OrganizationID string  `json:"organizationID"`
+	First          int     `json:"first"`
+	After          *string `json:"after"`
+}
+
+// GetOrganizationID returns __ListAccountsInput.OrganizationID, and is useful for accessing the field via an interface.
+func (v *__ListAccountsInput) GetOrganizationID() string { return v.OrganizationID }
+
+// GetFirst returns __ListAccountsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListAccountsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListAccountsInput.After, and is useful for accessing the field via an interface.
+func (v *__ListAccountsInput) GetAfter() *string { return v.After }
+
+// __ListLogEventVolumesInput is used internally by genqlient
+type __ListLogEventVolumesInput struct {
+	LogEventID string    `json:"logEventID"`
+	Since      time.Time `json:"since"`
+	First      int       `json:"first"`
+	After      *string   `json:"after"`
+}
+
+// GetLogEventID returns __ListLogEventVolumesInput.LogEventID, and is useful for accessing the field via an interface.
+func (v *__ListLogEventVolumesInput) GetLogEventID() string { return v.LogEventID }
+
+// GetSince returns __ListLogEventVolumesInput.Since, and is useful for accessing the field via an interface.
+func (v *__ListLogEventVolumesInput) GetSince() time.Time { return v.Since }
+
+// GetFirst returns __ListLogEventVolumesInput.First, and is useful for accessing the field via an interface.
+func (v *__ListLogEventVolumesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListLogEventVolumesInput.After, and is useful for accessing the field via an interface.
+func (v *__ListLogEventVolumesInput) GetAfter() *string { return v.After }
+
+// __ListLogEventsForServiceInput is used internally by genqlient
+type __ListLogEventsForServiceInput struct {
+	ServiceID string     `json:"serviceID"`
+	Lookback  TimeWindow `json:"lookback"`
+	First     int        `json:"first"`
+	After     *string    `json:"after"`
+}
+
+// GetServiceID returns __ListLogEventsForServiceInput.ServiceID, and is useful for accessing the field via an interface.
+func (v *__ListLogEventsForServiceInput) GetServiceID() string { return v.ServiceID }
+
+// GetLookback returns __ListLogEventsForServiceInput.Lookback, and is useful for accessing the field via an interface.
+func (v *__ListLogEventsForServiceInput) GetLookback() TimeWindow { return v.Lookback }
+
+// GetFirst returns __ListLogEventsForServiceInput.First, and is useful for accessing the field via an interface.
+func (v *__ListLogEventsForServiceInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListLogEventsForServiceInput.After, and is useful for accessing the field via an interface.
+func (v *__ListLogEventsForServiceInput) GetAfter() *string { return v.After }
+
+// __ListLogRulesForServiceInput is used internally by genqlient
+type __ListLogRulesForServiceInput struct {
+	ServiceID string  `json:"serviceID"`
+	First     int     `json:"first"`
+	After     *string `json:"after"`
+}
+
+// GetServiceID returns __ListLogRulesForServiceInput.ServiceID, and is useful for accessing the field via an interface.
+func (v *__ListLogRulesForServiceInput) GetServiceID() string { return v.ServiceID }
+
+// GetFirst returns __ListLogRulesForServiceInput.First, and is useful for accessing the field via an interface.
+func (v *__ListLogRulesForServiceInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListLogRulesForServiceInput.After, and is useful for accessing the field via an interface.
+func (v *__ListLogRulesForServiceInput) GetAfter() *string { return v.After }
+
+// __ListLogRulesForWorkspaceInput is used internally by genqlient
+type __ListLogRulesForWorkspaceInput struct {
+	WorkspaceID string  `json:"workspaceID"`
+	First       int     `json:"first"`
+	After       *string `json:"after"`
+}
+
+// GetWorkspaceID returns __ListLogRulesForWorkspaceInput.WorkspaceID, and is useful for accessing the field via an interface.
+func (v *__ListLogRulesForWorkspaceInput) GetWorkspaceID() string { return v.WorkspaceID }
+
+// GetFirst returns __ListLogRulesForWorkspaceInput.First, and is useful for accessing the field via an interface.
+func (v *__ListLogRulesForWorkspaceInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListLogRulesForWorkspaceInput.After, and is useful for accessing the field via an interface.
+func (v *__ListLogRulesForWorkspaceInput) GetAfter() *string { return v.After }
+
+// __ListOrganizationsInput is used internally by genqlient
+type __ListOrganizationsInput struct {
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetFirst returns __ListOrganizationsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListOrganizationsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListOrganizationsInput.After, and is useful for accessing the field via an interface.
+func (v *__ListOrganizationsInput) GetAfter() *string { return v.After }
+
+// __ListServiceVolumeStatsInput is used internally by genqlient
+type __ListServiceVolumeStatsInput struct {
+	AccountID string     `json:"accountID"`
+	Lookback  TimeWindow `json:"lookback"`
+	First     int        `json:"first"`
+	After     *string    `json:"after"`
+}
+
+// GetAccountID returns __ListServiceVolumeStatsInput.AccountID, and is useful for accessing the field via an interface.
+func (v *__ListServiceVolumeStatsInput) GetAccountID() string { return v.AccountID }
+
+// GetLookback returns __ListServiceVolumeStatsInput.Lookback, and is useful for accessing the field via an interface.
+func (v *__ListServiceVolumeStatsInput) GetLookback() TimeWindow { return v.Lookback }
+
+// GetFirst returns __ListServiceVolumeStatsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListServiceVolumeStatsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListServiceVolumeStatsInput.After, and is useful for accessing the field via an interface.
+func (v *__ListServiceVolumeStatsInput) GetAfter() *string { return v.After }
+
+// __ListServicesInput is used internally by genqlient
+type __ListServicesInput struct {
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetFirst returns __ListServicesInput.First, and is useful for accessing the field via an interface.
+func (v *__ListServicesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListServicesInput.After, and is useful for accessing the field via an interface.
+func (v *__ListServicesInput) GetAfter() *string { return v.After }
+
+// __ListWorkspacesInput is used internally by genqlient
+type __ListWorkspacesInput struct {
+	AccountID string  `json:"accountID"`
+	First     int     `json:"first"`
+	After     *string `json:"after"`
+}
+
+// GetAccountID returns __ListWorkspacesInput.AccountID, and is useful for accessing the field via an interface.
+func (v *__ListWorkspacesInput) GetAccountID() string { return v.AccountID }
+
+// GetFirst returns __ListWorkspacesInput.First, and is useful for accessing the field via an interface.
+func (v *__ListWorkspacesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListWorkspacesInput.After, and is useful for accessing the field via an interface.
+func (v *__ListWorkspacesInput) GetAfter() *string { return v.After }
+
+// __RenameAccountInput is used internally by genqlient
+type __RenameAccountInput struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetId returns __RenameAccountInput.Id, and is useful for accessing the field via an interface.
+func (v *__RenameAccountInput) GetId() string { return v.Id }
+
+// GetName returns __RenameAccountInput.Name, and is useful for accessing the field via an interface.
+func (v *__RenameAccountInput) GetName() string { return v.Name }
+
+// __ValidateDatadogApiKeyInput is used internally by genqlient
+type __ValidateDatadogApiKeyInput struct {
+	Input ValidateDatadogApiKeyInput `json:"input"`
+}
+
+// GetInput returns __ValidateDatadogApiKeyInput.Input, and is useful for accessing the field via an interface.
+func (v *__ValidateDatadogApiKeyInput) GetInput() ValidateDatadogApiKeyInput { return v.Input }
+
+// The mutation executed by CreateAccount.
+const CreateAccount_Operation = `
+mutation CreateAccount ($input: CreateAccountInput!) {
+	createAccount(input: $input) {
+		id
+		name
+		createdAt
+	}
+}
+`
+
+func CreateAccount(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateAccountInput,
+) (data_ *CreateAccountResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateAccount",
+		Query:  CreateAccount_Operation,
+		Variables: &__CreateAccountInput{
+			Input: input,
+		},
+	}
+
+	data_ = &CreateAccountResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateDatadogAccountWithCredentials.
+const CreateDatadogAccountWithCredentials_Operation = `
+mutation CreateDatadogAccountWithCredentials ($input: CreateDatadogAccountWithCredentialsInput!) {
+	createDatadogAccount(input: $input) {
+		id
+		name
+		site
+		createdAt
+		updatedAt
+	}
+}
+`
+
+func CreateDatadogAccountWithCredentials(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateDatadogAccountWithCredentialsInput,
+) (data_ *CreateDatadogAccountWithCredentialsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateDatadogAccountWithCredentials",
+		Query:  CreateDatadogAccountWithCredentials_Operation,
+		Variables: &__CreateDatadogAccountWithCredentialsInput{
+			Input: input,
+		},
+	}
+
+	data_ = &CreateDatadogAccountWithCredentialsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateOrganizationAndBootstrap.
+const CreateOrganizationAndBootstrap_Operation = `
+mutation CreateOrganizationAndBootstrap ($input: CreateOrganizationInput!) {
+	createOrganizationAndBootstrap(input: $input) {
+		organization {
+			id
+			name
+			createdAt
+		}
+		account {
+			id
+			name
+		}
+		workspace {
+			id
+			name
+		}
+	}
+}
+`
+
+func CreateOrganizationAndBootstrap(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateOrganizationInput,
+) (data_ *CreateOrganizationAndBootstrapResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateOrganizationAndBootstrap",
+		Query:  CreateOrganizationAndBootstrap_Operation,
+		Variables: &__CreateOrganizationAndBootstrapInput{
+			Input: input,
+		},
+	}
+
+	data_ = &CreateOrganizationAndBootstrapResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by DisableService.
+const DisableService_Operation = `
+mutation DisableService ($serviceId: ID!) {
+	updateService(id: $serviceId, input: {enabled:false}) {
+		id
+		name
+		enabled
+	}
+}
+`
+
+// Mutation to disable analysis for a service
+func DisableService(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	serviceId string,
+) (data_ *DisableServiceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "DisableService",
+		Query:  DisableService_Operation,
+		Variables: &__DisableServiceInput{
+			ServiceId: serviceId,
+		},
+	}
+
+	data_ = &DisableServiceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by EnableService.
+const EnableService_Operation = `
+mutation EnableService ($serviceId: ID!) {
+	updateService(id: $serviceId, input: {enabled:true}) {
+		id
+		name
+		enabled
+	}
+}
+`
+
+// Mutation to enable a service for analysis
+func EnableService(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	serviceId string,
+) (data_ *EnableServiceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "EnableService",
+		Query:  EnableService_Operation,
+		Variables: &__EnableServiceInput{
+			ServiceId: serviceId,
+		},
+	}
+
+	data_ = &EnableServiceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetAccount.
+const GetAccount_Operation = `
+query GetAccount ($id: ID!) {
+	accounts(where: {id:$id}, first: 1) {
+		edges {
+			node {
+				id
+				datadogAccount {
+					id
+					name
+					site
+				}
+			}
+		}
+	}
+}
+`
+
+func GetAccount(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *GetAccountResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetAccount",
+		Query:  GetAccount_Operation,
+		Variables: &__GetAccountInput{
+			Id: id,
+		},
+	}
+
+	data_ = &GetAccountResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetAccountSummary.
+const GetAccountSummary_Operation = `
+query GetAccountSummary ($id: ID!) {
+	accounts(where: {id:$id}, first: 1) {
+		edges {
+			node {
+				id
+				name
+				organization {
+					id
+					name
+				}
+				services {
+					id
+				}
+				day: volumeStats(lookback: DAY) {
+					... LogVolumeStats
+				}
+				week: volumeStats(lookback: WEEK) {
+					... LogVolumeStats
+				}
+			}
+		}
+	}
+}
+fragment LogVolumeStats on LogVolumeAggregate {
+	totalVolume
+	unknownVolume
+	valuableVolume
+	wasteVolume
+	savedVolume
+	unknownPercent
+	valuablePercent
+	wastePercent
+	savedPercent
+	periodStart
+	periodEnd
+}
+`
+
+// Query to get the at-a-glance numbers shown in the app sidebar.
+// The day and week windows are compared to show whether waste is trending up.
+func GetAccountSummary(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *GetAccountSummaryResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetAccountSummary",
+		Query:  GetAccountSummary_Operation,
+		Variables: &__GetAccountSummaryInput{
+			Id: id,
+		},
+	}
+
+	data_ = &GetAccountSummaryResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetAccountVolumeStats.
+const GetAccountVolumeStats_Operation = `
+query GetAccountVolumeStats ($id: ID!, $lookback: TimeWindow!) {
+	accounts(where: {id:$id}, first: 1) {
+		edges {
+			node {
+				id
+				name
+				volumeStats(lookback: $lookback) {
+					... LogVolumeStats
+				}
+				services {
+					id
+					name
+					enabled
+					volumeStats(lookback: $lookback) {
+						... LogVolumeStats
+					}
+				}
+			}
+		}
+	}
+}
+fragment LogVolumeStats on LogVolumeAggregate {
+	totalVolume
+	unknownVolume
+	valuableVolume
+	wasteVolume
+	savedVolume
+	unknownPercent
+	valuablePercent
+	wastePercent
+	savedPercent
+	periodStart
+	periodEnd
+}
+`
+
+// Query to get volume stats for an account and each of its services
+func GetAccountVolumeStats(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	lookback TimeWindow,
+) (data_ *GetAccountVolumeStatsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetAccountVolumeStats",
+		Query:  GetAccountVolumeStats_Operation,
+		Variables: &__GetAccountVolumeStatsInput{
+			Id:       id,
+			Lookback: lookback,
+		},
+	}
+
+	data_ = &GetAccountVolumeStatsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetDatadogAccountLogDiscoveryProgress.
+const GetDatadogAccountLogDiscoveryProgress_Operation = `
+query GetDatadogAccountLogDiscoveryProgress ($id: ID!) {
+	datadogAccounts(where: {id:$id}, first: 1) {
+		edges {
+			node {
+				id
+				logEventDiscoveryProgress {
+					status
+					percentComplete
+					weeklyVolume
+					weeklyDiscoveredVolume
+					lastError
+					startedAt
+					completedAt
+					consecutiveFailures
+				}
+			}
+		}
+	}
+}
+`
+
+func GetDatadogAccountLogDiscoveryProgress(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *GetDatadogAccountLogDiscoveryProgressResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetDatadogAccountLogDiscoveryProgress",
+		Query:  GetDatadogAccountLogDiscoveryProgress_Operation,
+		Variables: &__GetDatadogAccountLogDiscoveryProgressInput{
+			Id: id,
+		},
+	}
+
+	data_ = &GetDatadogAccountLogDiscoveryProgressResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetDatadogAccountServiceDiscoveryProgress.
+const GetDatadogAccountServiceDiscoveryProgress_Operation = `
+query GetDatadogAccountServiceDiscoveryProgress ($id: ID!) {
+	datadogAccounts(where: {id:$id}, first: 1) {
+		edges {
+			node {
+				id
+				serviceDiscoveryProgress {
+					status
+					servicesDiscovered
+					lastError
+					startedAt
+					completedAt
+					consecutiveFailures
+				}
+			}
+		}
+	}
 }
+`
 
-// GetInput returns __CreateDatadogAccountWithCredentialsInput.Input, and is useful for accessing the field via an interface.
-func (v *__CreateDatadogAccountWithCredentialsInput) GetInput() CreateDatadogAccountWithCredentialsInput {
-	return v.Input
-}
+func GetDatadogAccountServiceDiscoveryProgress(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *GetDatadogAccountServiceDiscoveryProgressResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetDatadogAccountServiceDiscoveryProgress",
+		Query:  GetDatadogAccountServiceDiscoveryProgress_Operation,
+		Variables: &__GetDatadogAccountServiceDiscoveryProgressInput{
+			Id: id,
+		},
+	}
 
-// __CreateOrganizationAndBootstrapInput is used internally by genqlient
-type __CreateOrganizationAndBootstrapInput struct {
-	Input CreateOrganizationInput `json:"input"`
-}
+	data_ = &GetDatadogAccountServiceDiscoveryProgressResponse{}
+	resp_ := &graphql.Response{Data: data_}
 
-// GetInput returns __CreateOrganizationAndBootstrapInput.Input, and is useful for accessing the field via an interface.
-func (v *__CreateOrganizationAndBootstrapInput) GetInput() CreateOrganizationInput { return v.Input }
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// __EnableServiceInput is used internally by genqlient
-type __EnableServiceInput struct {
-	ServiceId string `json:"serviceId"`
+	return data_, err_
 }
 
-// GetServiceId returns __EnableServiceInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *__EnableServiceInput) GetServiceId() string { return v.ServiceId }
-
-// __GetAccountInput is used internally by genqlient
-type __GetAccountInput struct {
-	Id string `json:"id"`
+// The query executed by GetLatestChat.
+const GetLatestChat_Operation = `
+query GetLatestChat ($workspaceID: ID!) {
+	chats(where: {workspaceID:$workspaceID}, orderBy: {field:UPDATED_AT,direction:DESC}, first: 1) {
+		edges {
+			node {
+				id
+				title
+				createdAt
+				updatedAt
+				messages {
+					id
+					role
+					stopReason
+					createdAt
+				}
+			}
+		}
+	}
 }
+`
 
-// GetId returns __GetAccountInput.Id, and is useful for accessing the field via an interface.
-func (v *__GetAccountInput) GetId() string { return v.Id }
+// Query to get the most recently active chat in a workspace
+func GetLatestChat(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	workspaceID string,
+) (data_ *GetLatestChatResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetLatestChat",
+		Query:  GetLatestChat_Operation,
+		Variables: &__GetLatestChatInput{
+			WorkspaceID: workspaceID,
+		},
+	}
 
-// __GetDatadogAccountLogDiscoveryProgressInput is used internally by genqlient
-type __GetDatadogAccountLogDiscoveryProgressInput struct {
-	Id string `json:"id"`
-}
+	data_ = &GetLatestChatResponse{}
+	resp_ := &graphql.Response{Data: data_}
 
-// GetId returns __GetDatadogAccountLogDiscoveryProgressInput.Id, and is useful for accessing the field via an interface.
-func (v *__GetDatadogAccountLogDiscoveryProgressInput) GetId() string { return v.Id }
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// __GetDatadogAccountServiceDiscoveryProgressInput is used internally by genqlient
-type __GetDatadogAccountServiceDiscoveryProgressInput struct {
-	Id string `json:"id"`
+	return data_, err_
 }
 
-// GetId returns __GetDatadogAccountServiceDiscoveryProgressInput.Id, and is useful for accessing the field via an interface.
-func (v *__GetDatadogAccountServiceDiscoveryProgressInput) GetId() string { return v.Id }
-
-// __GetServiceByNameInput is used internally by genqlient
-type __GetServiceByNameInput struct {
-	Name string `json:"name"`
+// The query executed by GetLogEventDetail.
+const GetLogEventDetail_Operation = `
+query GetLogEventDetail ($id: ID!) {
+	logEvents(where: {id:$id}, first: 1) {
+		edges {
+			node {
+				id
+				name
+				description
+				service {
+					id
+					name
+				}
+				volumeStats(lookback: WEEK) {
+					... LogVolumeStats
+				}
+				logRules {
+					... LogRuleDetails
+					workspace {
+						id
+						name
+					}
+					deployments {
+						... LogRuleDeploymentDetails
+					}
+				}
+			}
+		}
+	}
 }
-
-// GetName returns __GetServiceByNameInput.Name, and is useful for accessing the field via an interface.
-func (v *__GetServiceByNameInput) GetName() string { return v.Name }
-
-// __GetServiceInput is used internally by genqlient
-type __GetServiceInput struct {
-	Id string `json:"id"`
+fragment LogVolumeStats on LogVolumeAggregate {
+	totalVolume
+	unknownVolume
+	valuableVolume
+	wasteVolume
+	savedVolume
+	unknownPercent
+	valuablePercent
+	wastePercent
+	savedPercent
+	periodStart
+	periodEnd
+}
+fragment LogRuleDetails on LogRule {
+	id
+	logEventID
+	workspaceID
+	retention
+	confidence
+	ignoredAt
+	vrlScript
+	rationale
+	createdByType
+	createdByID
+	createdAt
+}
+fragment LogRuleDeploymentDetails on LogRuleDeployment {
+	id
+	externalID
+	lastError
+	updatedAt
+	datadogLogIndex {
+		id
+		name
+	}
 }
+`
 
-// GetId returns __GetServiceInput.Id, and is useful for accessing the field via an interface.
-func (v *__GetServiceInput) GetId() string { return v.Id }
+// Query to get a log event with its week's volume stats and every workspace's
+// rule for it, including where each rule is deployed
+func GetLogEventDetail(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *GetLogEventDetailResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetLogEventDetail",
+		Query:  GetLogEventDetail_Operation,
+		Variables: &__GetLogEventDetailInput{
+			Id: id,
+		},
+	}
 
-// __ListAccountsInput is used internally by genqlient
-type __ListAccountsInput struct {
-	OrganizationID string `json:"organizationID"`
-}
+	data_ = &GetLogEventDetailResponse{}
+	resp_ := &graphql.Response{Data: data_}
 
-// GetOrganizationID returns __ListAccountsInput.OrganizationID, and is useful for accessing the field via an interface.
-func (v *__ListAccountsInput) GetOrganizationID() string { return v.OrganizationID }
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// __ValidateDatadogApiKeyInput is used internally by genqlient
-type __ValidateDatadogApiKeyInput struct {
-	Input ValidateDatadogApiKeyInput `json:"input"`
+	return data_, err_
 }
 
-// GetInput returns __ValidateDatadogApiKeyInput.Input, and is useful for accessing the field via an interface.
-func (v *__ValidateDatadogApiKeyInput) GetInput() ValidateDatadogApiKeyInput { return v.Input }
-
-// The mutation executed by CreateAccount.
-const CreateAccount_Operation = `
-mutation CreateAccount ($input: CreateAccountInput!) {
-	createAccount(input: $input) {
-		id
-		name
-		createdAt
+// The query executed by GetService.
+const GetService_Operation = `
+query GetService ($id: ID!) {
+	node(id: $id) {
+		__typename
+		... on Service {
+			id
+			name
+			description
+			enabled
+			createdAt
+			updatedAt
+			account {
+				id
+				name
+			}
+			logEvents {
+				id
+				name
+				description
+				createdAt
+			}
+		}
 	}
 }
 `
 
-func CreateAccount(
+// Query to get detailed information about a specific service by ID
+func GetService(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input CreateAccountInput,
-) (data_ *CreateAccountResponse, err_ error) {
+	id string,
+) (data_ *GetServiceResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "CreateAccount",
-		Query:  CreateAccount_Operation,
-		Variables: &__CreateAccountInput{
-			Input: input,
+		OpName: "GetService",
+		Query:  GetService_Operation,
+		Variables: &__GetServiceInput{
+			Id: id,
 		},
 	}
 
-	data_ = &CreateAccountResponse{}
+	data_ = &GetServiceResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1597,33 +6082,39 @@ func CreateAccount(
 	return data_, err_
 }
 
-// The mutation executed by CreateDatadogAccountWithCredentials.
-const CreateDatadogAccountWithCredentials_Operation = `
-mutation CreateDatadogAccountWithCredentials ($input: CreateDatadogAccountWithCredentialsInput!) {
-	createDatadogAccount(input: $input) {
-		id
-		name
-		site
-		createdAt
-		updatedAt
+// The query executed by GetServiceByName.
+const GetServiceByName_Operation = `
+query GetServiceByName ($name: String!) {
+	services(where: {name:$name}, first: 1) {
+		edges {
+			node {
+				id
+				name
+				description
+				enabled
+				createdAt
+				updatedAt
+			}
+		}
 	}
 }
 `
 
-func CreateDatadogAccountWithCredentials(
+// Query to get service by name
+func GetServiceByName(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input CreateDatadogAccountWithCredentialsInput,
-) (data_ *CreateDatadogAccountWithCredentialsResponse, err_ error) {
+	name string,
+) (data_ *GetServiceByNameResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "CreateDatadogAccountWithCredentials",
-		Query:  CreateDatadogAccountWithCredentials_Operation,
-		Variables: &__CreateDatadogAccountWithCredentialsInput{
-			Input: input,
+		OpName: "GetServiceByName",
+		Query:  GetServiceByName_Operation,
+		Variables: &__GetServiceByNameInput{
+			Name: name,
 		},
 	}
 
-	data_ = &CreateDatadogAccountWithCredentialsResponse{}
+	data_ = &GetServiceByNameResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1635,41 +6126,106 @@ func CreateDatadogAccountWithCredentials(
 	return data_, err_
 }
 
-// The mutation executed by CreateOrganizationAndBootstrap.
-const CreateOrganizationAndBootstrap_Operation = `
-mutation CreateOrganizationAndBootstrap ($input: CreateOrganizationInput!) {
-	createOrganizationAndBootstrap(input: $input) {
-		organization {
-			id
-			name
-			createdAt
+// The query executed by GetServiceVolumeStats.
+const GetServiceVolumeStats_Operation = `
+query GetServiceVolumeStats ($id: ID!, $lookback: TimeWindow!) {
+	services(where: {id:$id}, first: 1) {
+		edges {
+			node {
+				id
+				name
+				enabled
+				volumeStats(lookback: $lookback) {
+					... LogVolumeStats
+				}
+			}
 		}
-		account {
-			id
-			name
+	}
+}
+fragment LogVolumeStats on LogVolumeAggregate {
+	totalVolume
+	unknownVolume
+	valuableVolume
+	wasteVolume
+	savedVolume
+	unknownPercent
+	valuablePercent
+	wastePercent
+	savedPercent
+	periodStart
+	periodEnd
+}
+`
+
+// Query to get volume stats for a single service
+func GetServiceVolumeStats(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	lookback TimeWindow,
+) (data_ *GetServiceVolumeStatsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetServiceVolumeStats",
+		Query:  GetServiceVolumeStats_Operation,
+		Variables: &__GetServiceVolumeStatsInput{
+			Id:       id,
+			Lookback: lookback,
+		},
+	}
+
+	data_ = &GetServiceVolumeStatsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListAccounts.
+const ListAccounts_Operation = `
+query ListAccounts ($organizationID: ID!, $first: Int!, $after: Cursor) {
+	accounts(where: {organizationID:$organizationID}, first: $first, after: $after) {
+		edges {
+			node {
+				id
+				name
+				createdAt
+			}
 		}
-		workspace {
-			id
-			name
+		pageInfo {
+			... PageInfoFields
 		}
+		totalCount
 	}
 }
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
 `
 
-func CreateOrganizationAndBootstrap(
+func ListAccounts(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input CreateOrganizationInput,
-) (data_ *CreateOrganizationAndBootstrapResponse, err_ error) {
+	organizationID string,
+	first int,
+	after *string,
+) (data_ *ListAccountsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "CreateOrganizationAndBootstrap",
-		Query:  CreateOrganizationAndBootstrap_Operation,
-		Variables: &__CreateOrganizationAndBootstrapInput{
-			Input: input,
+		OpName: "ListAccounts",
+		Query:  ListAccounts_Operation,
+		Variables: &__ListAccountsInput{
+			OrganizationID: organizationID,
+			First:          first,
+			After:          after,
 		},
 	}
 
-	data_ = &CreateOrganizationAndBootstrapResponse{}
+	data_ = &ListAccountsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1681,32 +6237,48 @@ func CreateOrganizationAndBootstrap(
 	return data_, err_
 }
 
-// The mutation executed by EnableService.
-const EnableService_Operation = `
-mutation EnableService ($serviceId: ID!) {
-	updateService(id: $serviceId, input: {enabled:true}) {
-		id
-		name
-		enabled
+// The query executed by ListLogEventVolumes.
+const ListLogEventVolumes_Operation = `
+query ListLogEventVolumes ($logEventID: ID!, $since: Time!, $first: Int!, $after: Cursor) {
+	logEventVolumes(where: {logEventID:$logEventID,timestampGTE:$since}, first: $first, after: $after) {
+		edges {
+			node {
+				timestamp
+				count
+			}
+		}
+		pageInfo {
+			... PageInfoFields
+		}
 	}
 }
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
 `
 
-// Mutation to enable a service for analysis
-func EnableService(
+// Query to list a log event's volume samples since a point in time
+func ListLogEventVolumes(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	serviceId string,
-) (data_ *EnableServiceResponse, err_ error) {
+	logEventID string,
+	since time.Time,
+	first int,
+	after *string,
+) (data_ *ListLogEventVolumesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "EnableService",
-		Query:  EnableService_Operation,
-		Variables: &__EnableServiceInput{
-			ServiceId: serviceId,
+		OpName: "ListLogEventVolumes",
+		Query:  ListLogEventVolumes_Operation,
+		Variables: &__ListLogEventVolumesInput{
+			LogEventID: logEventID,
+			Since:      since,
+			First:      first,
+			After:      after,
 		},
 	}
 
-	data_ = &EnableServiceResponse{}
+	data_ = &ListLogEventVolumesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1718,38 +6290,66 @@ func EnableService(
 	return data_, err_
 }
 
-// The query executed by GetAccount.
-const GetAccount_Operation = `
-query GetAccount ($id: ID!) {
-	accounts(where: {id:$id}, first: 1) {
+// The query executed by ListLogEventsForService.
+const ListLogEventsForService_Operation = `
+query ListLogEventsForService ($serviceID: ID!, $lookback: TimeWindow!, $first: Int!, $after: Cursor) {
+	logEvents(where: {serviceID:$serviceID}, first: $first, after: $after) {
 		edges {
 			node {
 				id
-				datadogAccount {
-					id
-					name
-					site
+				name
+				description
+				volumeStats(lookback: $lookback) {
+					... LogVolumeStats
 				}
 			}
 		}
+		pageInfo {
+			... PageInfoFields
+		}
+		totalCount
 	}
 }
+fragment LogVolumeStats on LogVolumeAggregate {
+	totalVolume
+	unknownVolume
+	valuableVolume
+	wasteVolume
+	savedVolume
+	unknownPercent
+	valuablePercent
+	wastePercent
+	savedPercent
+	periodStart
+	periodEnd
+}
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
 `
 
-func GetAccount(
+// Query to list the log events of a service with their volume stats
+func ListLogEventsForService(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	id string,
-) (data_ *GetAccountResponse, err_ error) {
+	serviceID string,
+	lookback TimeWindow,
+	first int,
+	after *string,
+) (data_ *ListLogEventsForServiceResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "GetAccount",
-		Query:  GetAccount_Operation,
-		Variables: &__GetAccountInput{
-			Id: id,
+		OpName: "ListLogEventsForService",
+		Query:  ListLogEventsForService_Operation,
+		Variables: &__ListLogEventsForServiceInput{
+			ServiceID: serviceID,
+			Lookback:  lookback,
+			First:     first,
+			After:     after,
 		},
 	}
 
-	data_ = &GetAccountResponse{}
+	data_ = &ListLogEventsForServiceResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1761,43 +6361,63 @@ func GetAccount(
 	return data_, err_
 }
 
-// The query executed by GetDatadogAccountLogDiscoveryProgress.
-const GetDatadogAccountLogDiscoveryProgress_Operation = `
-query GetDatadogAccountLogDiscoveryProgress ($id: ID!) {
-	datadogAccounts(where: {id:$id}, first: 1) {
+// The query executed by ListLogRulesForService.
+const ListLogRulesForService_Operation = `
+query ListLogRulesForService ($serviceID: ID!, $first: Int!, $after: Cursor) {
+	logRules(where: {hasLogEventWith:[{serviceID:$serviceID}]}, first: $first, after: $after) {
 		edges {
 			node {
-				id
-				logEventDiscoveryProgress {
-					status
-					percentComplete
-					weeklyVolume
-					weeklyDiscoveredVolume
-					lastError
-					startedAt
-					completedAt
-					consecutiveFailures
+				... LogRuleDetails
+				logEvent {
+					id
+					name
 				}
 			}
 		}
+		pageInfo {
+			... PageInfoFields
+		}
+		totalCount
 	}
 }
+fragment LogRuleDetails on LogRule {
+	id
+	logEventID
+	workspaceID
+	retention
+	confidence
+	ignoredAt
+	vrlScript
+	rationale
+	createdByType
+	createdByID
+	createdAt
+}
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
 `
 
-func GetDatadogAccountLogDiscoveryProgress(
+// Query to list the log rules for every log event of a service
+func ListLogRulesForService(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	id string,
-) (data_ *GetDatadogAccountLogDiscoveryProgressResponse, err_ error) {
+	serviceID string,
+	first int,
+	after *string,
+) (data_ *ListLogRulesForServiceResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "GetDatadogAccountLogDiscoveryProgress",
-		Query:  GetDatadogAccountLogDiscoveryProgress_Operation,
-		Variables: &__GetDatadogAccountLogDiscoveryProgressInput{
-			Id: id,
+		OpName: "ListLogRulesForService",
+		Query:  ListLogRulesForService_Operation,
+		Variables: &__ListLogRulesForServiceInput{
+			ServiceID: serviceID,
+			First:     first,
+			After:     after,
 		},
 	}
 
-	data_ = &GetDatadogAccountLogDiscoveryProgressResponse{}
+	data_ = &ListLogRulesForServiceResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1809,41 +6429,81 @@ func GetDatadogAccountLogDiscoveryProgress(
 	return data_, err_
 }
 
-// The query executed by GetDatadogAccountServiceDiscoveryProgress.
-const GetDatadogAccountServiceDiscoveryProgress_Operation = `
-query GetDatadogAccountServiceDiscoveryProgress ($id: ID!) {
-	datadogAccounts(where: {id:$id}, first: 1) {
+// The query executed by ListLogRulesForWorkspace.
+const ListLogRulesForWorkspace_Operation = `
+query ListLogRulesForWorkspace ($workspaceID: ID!, $first: Int!, $after: Cursor) {
+	logRules(where: {workspaceID:$workspaceID}, first: $first, after: $after) {
 		edges {
 			node {
-				id
-				serviceDiscoveryProgress {
-					status
-					servicesDiscovered
-					lastError
-					startedAt
-					completedAt
-					consecutiveFailures
+				... LogRuleDetails
+				logEvent {
+					id
+					name
+					service {
+						id
+						name
+					}
+				}
+				deployments {
+					... LogRuleDeploymentDetails
 				}
 			}
 		}
+		pageInfo {
+			... PageInfoFields
+		}
+		totalCount
+	}
+}
+fragment LogRuleDetails on LogRule {
+	id
+	logEventID
+	workspaceID
+	retention
+	confidence
+	ignoredAt
+	vrlScript
+	rationale
+	createdByType
+	createdByID
+	createdAt
+}
+fragment LogRuleDeploymentDetails on LogRuleDeployment {
+	id
+	externalID
+	lastError
+	updatedAt
+	datadogLogIndex {
+		id
+		name
 	}
 }
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
 `
 
-func GetDatadogAccountServiceDiscoveryProgress(
+// Query to list a workspace's log rules with the log event and service each
+// rule matches and where it is deployed, for exporting to other tools
+func ListLogRulesForWorkspace(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	id string,
-) (data_ *GetDatadogAccountServiceDiscoveryProgressResponse, err_ error) {
+	workspaceID string,
+	first int,
+	after *string,
+) (data_ *ListLogRulesForWorkspaceResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "GetDatadogAccountServiceDiscoveryProgress",
-		Query:  GetDatadogAccountServiceDiscoveryProgress_Operation,
-		Variables: &__GetDatadogAccountServiceDiscoveryProgressInput{
-			Id: id,
+		OpName: "ListLogRulesForWorkspace",
+		Query:  ListLogRulesForWorkspace_Operation,
+		Variables: &__ListLogRulesForWorkspaceInput{
+			WorkspaceID: workspaceID,
+			First:       first,
+			After:       after,
 		},
 	}
 
-	data_ = &GetDatadogAccountServiceDiscoveryProgressResponse{}
+	data_ = &ListLogRulesForWorkspaceResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1855,48 +6515,45 @@ func GetDatadogAccountServiceDiscoveryProgress(
 	return data_, err_
 }
 
-// The query executed by GetService.
-const GetService_Operation = `
-query GetService ($id: ID!) {
-	node(id: $id) {
-		__typename
-		... on Service {
-			id
-			name
-			description
-			enabled
-			createdAt
-			updatedAt
-			account {
-				id
-				name
-			}
-			logEvents {
+// The query executed by ListOrganizations.
+const ListOrganizations_Operation = `
+query ListOrganizations ($first: Int!, $after: Cursor) {
+	organizations(first: $first, after: $after) {
+		edges {
+			node {
 				id
 				name
-				description
 				createdAt
 			}
 		}
+		pageInfo {
+			... PageInfoFields
+		}
+		totalCount
 	}
 }
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
 `
 
-// Query to get detailed information about a specific service by ID
-func GetService(
+func ListOrganizations(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	id string,
-) (data_ *GetServiceResponse, err_ error) {
+	first int,
+	after *string,
+) (data_ *ListOrganizationsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "GetService",
-		Query:  GetService_Operation,
-		Variables: &__GetServiceInput{
-			Id: id,
+		OpName: "ListOrganizations",
+		Query:  ListOrganizations_Operation,
+		Variables: &__ListOrganizationsInput{
+			First: first,
+			After: after,
 		},
 	}
 
-	data_ = &GetServiceResponse{}
+	data_ = &ListOrganizationsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1908,39 +6565,66 @@ func GetService(
 	return data_, err_
 }
 
-// The query executed by GetServiceByName.
-const GetServiceByName_Operation = `
-query GetServiceByName ($name: String!) {
-	services(where: {name:$name}, first: 1) {
+// The query executed by ListServiceVolumeStats.
+const ListServiceVolumeStats_Operation = `
+query ListServiceVolumeStats ($accountID: ID!, $lookback: TimeWindow!, $first: Int!, $after: Cursor) {
+	services(where: {accountID:$accountID}, first: $first, after: $after) {
 		edges {
 			node {
 				id
 				name
-				description
 				enabled
-				createdAt
-				updatedAt
+				initialWeeklyLogCount
+				volumeStats(lookback: $lookback) {
+					... LogVolumeStats
+				}
 			}
 		}
+		pageInfo {
+			... PageInfoFields
+		}
 	}
 }
+fragment LogVolumeStats on LogVolumeAggregate {
+	totalVolume
+	unknownVolume
+	valuableVolume
+	wasteVolume
+	savedVolume
+	unknownPercent
+	valuablePercent
+	wastePercent
+	savedPercent
+	periodStart
+	periodEnd
+}
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
 `
 
-// Query to get service by name
-func GetServiceByName(
+// Query to list an account's services with their volume stats
+func ListServiceVolumeStats(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	name string,
-) (data_ *GetServiceByNameResponse, err_ error) {
+	accountID string,
+	lookback TimeWindow,
+	first int,
+	after *string,
+) (data_ *ListServiceVolumeStatsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "GetServiceByName",
-		Query:  GetServiceByName_Operation,
-		Variables: &__GetServiceByNameInput{
-			Name: name,
+		OpName: "ListServiceVolumeStats",
+		Query:  ListServiceVolumeStats_Operation,
+		Variables: &__ListServiceVolumeStatsInput{
+			AccountID: accountID,
+			Lookback:  lookback,
+			First:     first,
+			After:     after,
 		},
 	}
 
-	data_ = &GetServiceByNameResponse{}
+	data_ = &ListServiceVolumeStatsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1952,36 +6636,49 @@ func GetServiceByName(
 	return data_, err_
 }
 
-// The query executed by ListAccounts.
-const ListAccounts_Operation = `
-query ListAccounts ($organizationID: ID!) {
-	accounts(where: {organizationID:$organizationID}) {
+// The query executed by ListServices.
+const ListServices_Operation = `
+query ListServices ($first: Int!, $after: Cursor) {
+	services(first: $first, after: $after) {
 		edges {
 			node {
 				id
 				name
+				description
+				enabled
 				createdAt
+				updatedAt
 			}
 		}
+		pageInfo {
+			... PageInfoFields
+		}
 		totalCount
 	}
 }
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
 `
 
-func ListAccounts(
+// Query to list all services with basic information
+func ListServices(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	organizationID string,
-) (data_ *ListAccountsResponse, err_ error) {
+	first int,
+	after *string,
+) (data_ *ListServicesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListAccounts",
-		Query:  ListAccounts_Operation,
-		Variables: &__ListAccountsInput{
-			OrganizationID: organizationID,
+		OpName: "ListServices",
+		Query:  ListServices_Operation,
+		Variables: &__ListServicesInput{
+			First: first,
+			After: after,
 		},
 	}
 
-	data_ = &ListAccountsResponse{}
+	data_ = &ListServicesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1993,32 +6690,48 @@ func ListAccounts(
 	return data_, err_
 }
 
-// The query executed by ListOrganizations.
-const ListOrganizations_Operation = `
-query ListOrganizations {
-	organizations {
+// The query executed by ListWorkspaces.
+const ListWorkspaces_Operation = `
+query ListWorkspaces ($accountID: ID!, $first: Int!, $after: Cursor) {
+	workspaces(where: {accountID:$accountID}, first: $first, after: $after) {
 		edges {
 			node {
 				id
 				name
-				createdAt
+				purpose
 			}
 		}
+		pageInfo {
+			... PageInfoFields
+		}
 		totalCount
 	}
 }
+fragment PageInfoFields on PageInfo {
+	hasNextPage
+	endCursor
+}
 `
 
-func ListOrganizations(
+// Query to list the workspaces of an account
+func ListWorkspaces(
 	ctx_ context.Context,
 	client_ graphql.Client,
-) (data_ *ListOrganizationsResponse, err_ error) {
+	accountID string,
+	first int,
+	after *string,
+) (data_ *ListWorkspacesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListOrganizations",
-		Query:  ListOrganizations_Operation,
+		OpName: "ListWorkspaces",
+		Query:  ListWorkspaces_Operation,
+		Variables: &__ListWorkspacesInput{
+			AccountID: accountID,
+			First:     first,
+			After:     after,
+		},
 	}
 
-	data_ = &ListOrganizationsResponse{}
+	data_ = &ListWorkspacesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -2030,36 +6743,33 @@ func ListOrganizations(
 	return data_, err_
 }
 
-// The query executed by ListServices.
-const ListServices_Operation = `
-query ListServices {
-	services(first: 100) {
-		edges {
-			node {
-				id
-				name
-				description
-				enabled
-				createdAt
-				updatedAt
-			}
-		}
-		totalCount
+// The mutation executed by RenameAccount.
+const RenameAccount_Operation = `
+mutation RenameAccount ($id: ID!, $name: String!) {
+	updateAccount(id: $id, input: {name:$name}) {
+		id
+		name
+		createdAt
 	}
 }
 `
 
-// Query to list all services with basic information
-func ListServices(
+func RenameAccount(
 	ctx_ context.Context,
 	client_ graphql.Client,
-) (data_ *ListServicesResponse, err_ error) {
+	id string,
+	name string,
+) (data_ *RenameAccountResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListServices",
-		Query:  ListServices_Operation,
+		OpName: "RenameAccount",
+		Query:  RenameAccount_Operation,
+		Variables: &__RenameAccountInput{
+			Id:   id,
+			Name: name,
+		},
 	}
 
-	data_ = &ListServicesResponse{}
+	data_ = &RenameAccountResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
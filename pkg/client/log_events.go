@@ -0,0 +1,21 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// ListLogEventsForService returns one page of a service's log events with their volume stats
+func (c *Client) ListLogEventsForService(ctx context.Context, serviceID string, lookback TimeWindow, first int, after *string) (*ListLogEventsForServiceResponse, error) {
+	return ListLogEventsForService(ctx, c.gql, serviceID, lookback, first, after)
+}
+
+// GetLogEventDetail retrieves a log event with its rules and their deployments
+func (c *Client) GetLogEventDetail(ctx context.Context, id string) (*GetLogEventDetailResponse, error) {
+	return GetLogEventDetail(ctx, c.gql, id)
+}
+
+// ListLogEventVolumes returns one page of a log event's volume samples since a point in time
+func (c *Client) ListLogEventVolumes(ctx context.Context, logEventID string, since time.Time, first int, after *string) (*ListLogEventVolumesResponse, error) {
+	return ListLogEventVolumes(ctx, c.gql, logEventID, since, first, after)
+}
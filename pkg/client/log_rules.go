@@ -0,0 +1,13 @@
+package client
+
+import "context"
+
+// ListLogRulesForService returns one page of log rules for every log event of a service
+func (c *Client) ListLogRulesForService(ctx context.Context, serviceID string, first int, after *string) (*ListLogRulesForServiceResponse, error) {
+	return ListLogRulesForService(ctx, c.gql, serviceID, first, after)
+}
+
+// ListLogRulesForWorkspace returns one page of a workspace's log rules with their log events and deployments
+func (c *Client) ListLogRulesForWorkspace(ctx context.Context, workspaceID string, first int, after *string) (*ListLogRulesForWorkspaceResponse, error) {
+	return ListLogRulesForWorkspace(ctx, c.gql, workspaceID, first, after)
+}
@@ -2,9 +2,9 @@ package client
 
 import "context"
 
-// ListOrganizations returns all organizations for the authenticated user
-func (c *Client) ListOrganizations(ctx context.Context) (*ListOrganizationsResponse, error) {
-	return ListOrganizations(ctx, c.gql)
+// ListOrganizations returns one page of organizations for the authenticated user
+func (c *Client) ListOrganizations(ctx context.Context, first int, after *string) (*ListOrganizationsResponse, error) {
+	return ListOrganizations(ctx, c.gql, first, after)
 }
 
 // CreateOrganizationAndBootstrap creates a new organization with default setup
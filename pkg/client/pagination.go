@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+// DefaultPageSize is the number of items requested per page when walking a connection.
+const DefaultPageSize = 100
+
+// PageFunc fetches one page of a Relay connection.
+// first is the number of items to request and after is the cursor to resume
+// from (nil for the first page). It returns the page's items and PageInfo.
+type PageFunc[T any] func(ctx context.Context, first int, after *string) ([]T, PageInfoFields, error)
+
+// Paginate walks a Relay connection page by page, following endCursor until
+// hasNextPage is false. Items are yielded as they arrive, so callers that stop
+// early don't pay for pages they never read.
+//
+// limit caps the total number of items yielded; zero or negative means no limit.
+// A fetch error is yielded once and ends the iteration.
+func Paginate[T any](ctx context.Context, fetch PageFunc[T], limit int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var after *string
+		yielded := 0
+
+		for {
+			first := DefaultPageSize
+			if limit > 0 {
+				first = min(first, limit-yielded)
+			}
+
+			items, pageInfo, err := fetch(ctx, first, after)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+				if limit > 0 && yielded >= limit {
+					return
+				}
+			}
+
+			// Guard against servers that report more pages without a usable cursor
+			if !pageInfo.HasNextPage || pageInfo.EndCursor == "" || len(items) == 0 {
+				return
+			}
+			cursor := pageInfo.EndCursor
+			after = &cursor
+		}
+	}
+}
+
+// Collect drains a paginated iterator into a slice, stopping at the first error.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Run("follows endCursor until hasNextPage is false", func(t *testing.T) {
+		pages := newFakeConnection(250)
+
+		items, err := Collect(Paginate(context.Background(), pages.fetch, 0))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(items) != 250 {
+			t.Errorf("got %d items, want 250", len(items))
+		}
+		if pages.calls != 3 {
+			t.Errorf("fetched %d pages, want 3", pages.calls)
+		}
+		if items[249] != 249 {
+			t.Errorf("last item = %d, want 249", items[249])
+		}
+	})
+
+	t.Run("stops at the limit and requests only what it needs", func(t *testing.T) {
+		pages := newFakeConnection(250)
+
+		items, err := Collect(Paginate(context.Background(), pages.fetch, 120))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(items) != 120 {
+			t.Errorf("got %d items, want 120", len(items))
+		}
+		if want := []int{100, 20}; len(pages.firsts) != 2 || pages.firsts[0] != want[0] || pages.firsts[1] != want[1] {
+			t.Errorf("page sizes = %v, want %v", pages.firsts, want)
+		}
+	})
+
+	t.Run("does not fetch more pages after the consumer stops", func(t *testing.T) {
+		pages := newFakeConnection(250)
+
+		for item, err := range Paginate(context.Background(), pages.fetch, 0) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if item == 5 {
+				break
+			}
+		}
+
+		if pages.calls != 1 {
+			t.Errorf("fetched %d pages, want 1", pages.calls)
+		}
+	})
+
+	t.Run("returns fetch errors", func(t *testing.T) {
+		pages := newFakeConnection(250)
+		pages.failOn = 2
+
+		_, err := Collect(Paginate(context.Background(), pages.fetch, 0))
+		if err == nil || err.Error() != "page 2 failed" {
+			t.Errorf("error = %v, want page 2 failed", err)
+		}
+	})
+}
+
+// fakeConnection serves integers 0..total-1 as a Relay connection.
+type fakeConnection struct {
+	total  int
+	calls  int
+	firsts []int
+	failOn int
+}
+
+func newFakeConnection(total int) *fakeConnection {
+	return &fakeConnection{total: total}
+}
+
+func (c *fakeConnection) fetch(ctx context.Context, first int, after *string) ([]int, PageInfoFields, error) {
+	c.calls++
+	c.firsts = append(c.firsts, first)
+	if c.calls == c.failOn {
+		return nil, PageInfoFields{}, errors.New("page " + strconv.Itoa(c.calls) + " failed")
+	}
+
+	start := 0
+	if after != nil {
+		n, _ := strconv.Atoi(*after)
+		start = n + 1
+	}
+	end := min(start+first, c.total)
+
+	items := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		items = append(items, i)
+	}
+	return items, PageInfoFields{
+		HasNextPage: end < c.total,
+		EndCursor:   strconv.Itoa(end - 1),
+	}, nil
+}
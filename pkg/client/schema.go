@@ -0,0 +1,13 @@
+package client
+
+import "embed"
+
+// Schema is the control plane's GraphQL schema, as the generated code was built against.
+//
+//go:embed schema.graphql
+var Schema string
+
+// Queries holds the operations in queries/*.graphql that the generated code sends.
+//
+//go:embed queries/*.graphql
+var Queries embed.FS
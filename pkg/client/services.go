@@ -2,9 +2,9 @@ package client
 
 import "context"
 
-// ListServices returns all services
-func (c *Client) ListServices(ctx context.Context) (*ListServicesResponse, error) {
-	return ListServices(ctx, c.gql)
+// ListServices returns one page of services
+func (c *Client) ListServices(ctx context.Context, first int, after *string) (*ListServicesResponse, error) {
+	return ListServices(ctx, c.gql, first, after)
 }
 
 // GetService retrieves a specific service by ID
@@ -21,3 +21,18 @@ func (c *Client) GetServiceByName(ctx context.Context, name string) (*GetService
 func (c *Client) EnableService(ctx context.Context, serviceId string) (*EnableServiceResponse, error) {
 	return EnableService(ctx, c.gql, serviceId)
 }
+
+// DisableService disables analysis for a service
+func (c *Client) DisableService(ctx context.Context, serviceId string) (*DisableServiceResponse, error) {
+	return DisableService(ctx, c.gql, serviceId)
+}
+
+// ListServiceVolumeStats returns one page of an account's services with their volume stats
+func (c *Client) ListServiceVolumeStats(ctx context.Context, accountID string, lookback TimeWindow, first int, after *string) (*ListServiceVolumeStatsResponse, error) {
+	return ListServiceVolumeStats(ctx, c.gql, accountID, lookback, first, after)
+}
+
+// GetServiceVolumeStats retrieves volume stats for a specific service
+func (c *Client) GetServiceVolumeStats(ctx context.Context, id string, lookback TimeWindow) (*GetServiceVolumeStatsResponse, error) {
+	return GetServiceVolumeStats(ctx, c.gql, id, lookback)
+}
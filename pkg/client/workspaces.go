@@ -0,0 +1,8 @@
+package client
+
+import "context"
+
+// ListWorkspaces returns one page of workspaces for a given account
+func (c *Client) ListWorkspaces(ctx context.Context, accountID string, first int, after *string) (*ListWorkspacesResponse, error) {
+	return ListWorkspaces(ctx, c.gql, accountID, first, after)
+}